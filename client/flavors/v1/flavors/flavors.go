@@ -1,10 +1,16 @@
 package flavors
 
 import (
+	"fmt"
+
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/client/flavors/v1/client"
+	instancesclient "github.com/G-Core/gcorelabscloud-go/client/instances/v1/client"
 	"github.com/G-Core/gcorelabscloud-go/client/utils"
 	"github.com/G-Core/gcorelabscloud-go/gcore/flavor/v1/flavors"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+
+	"github.com/shopspring/decimal"
 
 	"github.com/urfave/cli/v2"
 )
@@ -50,10 +56,117 @@ var flavorListCommand = cli.Command{
 	},
 }
 
+var flavorSuggestCommand = cli.Command{
+	Name:     "suggest",
+	Usage:    "Suggest flavors meeting requirements, cheapest and closest first",
+	Category: "flavor",
+	Flags: []cli.Flag{
+		&cli.IntFlag{
+			Name:  "min-vcpus",
+			Usage: "minimum number of vCPUs",
+		},
+		&cli.IntFlag{
+			Name:  "min-ram",
+			Usage: "minimum RAM in MiB",
+		},
+		&cli.BoolFlag{
+			Name:  "gpu",
+			Usage: "require a GPU, --gpu=false excludes GPU flavors",
+		},
+		&cli.BoolFlag{
+			Name:  "ipu",
+			Usage: "require an IPU, --ipu=false excludes IPU flavors",
+		},
+		&cli.StringSliceFlag{
+			Name:  "include",
+			Usage: "flavor name pattern to include, e.g. 'g1-*'",
+		},
+		&cli.StringSliceFlag{
+			Name:  "exclude",
+			Usage: "flavor name pattern to exclude",
+		},
+		&cli.BoolFlag{
+			Name:    "baremetal",
+			Aliases: []string{"bm"},
+			Usage:   "suggest baremetal flavors",
+		},
+		&cli.StringFlag{
+			Name:  "max-price",
+			Usage: "maximum price per hour",
+		},
+		&cli.StringFlag{
+			Name:  "instance-id",
+			Usage: "suggest flavors the instance can be resized into",
+		},
+		&cli.IntFlag{
+			Name:  "limit",
+			Usage: "maximum number of suggestions, 0 for all",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		req := flavors.Requirements{
+			MinVCPUs:  c.Int("min-vcpus"),
+			MinRAM:    c.Int("min-ram"),
+			Include:   c.StringSlice("include"),
+			Exclude:   c.StringSlice("exclude"),
+			Baremetal: c.Bool("baremetal"),
+		}
+		if c.IsSet("gpu") {
+			gpu := c.Bool("gpu")
+			req.GPU = &gpu
+		}
+		if c.IsSet("ipu") {
+			ipu := c.Bool("ipu")
+			req.IPU = &ipu
+		}
+		if c.IsSet("max-price") {
+			price, err := decimal.NewFromString(c.String("max-price"))
+			if err != nil {
+				_ = cli.ShowCommandHelp(c, "suggest")
+				return cli.NewExitError(fmt.Errorf("invalid max price: %w", err), 1)
+			}
+			req.MaxPricePerHour = &price
+		}
+		if err := req.Validate(); err != nil {
+			_ = cli.ShowCommandHelp(c, "suggest")
+			return cli.NewExitError(err, 1)
+		}
+
+		var matches []flavors.Match
+		var err error
+		if instanceID := c.String("instance-id"); instanceID != "" {
+			var cl *gcorecloud.ServiceClient
+			cl, err = instancesclient.NewInstanceClientV1(c)
+			if err != nil {
+				_ = cli.ShowAppHelp(c)
+				return cli.NewExitError(err, 1)
+			}
+			matches, err = instances.SelectResizeFlavors(cl, instanceID, req)
+		} else {
+			var cl *gcorecloud.ServiceClient
+			cl, err = client.NewFlavorClientV1(c)
+			if err != nil {
+				_ = cli.ShowAppHelp(c)
+				return cli.NewExitError(err, 1)
+			}
+			matches, err = flavors.Select(cl, req)
+		}
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		if limit := c.Int("limit"); limit > 0 && len(matches) > limit {
+			matches = matches[:limit]
+		}
+		utils.ShowResults(matches, c.String("format"))
+		return nil
+	},
+}
+
 var Commands = cli.Command{
 	Name:  "flavor",
 	Usage: "GCloud flavors API",
 	Subcommands: []*cli.Command{
 		&flavorListCommand,
+		&flavorSuggestCommand,
 	},
 }
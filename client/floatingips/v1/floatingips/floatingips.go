@@ -3,6 +3,7 @@ package floatingips
 import (
 	"fmt"
 	"net"
+	"time"
 
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/client/flags"
@@ -241,6 +242,122 @@ var floatingIPUnAssignSubCommand = cli.Command{
 	},
 }
 
+var selectorFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:     "metadata-key",
+		Usage:    "metadata key tagging the floating IPs",
+		Required: true,
+	},
+	&cli.StringFlag{
+		Name:  "metadata-value",
+		Usage: "metadata value tagging the floating IPs, any value if not set",
+	},
+	&cli.IntFlag{
+		Name:  "wait-seconds",
+		Usage: "maximum time in seconds to wait for each step",
+		Value: 300,
+	},
+}
+
+func selectorFromFlags(c *cli.Context) (floatingips.Selector, gcorecloud.WaitOpts) {
+	selector := floatingips.Selector{
+		MetadataKey:   c.String("metadata-key"),
+		MetadataValue: c.String("metadata-value"),
+	}
+	return selector, gcorecloud.WaitOpts{Timeout: time.Duration(c.Int("wait-seconds")) * time.Second}
+}
+
+var floatingIPEnsureSubCommand = cli.Command{
+	Name:     "ensure",
+	Usage:    "Assign a floating IP tagged with metadata to an instance or a port, reusing an unassigned one",
+	Category: "floatingip",
+	Flags: append([]cli.Flag{
+		&cli.StringFlag{
+			Name:    "instance-id",
+			Aliases: []string{"i"},
+			Usage:   "instance id, the floating IP is assigned to its first interface",
+		},
+		&cli.StringFlag{
+			Name:    "port-id",
+			Aliases: []string{"p"},
+			Usage:   "port id",
+		},
+		&cli.StringFlag{
+			Name:    "fixed-ip-address",
+			Aliases: []string{"a"},
+			Usage:   "fixed ip address",
+		},
+	}, selectorFlags...),
+	Action: func(c *cli.Context) error {
+		client, err := client.NewFloatingIPClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+
+		selector, waitOpts := selectorFromFlags(c)
+		opts := floatingips.EnsureAssignedOpts{
+			InstanceID: c.String("instance-id"),
+			PortID:     c.String("port-id"),
+			Selector:   selector,
+			Wait:       waitOpts,
+		}
+		if address := c.String("fixed-ip-address"); address != "" {
+			opts.FixedIPAddress = net.ParseIP(address)
+			if opts.FixedIPAddress == nil {
+				_ = cli.ShowCommandHelp(c, "ensure")
+				return cli.NewExitError(fmt.Errorf("malformed ip address: %s", address), 1)
+			}
+		}
+		if err := opts.Validate(); err != nil {
+			_ = cli.ShowCommandHelp(c, "ensure")
+			return cli.NewExitError(err, 1)
+		}
+
+		result, err := floatingips.EnsureAssigned(client, opts)
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		utils.ShowResults(result, c.String("format"))
+		return nil
+	},
+}
+
+var floatingIPPoolSubCommand = cli.Command{
+	Name:     "pool",
+	Usage:    "Keep a number of unassigned floating IPs tagged with metadata ready",
+	Category: "floatingip",
+	Flags: append([]cli.Flag{
+		&cli.IntFlag{
+			Name:     "size",
+			Aliases:  []string{"n"},
+			Usage:    "count of unassigned floating IPs to keep",
+			Required: true,
+		},
+	}, selectorFlags...),
+	Action: func(c *cli.Context) error {
+		client, err := client.NewFloatingIPClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+
+		selector, waitOpts := selectorFromFlags(c)
+		report, err := floatingips.EnsurePool(client, floatingips.PoolOpts{
+			Size:     c.Int("size"),
+			Selector: selector,
+			Wait:     waitOpts,
+		})
+		if report != nil {
+			utils.ShowResults(report, c.String("format"))
+		}
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		return nil
+	},
+}
+
 var Commands = cli.Command{
 	Name:  "floatingip",
 	Usage: "GCloud floating ips API",
@@ -251,6 +368,8 @@ var Commands = cli.Command{
 		&floatingIPUnAssignSubCommand,
 		&floatingIPDeleteSubCommand,
 		&floatingIPCreateSubCommand,
+		&floatingIPEnsureSubCommand,
+		&floatingIPPoolSubCommand,
 		&availablefloatingips.AvailableFloatingIPCommands,
 		{
 			Name:  "metadata",
@@ -3,10 +3,13 @@ package images
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	cmeta "github.com/G-Core/gcorelabscloud-go/client/utils/metadata"
 
 	"github.com/G-Core/gcorelabscloud-go/client/images/v1/client"
+	instanceclient "github.com/G-Core/gcorelabscloud-go/client/instances/v1/client"
+	instancescli "github.com/G-Core/gcorelabscloud-go/client/instances/v1/instances"
 
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
@@ -15,8 +18,12 @@ import (
 
 	"github.com/G-Core/gcorelabscloud-go/client/flags"
 	"github.com/G-Core/gcorelabscloud-go/client/utils"
+	"github.com/G-Core/gcorelabscloud-go/gcore/image/v1/bake"
 	"github.com/G-Core/gcorelabscloud-go/gcore/image/v1/images"
 	"github.com/G-Core/gcorelabscloud-go/gcore/image/v1/images/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	instancetypes "github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/volume/v1/volumes"
 )
 
 var (
@@ -388,6 +395,192 @@ var imageUploadCommand = cli.Command{
 	},
 }
 
+var imageBakeCommand = cli.Command{
+	Name:     "bake",
+	Usage:    "Bake an image or a volume snapshot from a provisioned temporary instance",
+	Category: "image",
+	Description: "The temporary instance boots from the base image with the user data, which should set the instance\n" +
+		"metadata key given by --status-key to 'done' when the provisioning succeeds, or 'failed' otherwise.\n" +
+		"The instance is stopped and its boot volume is baked. The instance, its volumes and floating IPs are\n" +
+		"deleted whatever the outcome.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "name",
+			Aliases:  []string{"n"},
+			Usage:    "image or snapshot name",
+			Required: true,
+		},
+		&cli.GenericFlag{
+			Name: "target",
+			Value: &utils.EnumValue{
+				Enum:    bake.Target("").StringList(),
+				Default: bake.ImageTarget.String(),
+			},
+			Usage: fmt.Sprintf("output: %s", strings.Join(bake.Target("").StringList(), ", ")),
+		},
+		&cli.StringFlag{
+			Name:     "base-image-id",
+			Usage:    "image the temporary instance boots from",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     "flavor",
+			Usage:    "flavor of the temporary instance",
+			Required: true,
+		},
+		&cli.IntFlag{
+			Name:  "volume-size",
+			Usage: "boot volume size in GiB",
+			Value: 10,
+		},
+		&cli.StringFlag{
+			Name:  "volume-type",
+			Usage: fmt.Sprintf("boot volume type: %s", strings.Join(volumes.VolumeType("").StringList(), ", ")),
+		},
+		&cli.StringFlag{
+			Name:  "network-id",
+			Usage: "network of the temporary instance, an external interface is used when neither network nor subnet is set",
+		},
+		&cli.StringFlag{
+			Name:  "subnet-id",
+			Usage: "subnet of the temporary instance",
+		},
+		&cli.BoolFlag{
+			Name:  "floating-ip",
+			Usage: "assign a new floating IP to the temporary instance, deleted with it",
+		},
+		&cli.StringSliceFlag{
+			Name:  "security-group",
+			Usage: "security group ID of the temporary instance",
+		},
+		&cli.StringFlag{
+			Name:  "keypair",
+			Usage: "keypair name of the temporary instance",
+		},
+		&cli.StringFlag{
+			Name:  "user-data",
+			Usage: "provisioning user data",
+		},
+		&cli.StringFlag{
+			Name:  "user-data-file",
+			Usage: "provisioning user data file",
+		},
+		&cli.StringSliceFlag{
+			Name:  "user-data-part",
+			Usage: "cloud-config, shell script or boothook file composed into multi-part user data",
+		},
+		&cli.BoolFlag{
+			Name:  "user-data-gzip",
+			Usage: "gzip user data composed from --user-data-part",
+		},
+		&cli.StringFlag{
+			Name:  "status-key",
+			Usage: "instance metadata key the provisioning sets to signal its completion",
+			Value: bake.DefaultStatusKey,
+		},
+		&cli.StringSliceFlag{
+			Name:  "metadata",
+			Usage: "build metadata of the image or snapshot. Example: --metadata git_sha=4f2c1d",
+		},
+		&cli.StringFlag{
+			Name:  "hw-firmware-type",
+			Usage: "image firmware type: bios, uefi",
+		},
+		&cli.StringFlag{
+			Name:  "hw-machine-type",
+			Usage: "image chipset type: i440, q35",
+		},
+		&cli.StringFlag{
+			Name:  "ssh-key",
+			Usage: "image ssh key permission: allow, deny, required",
+		},
+		&cli.StringFlag{
+			Name:  "os-type",
+			Usage: "image operating system: linux, windows",
+		},
+		&cli.IntFlag{
+			Name:  "provision-timeout",
+			Usage: "seconds to wait for the provisioning",
+			Value: int(bake.DefaultProvisionTimeout.Seconds()),
+		},
+	},
+	Action: func(c *cli.Context) error {
+		cl, err := instanceclient.NewInstanceClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+		userData, err := instancescli.GetUserData(c)
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		userDataBuilder, err := instancescli.GetUserDataBuilder(c)
+		if err != nil {
+			_ = cli.ShowCommandHelp(c, "bake")
+			return cli.NewExitError(err, 1)
+		}
+		metadata, err := cmeta.StringSliceToMap(c.StringSlice("metadata"))
+		if err != nil {
+			_ = cli.ShowCommandHelp(c, "bake")
+			return cli.NewExitError(err, 1)
+		}
+
+		iface := instances.InterfaceInstanceCreateOpts{
+			InterfaceOpts: instances.InterfaceOpts{Type: instancetypes.ExternalInterfaceType},
+		}
+		switch {
+		case c.String("subnet-id") != "":
+			iface.Type = instancetypes.SubnetInterfaceType
+			iface.NetworkID = c.String("network-id")
+			iface.SubnetID = c.String("subnet-id")
+		case c.String("network-id") != "":
+			iface.Type = instancetypes.AnySubnetInterfaceType
+			iface.NetworkID = c.String("network-id")
+		}
+		if c.Bool("floating-ip") {
+			iface.FloatingIP = &instances.CreateNewInterfaceFloatingIPOpts{Source: instancetypes.NewFloatingIP}
+		}
+		var securityGroups []gcorecloud.ItemID
+		for _, id := range c.StringSlice("security-group") {
+			securityGroups = append(securityGroups, gcorecloud.ItemID{ID: id})
+		}
+
+		opts := bake.Opts{
+			Name:            c.String("name"),
+			Target:          bake.Target(c.String("target")),
+			BaseImageID:     c.String("base-image-id"),
+			Flavor:          c.String("flavor"),
+			VolumeSize:      c.Int("volume-size"),
+			VolumeType:      volumes.VolumeType(c.String("volume-type")),
+			Interfaces:      []instances.InterfaceInstanceCreateOpts{iface},
+			SecurityGroups:  securityGroups,
+			Keypair:         c.String("keypair"),
+			UserData:        userData,
+			UserDataBuilder: userDataBuilder,
+			StatusKey:       c.String("status-key"),
+			Metadata:        metadata,
+			HwMachineType:   types.HwMachineType(c.String("hw-machine-type")),
+			SshKey:          types.SshKeyType(c.String("ssh-key")),
+			OSType:          types.OSType(c.String("os-type")),
+			HwFirmwareType:  types.HwFirmwareType(c.String("hw-firmware-type")),
+			ProvisionWait:   gcorecloud.WaitOpts{Timeout: time.Duration(c.Int("provision-timeout")) * time.Second},
+		}
+		if err := opts.Validate(); err != nil {
+			_ = cli.ShowCommandHelp(c, "bake")
+			return cli.NewExitError(err, 1)
+		}
+
+		result, err := bake.Bake(cl, opts)
+		if result != nil {
+			utils.ShowResults(result, c.String("format"))
+		}
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		return nil
+	},
+}
+
 var imageDeleteCommand = cli.Command{
 	Name:      "delete",
 	Usage:     "Delete image",
@@ -435,6 +628,7 @@ var Commands = cli.Command{
 		&imageCreateCommand,
 		&imageUpdateCommand,
 		&imageUploadCommand,
+		&imageBakeCommand,
 		{
 			Name:  "project",
 			Usage: "GCloud project images API",
@@ -1,15 +1,25 @@
 package instances
 
 import (
+	"bytes"
+	"context"
 	"encoding/base64"
 	"fmt"
+	"io"
+	"net"
 	"os"
+	"os/signal"
 	"sort"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/G-Core/gcorelabscloud-go/client/instances/v1/client"
 	client2 "github.com/G-Core/gcorelabscloud-go/client/instances/v2/client"
+	volumesclient "github.com/G-Core/gcorelabscloud-go/client/volumes/v1/client"
 	"github.com/G-Core/gcorelabscloud-go/gcore/baremetal/v1/bminstances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/console"
+	"golang.org/x/crypto/ssh/terminal"
 
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 
@@ -17,9 +27,11 @@ import (
 
 	"github.com/G-Core/gcorelabscloud-go/client/flags"
 	"github.com/G-Core/gcorelabscloud-go/client/utils"
+	cmeta "github.com/G-Core/gcorelabscloud-go/client/utils/metadata"
 	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
 	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
 	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/utils/userdata"
 	"github.com/G-Core/gcorelabscloud-go/gcore/volume/v1/volumes"
 )
 
@@ -29,6 +41,7 @@ var (
 	volumeType                = volumes.VolumeType("").StringList()
 	interfaceTypes            = types.InterfaceType("").StringList()
 	interfaceFloatingIPSource = types.FloatingIPSource("").StringList()
+	powerActions              = types.PowerAction("").StringList()
 	bootableIndex             = 0
 )
 
@@ -37,6 +50,7 @@ var Commands = cli.Command{
 	Usage: "GCloud instances API",
 	Subcommands: []*cli.Command{
 		&instanceGetCommand,
+		&instanceDescribeCommand,
 		&instanceListCommand,
 		&instanceCreateCommandV2,
 		&instanceRenameCommand,
@@ -48,6 +62,8 @@ var Commands = cli.Command{
 		&instanceSuspendCommand,
 		&instanceResumeCommand,
 		&instanceResizeCommand,
+		&instanceBulkCommand,
+		&instanceConsoleCommand,
 		&instanceCreateBaremetalCommand,
 		{
 			Name:  "interface",
@@ -76,6 +92,14 @@ var Commands = cli.Command{
 				&metadataDeleteCommand,
 			},
 		},
+		{
+			Name:  "snapshot",
+			Usage: "Instance volume snapshot groups",
+			Subcommands: []*cli.Command{
+				&instanceSnapshotAllCommand,
+				&instanceRestoreFromSnapshotsCommand,
+			},
+		},
 	},
 }
 
@@ -126,6 +150,22 @@ func GetUserData(c *cli.Context) (string, error) {
 	return userData, nil
 }
 
+// GetUserDataBuilder composes the --user-data-part files into a cloud-init payload. It returns nil without parts.
+func GetUserDataBuilder(c *cli.Context) (*userdata.Builder, error) {
+	parts := c.StringSlice("user-data-part")
+	if len(parts) == 0 {
+		return nil, nil
+	}
+	if c.String("user-data") != "" || c.String("user-data-file") != "" {
+		return nil, fmt.Errorf("--user-data-part cannot be used with --user-data or --user-data-file")
+	}
+	builder := userdata.New().Gzip(c.Bool("user-data-gzip"))
+	for _, part := range parts {
+		builder.File(part)
+	}
+	return builder, builder.Validate()
+}
+
 func GetInstanceVolumes(c *cli.Context) ([]instances.CreateVolumeOpts, error) {
 	volumeSources := utils.GetEnumStringSliceValue(c, "volume-source")
 	volumeTypes := utils.GetEnumStringSliceValue(c, "volume-type")
@@ -572,6 +612,16 @@ var instanceCreateCommandV2 = cli.Command{
 			Usage:    "instance user data file",
 			Required: false,
 		},
+		&cli.StringSliceFlag{
+			Name:     "user-data-part",
+			Usage:    "cloud-config, shell script or boothook file composed into multi-part user data. Example: --user-data-part config.yaml --user-data-part setup.sh",
+			Required: false,
+		},
+		&cli.BoolFlag{
+			Name:     "user-data-gzip",
+			Usage:    "gzip user data composed from --user-data-part",
+			Required: false,
+		},
 		&cli.GenericFlag{
 			Name:    "volume-source",
 			Aliases: []string{"vs"},
@@ -687,6 +737,12 @@ var instanceCreateCommandV2 = cli.Command{
 			return cli.NewExitError(err, 1)
 		}
 
+		userDataBuilder, err := GetUserDataBuilder(c)
+		if err != nil {
+			_ = cli.ShowCommandHelp(c, "create")
+			return cli.NewExitError(err, 1)
+		}
+
 		instanceVolumes, err := GetInstanceVolumes(c)
 		if err != nil {
 			_ = cli.ShowCommandHelp(c, "create")
@@ -709,18 +765,19 @@ var instanceCreateCommandV2 = cli.Command{
 		}
 
 		opts := instances.CreateOpts{
-			Flavor:         c.String("flavor"),
-			Names:          c.StringSlice("name"),
-			NameTemplates:  c.StringSlice("name-template"),
-			Volumes:        instanceVolumes,
-			Interfaces:     instanceInterfaces,
-			SecurityGroups: securityGroups,
-			Keypair:        c.String("keypair"),
-			Password:       c.String("password"),
-			Username:       c.String("username"),
-			UserData:       userData,
-			Metadata:       metadata,
-			ServerGroupID:  c.String("server-group"),
+			Flavor:          c.String("flavor"),
+			Names:           c.StringSlice("name"),
+			NameTemplates:   c.StringSlice("name-template"),
+			Volumes:         instanceVolumes,
+			Interfaces:      instanceInterfaces,
+			SecurityGroups:  securityGroups,
+			Keypair:         c.String("keypair"),
+			Password:        c.String("password"),
+			Username:        c.String("username"),
+			UserData:        userData,
+			UserDataBuilder: userDataBuilder,
+			Metadata:        metadata,
+			ServerGroupID:   c.String("server-group"),
 		}
 
 		err = gcorecloud.TranslateValidationError(opts.Validate())
@@ -776,6 +833,191 @@ var instanceGetCommand = cli.Command{
 	},
 }
 
+var instanceDescribeCommand = cli.Command{
+	Name:      "describe",
+	Usage:     "Get instance information with ports, floating ips, volumes and security groups",
+	ArgsUsage: "<instance_id>",
+	Category:  "instance",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:     "all",
+			Aliases:  []string{"a"},
+			Usage:    "describe all instances",
+			Required: false,
+		},
+		&cli.IntFlag{
+			Name:     "concurrency",
+			Usage:    "maximum number of concurrent requests",
+			Value:    instances.DefaultDetailsConcurrency,
+			Required: false,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		var instanceID string
+		if !c.Bool("all") {
+			var err error
+			instanceID, err = flags.GetFirstStringArg(c, instanceIDText)
+			if err != nil {
+				_ = cli.ShowCommandHelp(c, "describe")
+				return err
+			}
+		}
+		client, err := client.NewInstanceClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+		volumeClient, err := volumesclient.NewVolumeClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+		opts := instances.DetailsOpts{
+			Concurrency:  c.Int("concurrency"),
+			VolumeClient: volumeClient,
+		}
+
+		if c.Bool("all") {
+			details, err := instances.ListAllDetails(client, nil, opts)
+			if err != nil {
+				return cli.NewExitError(err, 1)
+			}
+			utils.ShowResults(details, c.String("format"))
+			return nil
+		}
+		details, err := instances.GetDetails(client, instanceID, opts)
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		utils.ShowResults(details, c.String("format"))
+		return nil
+	},
+}
+
+var instanceBulkCommand = cli.Command{
+	Name:        "bulk",
+	Usage:       "Run a power action on the instances matching a selector",
+	ArgsUsage:   fmt.Sprintf("<%s>", strings.Join(powerActions, "|")),
+	Description: "Select instances by name regex, metadata, flavor or status and run the action on them",
+	Category:    "instance",
+	Flags: append([]cli.Flag{
+		&cli.StringFlag{
+			Name:     "name-regex",
+			Usage:    "select instances whose name matches the regular expression",
+			Required: false,
+		},
+		&cli.StringSliceFlag{
+			Name:     "metadata",
+			Usage:    "select instances with the metadata. Example: --metadata env=dev --metadata team=qa",
+			Required: false,
+		},
+		&cli.StringFlag{
+			Name:     "flavor_id",
+			Aliases:  []string{"fid"},
+			Usage:    "select instances by flavor id",
+			Required: false,
+		},
+		&cli.StringFlag{
+			Name:     "status",
+			Usage:    "select instances by status. Example: ACTIVE",
+			Required: false,
+		},
+		&cli.IntFlag{
+			Name:     "concurrency",
+			Usage:    "maximum number of instances processed concurrently",
+			Value:    instances.DefaultBulkConcurrency,
+			Required: false,
+		},
+		&cli.BoolFlag{
+			Name:     "dry-run",
+			Usage:    "show the selected instances without running the action",
+			Required: false,
+		},
+		&cli.BoolFlag{
+			Name:     "yes",
+			Aliases:  []string{"y"},
+			Usage:    "do not ask for confirmation",
+			Required: false,
+		},
+	}, flags.WaitCommandFlags...),
+	Action: func(c *cli.Context) error {
+		action := types.PowerAction(c.Args().First())
+		if err := action.IsValid(); err != nil {
+			_ = cli.ShowCommandHelp(c, "bulk")
+			return cli.NewExitError(err, 1)
+		}
+		metadata, err := cmeta.StringSliceToMap(c.StringSlice("metadata"))
+		if err != nil {
+			_ = cli.ShowCommandHelp(c, "bulk")
+			return cli.NewExitError(err, 1)
+		}
+		selector := instances.Selector{
+			NameRegex: c.String("name-regex"),
+			Metadata:  metadata,
+			FlavorID:  c.String("flavor_id"),
+			Status:    c.String("status"),
+		}
+		if err := selector.Validate(); err != nil {
+			_ = cli.ShowCommandHelp(c, "bulk")
+			return cli.NewExitError(err, 1)
+		}
+		opts := instances.BulkOpts{
+			Action:      action,
+			Concurrency: c.Int("concurrency"),
+			Wait:        c.Bool("wait"),
+			WaitTimeout: time.Duration(c.Int("wait-seconds")) * time.Second,
+			DryRun:      c.Bool("dry-run"),
+		}
+		if err := opts.Validate(); err != nil {
+			_ = cli.ShowCommandHelp(c, "bulk")
+			return cli.NewExitError(err, 1)
+		}
+
+		client, err := client.NewInstanceClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+		selected, err := instances.Select(client, selector)
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		if len(selected) == 0 {
+			return cli.NewExitError("no instances match the selector", 1)
+		}
+
+		if !opts.DryRun && !c.Bool("yes") {
+			for _, instance := range selected {
+				fmt.Fprintf(c.App.Writer, "%s\t%s\t%s\n", instance.ID, instance.Name, instance.Status)
+			}
+			confirmed, err := utils.Confirm(os.Stdin, c.App.Writer,
+				fmt.Sprintf("Run %s on %d instances?", action, len(selected)))
+			if err != nil {
+				return cli.NewExitError(err, 1)
+			}
+			if !confirmed {
+				return cli.NewExitError("aborted", 1)
+			}
+		}
+
+		results, err := instances.BulkInstances(client, selected, opts)
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		utils.ShowResults(results, c.String("format"))
+		failed := 0
+		for _, r := range results {
+			if r.Outcome == instances.BulkOutcomeFailed {
+				failed++
+			}
+		}
+		if failed > 0 {
+			return cli.NewExitError(fmt.Sprintf("%s failed on %d of %d instances", action, failed, len(results)), 1)
+		}
+		return nil
+	},
+}
+
 var instanceDeleteCommand = cli.Command{
 	Name:  "delete",
 	Usage: "Delete instance",
@@ -1037,6 +1279,195 @@ var instanceResizeCommand = cli.Command{
 	},
 }
 
+// consoleEscape is Ctrl+], which ends a terminal console session.
+const consoleEscape = 0x1d
+
+// escapeReader ends the input at the console escape character.
+type escapeReader struct {
+	io.Reader
+}
+
+func (r escapeReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if i := bytes.IndexByte(p[:n], consoleEscape); i >= 0 {
+		return i, io.EOF
+	}
+	return n, err
+}
+
+var instanceConsoleCommand = cli.Command{
+	Name:      "console",
+	Usage:     "Bridge instance console to a local TCP port or to the terminal",
+	ArgsUsage: "<instance_id>",
+	Category:  "instance",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "listen",
+			Usage: "local address the console is served on, e.g. for a VNC client",
+			Value: "127.0.0.1:5900",
+		},
+		&cli.BoolFlag{
+			Name:  "terminal",
+			Usage: "bridge the console to the terminal, the default for serial consoles",
+		},
+		&cli.BoolFlag{
+			Name:  "spice",
+			Usage: "use the spice console",
+		},
+		&cli.BoolFlag{
+			Name:  "show",
+			Usage: "show the console descriptor and exit",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		instanceID, err := flags.GetFirstStringArg(c, instanceIDText)
+		if err != nil {
+			_ = cli.ShowCommandHelp(c, "console")
+			return err
+		}
+		client, err := client.NewInstanceClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+
+		bridge := console.NewInstanceBridge(client, instanceID, console.Opts{})
+		if c.Bool("spice") {
+			bridge = console.NewSpiceBridge(client, instanceID, console.Opts{})
+		}
+		rc, err := bridge.Console(false)
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		if c.Bool("show") {
+			utils.ShowResults(rc, c.String("format"))
+			return nil
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		if c.Bool("terminal") || rc.Protocol == "serial" {
+			fd := int(os.Stdin.Fd())
+			if terminal.IsTerminal(fd) {
+				state, err := terminal.MakeRaw(fd)
+				if err != nil {
+					return cli.NewExitError(err, 1)
+				}
+				defer terminal.Restore(fd, state) // nolint
+			}
+			fmt.Fprintf(c.App.ErrWriter, "Connected to console of instance %s, press Ctrl+] to exit\r\n", instanceID)
+			local := struct {
+				io.Reader
+				io.Writer
+			}{escapeReader{os.Stdin}, os.Stdout}
+			if err := bridge.Serve(ctx, local); err != nil {
+				return cli.NewExitError(err, 1)
+			}
+			return nil
+		}
+
+		listener, err := net.Listen("tcp", c.String("listen"))
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		fmt.Fprintf(c.App.Writer, "%s console of instance %s is served on %s, press Ctrl+C to exit\n",
+			rc.Protocol, instanceID, listener.Addr())
+		err = bridge.ServeListener(ctx, listener, func(err error) {
+			fmt.Fprintln(c.App.ErrWriter, err)
+		})
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		return nil
+	},
+}
+
+var snapshotGroupFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:     "tag",
+		Usage:    "snapshot group tag",
+		Required: true,
+	},
+	&cli.IntFlag{
+		Name:  "wait-seconds",
+		Usage: "maximum time in seconds to wait for each step",
+		Value: 3600,
+	},
+}
+
+var instanceSnapshotAllCommand = cli.Command{
+	Name:      "create",
+	Usage:     "Snapshot all volumes attached to instance as a group",
+	ArgsUsage: "<instance_id>",
+	Category:  "snapshot",
+	Flags:     snapshotGroupFlags,
+	Action: func(c *cli.Context) error {
+		instanceID, err := flags.GetFirstStringArg(c, instanceIDText)
+		if err != nil {
+			_ = cli.ShowCommandHelp(c, "create")
+			return err
+		}
+		client, err := client.NewInstanceClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+		waitOpts := gcorecloud.WaitOpts{Timeout: time.Duration(c.Int("wait-seconds")) * time.Second}
+		results, err := instances.SnapshotAll(client, instanceID, c.String("tag"), waitOpts)
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		utils.ShowResults(results, c.String("format"))
+		return nil
+	},
+}
+
+var instanceRestoreFromSnapshotsCommand = cli.Command{
+	Name:      "restore",
+	Usage:     "Restore instance volumes from a snapshot group",
+	ArgsUsage: "<instance_id>",
+	Category:  "snapshot",
+	Flags: append([]cli.Flag{
+		&cli.BoolFlag{
+			Name:  "yes",
+			Usage: "do not ask for confirmation",
+		},
+	}, snapshotGroupFlags...),
+	Action: func(c *cli.Context) error {
+		instanceID, err := flags.GetFirstStringArg(c, instanceIDText)
+		if err != nil {
+			_ = cli.ShowCommandHelp(c, "restore")
+			return err
+		}
+		client, err := client.NewInstanceClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+		tag := c.String("tag")
+		if !c.Bool("yes") {
+			confirmed, err := utils.Confirm(os.Stdin, c.App.Writer,
+				fmt.Sprintf("Stop instance %s and restore its volumes from snapshot group %s?", instanceID, tag))
+			if err != nil {
+				return cli.NewExitError(err, 1)
+			}
+			if !confirmed {
+				return cli.NewExitError("aborted", 1)
+			}
+		}
+		waitOpts := gcorecloud.WaitOpts{Timeout: time.Duration(c.Int("wait-seconds")) * time.Second}
+		results, err := instances.RestoreFromSnapshots(client, instanceID, tag, waitOpts)
+		if results != nil {
+			utils.ShowResults(results, c.String("format"))
+		}
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		return nil
+	},
+}
+
 var metadataListCommand = cli.Command{
 	Name:      "list",
 	Usage:     "Get instance metadata",
@@ -1241,6 +1672,14 @@ var instanceCreateBaremetalCommand = cli.Command{
 			Name:  "user-data",
 			Usage: "Baremetal instance user data",
 		},
+		&cli.StringSliceFlag{
+			Name:  "user-data-part",
+			Usage: "cloud-config, shell script or boothook file composed into multi-part user data",
+		},
+		&cli.BoolFlag{
+			Name:  "user-data-gzip",
+			Usage: "gzip user data composed from --user-data-part",
+		},
 		&cli.StringFlag{
 			Name:  "image-id",
 			Usage: "Baremetal instance volume image id",
@@ -1296,6 +1735,12 @@ var instanceCreateBaremetalCommand = cli.Command{
 			return cli.NewExitError(err, 1)
 		}
 
+		userDataBuilder, err := GetUserDataBuilder(c)
+		if err != nil {
+			_ = cli.ShowCommandHelp(c, "create_baremetal")
+			return cli.NewExitError(err, 1)
+		}
+
 		instanceInterfaces, err := getBaremetalInterfaces(c)
 		if err != nil {
 			_ = cli.ShowCommandHelp(c, "create_baremetal")
@@ -1309,17 +1754,18 @@ var instanceCreateBaremetalCommand = cli.Command{
 		}
 
 		opts := bminstances.CreateOpts{
-			Flavor:        c.String("flavor"),
-			Names:         c.StringSlice("name"),
-			NameTemplates: c.StringSlice("name-template"),
-			ImageID:       c.String("image-id"),
-			AppTemplateID: c.String("apptemplate-id"),
-			Interfaces:    instanceInterfaces,
-			Keypair:       c.String("keypair"),
-			Password:      c.String("password"),
-			Username:      c.String("username"),
-			UserData:      userData,
-			AppConfig:     appCfg,
+			Flavor:          c.String("flavor"),
+			Names:           c.StringSlice("name"),
+			NameTemplates:   c.StringSlice("name-template"),
+			ImageID:         c.String("image-id"),
+			AppTemplateID:   c.String("apptemplate-id"),
+			Interfaces:      instanceInterfaces,
+			Keypair:         c.String("keypair"),
+			Password:        c.String("password"),
+			Username:        c.String("username"),
+			UserData:        userData,
+			UserDataBuilder: userDataBuilder,
+			AppConfig:       appCfg,
 		}
 
 		results, err := bminstances.Create(clientV1, opts).Extract()
@@ -2,6 +2,7 @@ package networks
 
 import (
 	"fmt"
+	"strings"
 
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/client/flags"
@@ -10,6 +11,7 @@ import (
 	cmeta "github.com/G-Core/gcorelabscloud-go/client/utils/metadata"
 	"github.com/G-Core/gcorelabscloud-go/gcore/network/v1/availablenetworks"
 	"github.com/G-Core/gcorelabscloud-go/gcore/network/v1/networks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/network/v1/topology"
 	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
 
 	"github.com/urfave/cli/v2"
@@ -277,6 +279,59 @@ var networkInstancePortCommand = cli.Command{
 	},
 }
 
+var networkTopologyCommand = cli.Command{
+	Name:     "topology",
+	Usage:    "Render the graph of networks, subnets, routers, ports, instances, VIPs and floating IPs",
+	Category: "network",
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:     "network-id",
+			Aliases:  []string{"i"},
+			Usage:    "show the topology of the network. Could be repeated",
+			Required: false,
+		},
+		&cli.StringSliceFlag{
+			Name:     "metadata",
+			Usage:    "show the topology of the networks with the metadata. Example: --metadata one=two --metadata three=four",
+			Required: false,
+		},
+		&cli.GenericFlag{
+			Name:    "output",
+			Aliases: []string{"o"},
+			Value: &utils.EnumValue{
+				Enum:    topology.DOTFormat.StringList(),
+				Default: topology.DOTFormat.String(),
+			},
+			Usage: fmt.Sprintf("output in %s", strings.Join(topology.DOTFormat.StringList(), ", ")),
+		},
+	},
+	Action: func(c *cli.Context) error {
+		client, err := client.NewNetworkClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+		md, err := cmeta.StringSliceToMap(c.StringSlice("metadata"))
+		if err != nil {
+			_ = cli.ShowCommandHelp(c, "topology")
+			return cli.NewExitError(err, 1)
+		}
+		graph, err := topology.Build(client, topology.Opts{
+			NetworkIDs: c.StringSlice("network-id"),
+			Metadata:   md,
+		})
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		out, err := graph.Render(topology.Format(c.String("output")))
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		_, _ = fmt.Fprint(c.App.Writer, out)
+		return nil
+	},
+}
+
 var Commands = cli.Command{
 	Name:  "network",
 	Usage: "GCloud networks API",
@@ -289,6 +344,7 @@ var Commands = cli.Command{
 		&networkUpdateCommand,
 		&extensionCommands,
 		&networkInstancePortCommand,
+		&networkTopologyCommand,
 		{
 			Name:  "metadata",
 			Usage: "Network metadata",
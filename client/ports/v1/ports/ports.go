@@ -3,6 +3,7 @@ package ports
 import (
 	"errors"
 
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/client/ports/v1/client"
 	"github.com/G-Core/gcorelabscloud-go/gcore/port/v1/ports"
 	"github.com/G-Core/gcorelabscloud-go/gcore/reservedfixedip/v1/reservedfixedips"
@@ -136,5 +137,244 @@ var Commands = cli.Command{
 		&portSecurityEnableSubCommand,
 		&portSecurityDisableSubCommand,
 		&assignAllowedAddressPairsSubCommand,
+		&portListSubCommand,
+		&portGetSubCommand,
+		{
+			Name:  "address_pair",
+			Usage: "Port allowed address pairs",
+			Subcommands: []*cli.Command{
+				&addressPairListSubCommand,
+				&addressPairAddSubCommand,
+				&addressPairRemoveSubCommand,
+			},
+		},
+		{
+			Name:  "security_group",
+			Usage: "Port security groups",
+			Subcommands: []*cli.Command{
+				&portSecurityGroupAssignSubCommand,
+				&portSecurityGroupUnassignSubCommand,
+			},
+		},
+	},
+}
+
+var portListSubCommand = cli.Command{
+	Name:     "list",
+	Usage:    "List instance ports",
+	Category: "port",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "network-id",
+			Usage: "Filter ports by network ID",
+		},
+		&cli.StringFlag{
+			Name:  "device-id",
+			Usage: "Filter ports by instance ID",
+		},
+		&cli.StringFlag{
+			Name:  "mac-address",
+			Usage: "Filter ports by MAC address",
+		},
+		&cli.StringFlag{
+			Name:  "ip-address",
+			Usage: "Filter ports by fixed IP address",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		client, err := client.NewPortClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+
+		opts := ports.ListOpts{
+			NetworkID:  c.String("network-id"),
+			DeviceID:   c.String("device-id"),
+			MacAddress: c.String("mac-address"),
+			IPAddress:  c.String("ip-address"),
+		}
+		results, err := ports.ListAll(client, opts)
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		utils.ShowResults(results, c.String("format"))
+		return nil
+	},
+}
+
+var portGetSubCommand = cli.Command{
+	Name:      "show",
+	Usage:     "Show instance port",
+	ArgsUsage: "<port_id>",
+	Category:  "port",
+	Action: func(c *cli.Context) error {
+		portID, err := flags.GetFirstStringArg(c, portIDText)
+		if err != nil {
+			_ = cli.ShowCommandHelp(c, "show")
+			return err
+		}
+		client, err := client.NewPortClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+
+		port, err := ports.Get(client, portID).Extract()
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		utils.ShowResults(port, c.String("format"))
+		return nil
+	},
+}
+
+var addressPairListSubCommand = cli.Command{
+	Name:      "list",
+	Usage:     "List allowed address pairs of instance port",
+	ArgsUsage: "<port_id>",
+	Category:  "address_pair",
+	Action: func(c *cli.Context) error {
+		portID, err := flags.GetFirstStringArg(c, portIDText)
+		if err != nil {
+			_ = cli.ShowCommandHelp(c, "list")
+			return err
+		}
+		client, err := client.NewPortClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+
+		pairs, err := ports.ListAddressPairs(client, portID)
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		utils.ShowResults(pairs, c.String("format"))
+		return nil
+	},
+}
+
+var addressPairFlags = []cli.Flag{
+	&cli.StringSliceFlag{
+		Name:     "ip-address",
+		Usage:    "IP address or CIDR of the allowed address pair",
+		Required: true,
+	},
+	&cli.StringSliceFlag{
+		Name:  "mac-address",
+		Usage: "MAC address of the allowed address pair, one per ip-address when set",
+	},
+}
+
+// addressPairsFromFlags returns the address pairs of the ip-address and mac-address flags, the MAC addresses are
+// optional.
+func addressPairsFromFlags(c *cli.Context) ([]reservedfixedips.AllowedAddressPairs, error) {
+	ips, macs := c.StringSlice("ip-address"), c.StringSlice("mac-address")
+	if len(macs) == 0 {
+		macs = make([]string, len(ips))
+	}
+	return getAddressPairs(ips, macs)
+}
+
+// changeAddressPairs runs an address pair change with the pairs of the flags and shows the port.
+func changeAddressPairs(c *cli.Context, change func(*gcorecloud.ServiceClient, string, ...reservedfixedips.AllowedAddressPairs) (*ports.InstancePort, error)) error {
+	portID, err := flags.GetFirstStringArg(c, portIDText)
+	if err != nil {
+		_ = cli.ShowCommandHelp(c, c.Command.Name)
+		return err
+	}
+	client, err := client.NewPortClientV1(c)
+	if err != nil {
+		_ = cli.ShowAppHelp(c)
+		return cli.NewExitError(err, 1)
+	}
+
+	pairs, err := addressPairsFromFlags(c)
+	if err != nil {
+		_ = cli.ShowCommandHelp(c, c.Command.Name)
+		return cli.NewExitError(err, 1)
+	}
+	result, err := change(client, portID, pairs...)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	utils.ShowResults(result, c.String("format"))
+	return nil
+}
+
+var addressPairAddSubCommand = cli.Command{
+	Name:      "add",
+	Usage:     "Add allowed address pairs to instance port, keeping its other pairs",
+	ArgsUsage: "<port_id>",
+	Category:  "address_pair",
+	Flags:     addressPairFlags,
+	Action: func(c *cli.Context) error {
+		return changeAddressPairs(c, ports.MergeAddressPairs)
+	},
+}
+
+var addressPairRemoveSubCommand = cli.Command{
+	Name:      "remove",
+	Usage:     "Remove allowed address pairs from instance port, a pair without MAC address removes all pairs of its IP",
+	ArgsUsage: "<port_id>",
+	Category:  "address_pair",
+	Flags:     addressPairFlags,
+	Action: func(c *cli.Context) error {
+		return changeAddressPairs(c, ports.RemoveAddressPairs)
+	},
+}
+
+var portSecurityGroupFlags = []cli.Flag{
+	&cli.StringSliceFlag{
+		Name:     "name",
+		Usage:    "security group name",
+		Required: true,
+	},
+}
+
+// changeSecurityGroups runs a security group change with the names of the flags and shows the port.
+func changeSecurityGroups(c *cli.Context, change func(*gcorecloud.ServiceClient, string, ...string) error) error {
+	portID, err := flags.GetFirstStringArg(c, portIDText)
+	if err != nil {
+		_ = cli.ShowCommandHelp(c, c.Command.Name)
+		return err
+	}
+	client, err := client.NewPortClientV1(c)
+	if err != nil {
+		_ = cli.ShowAppHelp(c)
+		return cli.NewExitError(err, 1)
+	}
+
+	if err := change(client, portID, c.StringSlice("name")...); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	port, err := ports.Get(client, portID).Extract()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	utils.ShowResults(port, c.String("format"))
+	return nil
+}
+
+var portSecurityGroupAssignSubCommand = cli.Command{
+	Name:      "assign",
+	Usage:     "Assign security groups to instance port",
+	ArgsUsage: "<port_id>",
+	Category:  "security_group",
+	Flags:     portSecurityGroupFlags,
+	Action: func(c *cli.Context) error {
+		return changeSecurityGroups(c, ports.AssignSecurityGroups)
+	},
+}
+
+var portSecurityGroupUnassignSubCommand = cli.Command{
+	Name:      "unassign",
+	Usage:     "Unassign security groups from instance port",
+	ArgsUsage: "<port_id>",
+	Category:  "security_group",
+	Flags:     portSecurityGroupFlags,
+	Action: func(c *cli.Context) error {
+		return changeSecurityGroups(c, ports.UnassignSecurityGroups)
 	},
 }
@@ -3,13 +3,16 @@ package reservedfixedips
 import (
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/urfave/cli/v2"
 
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/client/flags"
 	"github.com/G-Core/gcorelabscloud-go/client/reservedfixedips/v1/client"
 	"github.com/G-Core/gcorelabscloud-go/client/utils"
 	"github.com/G-Core/gcorelabscloud-go/gcore/reservedfixedip/v1/reservedfixedips"
+	"github.com/G-Core/gcorelabscloud-go/gcore/reservedfixedip/v1/vip"
 	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
 )
 
@@ -28,6 +31,15 @@ var Commands = cli.Command{
 		&reservedFixedIPAddPortSubCommand,
 		&reservedFixedIPReplacePortSubCommand,
 		&reservedFixedIPListAvailablePortSubCommand,
+		{
+			Name:  "vip",
+			Usage: "VIPs shared by instance ports",
+			Subcommands: []*cli.Command{
+				&reservedFixedIPVIPCreateSubCommand,
+				&reservedFixedIPVIPFailoverSubCommand,
+				&reservedFixedIPVIPStatusSubCommand,
+			},
+		},
 	},
 }
 
@@ -388,3 +400,119 @@ var reservedFixedIPReplacePortSubCommand = cli.Command{
 		return nil
 	},
 }
+
+var reservedFixedIPVIPCreateSubCommand = cli.Command{
+	Name:     "create",
+	Usage:    "Reserve a VIP and share it with instance ports, the first port holds it",
+	Category: "vip",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "subnet-id",
+			Usage: "VIP will be allocated in this subnet.",
+		},
+		&cli.StringFlag{
+			Name:  "network-id",
+			Usage: "VIP will be allocated in a subnet of this network if subnet-id is not set.",
+		},
+		&cli.StringFlag{
+			Name:  "ip-address",
+			Usage: "VIP will be allocated the given IP address of the network.",
+		},
+		&cli.StringSliceFlag{
+			Name:     "port-id",
+			Usage:    "Instance port ID that will share the VIP.",
+			Required: true,
+		},
+		&cli.IntFlag{
+			Name:  "wait-seconds",
+			Usage: "maximum time in seconds to wait for the VIP",
+			Value: 300,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		client, err := client.NewReservedFixedIPClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+
+		opts := vip.CreateOpts{
+			SubnetID:  c.String("subnet-id"),
+			NetworkID: c.String("network-id"),
+			PortIDs:   c.StringSlice("port-id"),
+			Wait:      gcorecloud.WaitOpts{Timeout: time.Duration(c.Int("wait-seconds")) * time.Second},
+		}
+		if c.IsSet("ip-address") {
+			if opts.IPAddress = net.ParseIP(c.String("ip-address")); opts.IPAddress == nil {
+				return cli.NewExitError(fmt.Errorf("invalid IP address %q", c.String("ip-address")), 1)
+			}
+		}
+		status, err := vip.Create(client, opts)
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		utils.ShowResults(status, c.String("format"))
+		return nil
+	},
+}
+
+var reservedFixedIPVIPFailoverSubCommand = cli.Command{
+	Name:      "failover",
+	Usage:     "Move the VIP to another port sharing it",
+	ArgsUsage: "<port_id>",
+	Category:  "vip",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "to-port-id",
+			Usage:    "Port ID that will hold the VIP.",
+			Required: true,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		portID, err := flags.GetFirstStringArg(c, portIDText)
+		if err != nil {
+			_ = cli.ShowCommandHelp(c, "failover")
+			return err
+		}
+
+		client, err := client.NewReservedFixedIPClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+
+		status, err := vip.Failover(client, portID, c.String("to-port-id"))
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		utils.ShowResults(status, c.String("format"))
+		return nil
+	},
+}
+
+var reservedFixedIPVIPStatusSubCommand = cli.Command{
+	Name:      "status",
+	Usage:     "Show the port holding the VIP, the candidate ports and the floating IP",
+	ArgsUsage: "<port_id>",
+	Category:  "vip",
+	Action: func(c *cli.Context) error {
+		portID, err := flags.GetFirstStringArg(c, portIDText)
+		if err != nil {
+			_ = cli.ShowCommandHelp(c, "status")
+			return err
+		}
+
+		client, err := client.NewReservedFixedIPClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+
+		status, err := vip.GetStatus(client, portID)
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		utils.ShowResults(status, c.String("format"))
+		return nil
+	},
+}
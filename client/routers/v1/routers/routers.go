@@ -2,15 +2,18 @@ package routers
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/urfave/cli/v2"
 
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/client/flags"
 	"github.com/G-Core/gcorelabscloud-go/client/routers/v1/client"
 	"github.com/G-Core/gcorelabscloud-go/client/subnets/v1/subnets"
 	"github.com/G-Core/gcorelabscloud-go/client/utils"
 	"github.com/G-Core/gcorelabscloud-go/gcore/router/v1/routers"
 	"github.com/G-Core/gcorelabscloud-go/gcore/router/v1/types"
+	gcoresubnets "github.com/G-Core/gcorelabscloud-go/gcore/subnet/v1/subnets"
 	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
 )
 
@@ -27,6 +30,22 @@ var Commands = cli.Command{
 		&routerCreateSubCommand,
 		&routerAttachSubCommand,
 		&routerDetachSubCommand,
+		{
+			Name:  "route",
+			Usage: "Router static routes",
+			Subcommands: []*cli.Command{
+				&routerRouteListSubCommand,
+				&routerRouteAddSubCommand,
+				&routerRouteDeleteSubCommand,
+			},
+		},
+		{
+			Name:  "interface",
+			Usage: "Router interfaces",
+			Subcommands: []*cli.Command{
+				&routerInterfaceSyncSubCommand,
+			},
+		},
 	},
 }
 
@@ -352,3 +371,152 @@ var routerDetachSubCommand = cli.Command{
 		return nil
 	},
 }
+
+var routeFlags = []cli.Flag{
+	&cli.StringSliceFlag{
+		Name:     "route-destination",
+		Aliases:  []string{"rd"},
+		Usage:    "CIDR of destination IPv4 subnet.",
+		Required: true,
+	},
+	&cli.StringSliceFlag{
+		Name:     "route-nexthop",
+		Aliases:  []string{"rh"},
+		Usage:    "IPv4 address to forward traffic to if it's destination IP matches 'destination' CIDR.",
+		Required: true,
+	},
+}
+
+var routerRouteListSubCommand = cli.Command{
+	Name:      "list",
+	Usage:     "List router routes",
+	Category:  "route",
+	ArgsUsage: "<router_id>",
+	Action: func(c *cli.Context) error {
+		routerID, err := flags.GetFirstStringArg(c, routerIDText)
+		if err != nil {
+			_ = cli.ShowCommandHelp(c, "list")
+			return err
+		}
+
+		client, err := client.NewRouterClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+
+		result, err := routers.Get(client, routerID).Extract()
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+
+		utils.ShowResults(result.Routes, c.String("format"))
+		return nil
+	},
+}
+
+var routerRouteAddSubCommand = cli.Command{
+	Name:      "add",
+	Usage:     "Add routes to router, keeping its other routes",
+	Category:  "route",
+	ArgsUsage: "<router_id>",
+	Flags:     routeFlags,
+	Action: func(c *cli.Context) error {
+		return changeRoutes(c, "add", routers.AddRoutes)
+	},
+}
+
+var routerRouteDeleteSubCommand = cli.Command{
+	Name:      "delete",
+	Usage:     "Delete routes from router, keeping its other routes",
+	Category:  "route",
+	ArgsUsage: "<router_id>",
+	Flags:     routeFlags,
+	Action: func(c *cli.Context) error {
+		return changeRoutes(c, "delete", routers.RemoveRoutes)
+	},
+}
+
+func changeRoutes(c *cli.Context, command string, change func(*gcorecloud.ServiceClient, string, ...gcoresubnets.HostRoute) (*routers.Router, error)) error {
+	routerID, err := flags.GetFirstStringArg(c, routerIDText)
+	if err != nil {
+		_ = cli.ShowCommandHelp(c, command)
+		return err
+	}
+	routes, err := subnets.GetHostRoutes(c)
+	if err != nil {
+		_ = cli.ShowCommandHelp(c, command)
+		return cli.NewExitError(err, 1)
+	}
+
+	client, err := client.NewRouterClientV1(c)
+	if err != nil {
+		_ = cli.ShowAppHelp(c)
+		return cli.NewExitError(err, 1)
+	}
+
+	result, err := change(client, routerID, routes...)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	utils.ShowResults(result.Routes, c.String("format"))
+	return nil
+}
+
+var routerInterfaceSyncSubCommand = cli.Command{
+	Name:      "sync",
+	Usage:     "Attach and detach subnets so the router has an interface in exactly the given subnets",
+	Category:  "interface",
+	ArgsUsage: "<router_id>",
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:     "subnet-id",
+			Usage:    "ID of a subnet the router should have an interface in. Could be repeated, none detaches all the subnets",
+			Required: false,
+		},
+		&cli.BoolFlag{
+			Name:  "plan",
+			Usage: "show the changes without applying them",
+		},
+		&cli.BoolFlag{
+			Name:  "yes",
+			Usage: "do not ask for confirmation before detaching all the subnets",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		routerID, err := flags.GetFirstStringArg(c, routerIDText)
+		if err != nil {
+			_ = cli.ShowCommandHelp(c, "sync")
+			return err
+		}
+
+		client, err := client.NewRouterClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+
+		subnetIDs := c.StringSlice("subnet-id")
+		sync := routers.SyncInterfaces
+		if c.Bool("plan") {
+			sync = routers.PlanSyncInterfaces
+		} else if len(subnetIDs) == 0 && !c.Bool("yes") {
+			confirmed, err := utils.Confirm(os.Stdin, c.App.Writer, fmt.Sprintf("Detach all the subnets from router %s?", routerID))
+			if err != nil {
+				return cli.NewExitError(err, 1)
+			}
+			if !confirmed {
+				return cli.NewExitError("aborted", 1)
+			}
+		}
+		report, err := sync(client, routerID, subnetIDs)
+		if report != nil {
+			utils.ShowResults(report, c.String("format"))
+		}
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		return nil
+	},
+}
@@ -1,6 +1,7 @@
 package securitygroups
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/G-Core/gcorelabscloud-go/client/utils"
 	"github.com/G-Core/gcorelabscloud-go/gcore/securitygroup/v1/securitygroups"
 	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v2"
 )
 
 var (
@@ -391,6 +393,297 @@ var securityGroupDeepCopySubCommand = cli.Command{
 	},
 }
 
+// ruleSpec is a security group rule of a rules file.
+type ruleSpec struct {
+	Direction      string  `yaml:"direction"`
+	EtherType      string  `yaml:"ethertype"`
+	Protocol       string  `yaml:"protocol"`
+	PortRangeMin   *int    `yaml:"port_range_min"`
+	PortRangeMax   *int    `yaml:"port_range_max"`
+	RemoteIPPrefix *string `yaml:"remote_ip_prefix"`
+	RemoteGroupID  *string `yaml:"remote_group_id"`
+	Description    *string `yaml:"description"`
+}
+
+func (r ruleSpec) toCreateOpts() (securitygroups.CreateSecurityGroupRuleOpts, error) {
+	opts := securitygroups.CreateSecurityGroupRuleOpts{
+		Direction:      types.RuleDirection(r.Direction),
+		EtherType:      types.EtherType(r.EtherType),
+		Protocol:       types.Protocol(r.Protocol),
+		PortRangeMin:   r.PortRangeMin,
+		PortRangeMax:   r.PortRangeMax,
+		RemoteIPPrefix: r.RemoteIPPrefix,
+		RemoteGroupID:  r.RemoteGroupID,
+		Description:    r.Description,
+	}
+	if err := opts.Direction.IsValid(); err != nil {
+		return opts, fmt.Errorf("%w, should be one of %s", err, strings.Join(directionTypeList, ", "))
+	}
+	// The ethertype and the protocol default to the values the rule is compared with.
+	normalized := securitygroups.NormalizeRule(opts)
+	if opts.EtherType == "" {
+		opts.EtherType = normalized.EtherType
+	}
+	if opts.Protocol == "" {
+		opts.Protocol = normalized.Protocol
+	}
+	// A single port bound applies to both, the ICMP type and code are left as is.
+	icmp := normalized.Protocol == types.ProtocolICMP || normalized.Protocol == types.ProtocolIPv6ICMP
+	if !icmp && opts.PortRangeMin != nil && opts.PortRangeMax == nil {
+		opts.PortRangeMax = opts.PortRangeMin
+	}
+	if err := opts.EtherType.IsValid(); err != nil {
+		return opts, fmt.Errorf("%w, should be one of %s", err, strings.Join(etherTypeTypeList, ", "))
+	}
+	if err := opts.Protocol.IsValid(); err != nil {
+		return opts, fmt.Errorf("%w, should be one of %s", err, strings.Join(protocolTypeList, ", "))
+	}
+	return opts, nil
+}
+
+// readRulesFile reads the desired rules of a security group from a YAML or JSON file with a rules list.
+func readRulesFile(filename string) ([]securitygroups.CreateSecurityGroupRuleOpts, error) {
+	content, err := utils.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var file struct {
+		Rules []ruleSpec `yaml:"rules"`
+	}
+	if err := yaml.UnmarshalStrict(content, &file); err != nil {
+		return nil, fmt.Errorf("cannot parse %s: %w", filename, err)
+	}
+	rules := make([]securitygroups.CreateSecurityGroupRuleOpts, 0, len(file.Rules))
+	for i, spec := range file.Rules {
+		rule, err := spec.toCreateOpts()
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+var securityGroupApplySubCommand = cli.Command{
+	Name:      "apply",
+	Usage:     "Converge security group rules to the rules of a file",
+	ArgsUsage: "<securitygroup_id>",
+	Category:  "securitygroup",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "file",
+			Aliases:  []string{"f"},
+			Usage:    "YAML or JSON file with the desired rules",
+			Required: true,
+		},
+		&cli.BoolFlag{
+			Name:  "plan",
+			Usage: "show the changes without applying them",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		securityGroupID, err := flags.GetFirstStringArg(c, securityGroupIDText)
+		if err != nil {
+			_ = cli.ShowCommandHelp(c, "apply")
+			return err
+		}
+		rules, err := readRulesFile(c.String("file"))
+		if err != nil {
+			_ = cli.ShowCommandHelp(c, "apply")
+			return cli.NewExitError(err, 1)
+		}
+		client, err := client.NewSecurityGroupClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+
+		sync := securitygroups.Sync
+		if c.Bool("plan") {
+			sync = securitygroups.PlanSync
+		}
+		report, err := sync(client, securityGroupID, rules)
+		if report != nil {
+			utils.ShowResults(report, c.String("format"))
+		}
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		return nil
+	},
+}
+
+var securityGroupExportSubCommand = cli.Command{
+	Name:      "export",
+	Usage:     "Export security groups to a portable YAML or JSON document",
+	ArgsUsage: "[<securitygroup_id>...]",
+	Category:  "securitygroup",
+	Flags: []cli.Flag{
+		&cli.GenericFlag{
+			Name: "output",
+			Value: &utils.EnumValue{
+				Enum:    []string{"yaml", "json"},
+				Default: "yaml",
+			},
+			Usage: "output in yaml or json",
+		},
+		&cli.StringFlag{
+			Name:    "file",
+			Aliases: []string{"f"},
+			Usage:   "write the document to the file instead of the standard output",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		client, err := client.NewSecurityGroupClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+		doc, err := securitygroups.Export(client, securitygroups.ExportOpts{SecurityGroupIDs: c.Args().Slice()})
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+
+		var content []byte
+		if c.String("output") == "json" {
+			content, err = json.MarshalIndent(doc, "", "  ")
+			content = append(content, '\n')
+		} else {
+			content, err = yaml.Marshal(doc)
+		}
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		if filename := c.String("file"); filename != "" {
+			if err := utils.WriteToFile(filename, content); err != nil {
+				return cli.NewExitError(err, 1)
+			}
+			return nil
+		}
+		_, err = c.App.Writer.Write(content)
+		return err
+	},
+}
+
+var securityGroupImportSubCommand = cli.Command{
+	Name:     "import",
+	Usage:    "Create or update security groups from a portable YAML or JSON document",
+	Category: "securitygroup",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "file",
+			Aliases:  []string{"f"},
+			Usage:    "document written by the export command",
+			Required: true,
+		},
+		&cli.BoolFlag{
+			Name:  "plan",
+			Usage: "show the changes without applying them",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		content, err := utils.ReadFile(c.String("file"))
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		var doc securitygroups.Document
+		if err := yaml.UnmarshalStrict(content, &doc); err != nil {
+			return cli.NewExitError(fmt.Errorf("cannot parse %s: %w", c.String("file"), err), 1)
+		}
+		client, err := client.NewSecurityGroupClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+
+		report, err := securitygroups.Import(client, doc, securitygroups.ImportOpts{Plan: c.Bool("plan")})
+		if report != nil {
+			utils.ShowResults(report, c.String("format"))
+		}
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		return nil
+	},
+}
+
+var securityGroupLintSubCommand = cli.Command{
+	Name:      "lint",
+	Usage:     "Check security groups for risky rules. Exits with code 2 on findings of the fail-on severity",
+	ArgsUsage: "[<securitygroup_id>...]",
+	Category:  "securitygroup",
+	Flags: []cli.Flag{
+		&cli.GenericFlag{
+			Name: "output",
+			Value: &utils.EnumValue{
+				Enum:    []string{"table", "json", "sarif"},
+				Default: "table",
+			},
+			Usage: "output in table, json or sarif",
+		},
+		&cli.IntSliceFlag{
+			Name:     "sensitive-port",
+			Usage:    "port which should not be open to any address, the SSH, RDP and database ports if not set",
+			Required: false,
+		},
+		&cli.BoolFlag{
+			Name:  "skip-unused",
+			Usage: "skip the check of the groups not used by any instance",
+		},
+		&cli.GenericFlag{
+			Name: "fail-on",
+			Value: &utils.EnumValue{
+				Enum: securitygroups.Severity("").StringList(),
+			},
+			Usage: fmt.Sprintf("lowest severity failing the check, one of %s, disabled if not set",
+				strings.Join(securitygroups.Severity("").StringList(), ", ")),
+		},
+	},
+	Action: func(c *cli.Context) error {
+		client, err := client.NewSecurityGroupClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+		findings, err := securitygroups.Lint(client, securitygroups.LintOpts{
+			SecurityGroupIDs: c.Args().Slice(),
+			SensitivePorts:   c.IntSlice("sensitive-port"),
+			SkipUnused:       c.Bool("skip-unused"),
+		})
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+
+		switch c.String("output") {
+		case "sarif":
+			content, err := securitygroups.ToSARIF(findings)
+			if err != nil {
+				return cli.NewExitError(err, 1)
+			}
+			if _, err := fmt.Fprintln(c.App.Writer, string(content)); err != nil {
+				return err
+			}
+		default:
+			utils.ShowResults(findings, c.String("output"))
+		}
+
+		failOn := securitygroups.Severity(c.String("fail-on"))
+		if failOn == "" {
+			return nil
+		}
+		count := 0
+		for _, f := range findings {
+			if f.Severity.AtLeast(failOn) {
+				count++
+			}
+		}
+		if count > 0 {
+			return cli.NewExitError(fmt.Sprintf("%d findings of %s severity or higher", count, failOn), 2)
+		}
+		return nil
+	},
+}
+
 var Commands = cli.Command{
 	Name:  "securitygroup",
 	Usage: "GCloud security groups API",
@@ -401,6 +694,10 @@ var Commands = cli.Command{
 		&securityGroupDeleteSubCommand,
 		&securityGroupCreateSubCommand,
 		&securityGroupDeepCopySubCommand,
+		&securityGroupApplySubCommand,
+		&securityGroupExportSubCommand,
+		&securityGroupImportSubCommand,
+		&securityGroupLintSubCommand,
 		{
 			Name:  "instance",
 			Usage: "Security group instances",
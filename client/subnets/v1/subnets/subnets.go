@@ -3,6 +3,7 @@ package subnets
 import (
 	"fmt"
 	"net"
+	"strings"
 
 	cmeta "github.com/G-Core/gcorelabscloud-go/client/utils/metadata"
 
@@ -10,7 +11,10 @@ import (
 
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/client/flags"
+	k8sclient "github.com/G-Core/gcorelabscloud-go/client/k8s/v2/client"
 	"github.com/G-Core/gcorelabscloud-go/client/utils"
+	"github.com/G-Core/gcorelabscloud-go/gcore/k8s/v2/clusters"
+	"github.com/G-Core/gcorelabscloud-go/gcore/subnet/v1/ipam"
 	"github.com/G-Core/gcorelabscloud-go/gcore/subnet/v1/subnets"
 	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
 
@@ -224,6 +228,28 @@ var subnetUpdateCommand = cli.Command{
 	},
 }
 
+// autoCIDR is the --cidr value selecting the first free block of the supernet.
+const autoCIDR = "auto"
+
+func findFreeCIDR(c *cli.Context, client *gcorecloud.ServiceClient) (*gcorecloud.CIDR, error) {
+	supernet, err := gcorecloud.ParseCIDRString(c.String("supernet"))
+	if err != nil {
+		return nil, err
+	}
+	opts := subnets.FreeCIDROpts{Supernet: *supernet, PrefixLength: c.Int("prefix-length")}
+	if c.Bool("exclude-k8s-pools") {
+		k8sClient, err := k8sclient.NewK8sClientV2(c)
+		if err != nil {
+			return nil, err
+		}
+		opts.Reserved, err = clusters.ListAllIPPools(k8sClient)
+		if err != nil {
+			return nil, fmt.Errorf("cannot list k8s IP pools: %w", err)
+		}
+	}
+	return subnets.FindFreeCIDR(client, opts)
+}
+
 var subnetCreateCommand = cli.Command{
 	Name:     "create",
 	Usage:    "Create subnet",
@@ -238,9 +264,26 @@ var subnetCreateCommand = cli.Command{
 		&cli.StringFlag{
 			Name:     "cidr",
 			Aliases:  []string{"c"},
-			Usage:    "Subnet CIDR",
+			Usage:    "Subnet CIDR. auto takes the first free block of the supernet",
 			Required: true,
 		},
+		&cli.StringFlag{
+			Name:     "supernet",
+			Usage:    "Supernet of the subnet CIDR with --cidr auto",
+			Value:    "10.0.0.0/8",
+			Required: false,
+		},
+		&cli.IntFlag{
+			Name:     "prefix-length",
+			Usage:    "Prefix length of the subnet CIDR with --cidr auto",
+			Value:    24,
+			Required: false,
+		},
+		&cli.BoolFlag{
+			Name:     "exclude-k8s-pools",
+			Usage:    "Keep the pods and services IP pools of the k8s clusters out of the subnet CIDR with --cidr auto",
+			Required: false,
+		},
 		&cli.StringFlag{
 			Name:     "network-id",
 			Aliases:  []string{"i"},
@@ -289,7 +332,12 @@ var subnetCreateCommand = cli.Command{
 			_ = cli.ShowAppHelp(c)
 			return cli.NewExitError(err, 1)
 		}
-		cidr, err := gcorecloud.ParseCIDRString(c.String("cidr"))
+		var cidr *gcorecloud.CIDR
+		if c.String("cidr") == autoCIDR {
+			cidr, err = findFreeCIDR(c, client)
+		} else {
+			cidr, err = gcorecloud.ParseCIDRString(c.String("cidr"))
+		}
 		if err != nil {
 			return cli.NewExitError(err, 1)
 		}
@@ -344,6 +392,68 @@ var subnetCreateCommand = cli.Command{
 	},
 }
 
+var subnetUsageCommand = cli.Command{
+	Name:      "usage",
+	Usage:     "Show the IP address usage of subnets. Exits with code 2 when a subnet reaches the threshold",
+	ArgsUsage: "[<subnet_id>...]",
+	Category:  "subnet",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "network-id",
+			Aliases:  []string{"i"},
+			Usage:    "show the subnets of the network",
+			Required: false,
+		},
+		&cli.IntFlag{
+			Name:     "next-free",
+			Usage:    "count of next free addresses shown per subnet",
+			Value:    ipam.DefaultNextFree,
+			Required: false,
+		},
+		&cli.Float64Flag{
+			Name:     "threshold",
+			Aliases:  []string{"t"},
+			Usage:    "used addresses percentage alerting on, disabled if 0",
+			Required: false,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		client, err := client.NewSubnetClientV1(c)
+		if err != nil {
+			_ = cli.ShowAppHelp(c)
+			return cli.NewExitError(err, 1)
+		}
+		nextFree := c.Int("next-free")
+		if nextFree == 0 {
+			nextFree = -1
+		}
+		report, err := ipam.Report(client, ipam.Opts{
+			SubnetIDs: c.Args().Slice(),
+			NetworkID: c.String("network-id"),
+			NextFree:  nextFree,
+		})
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		utils.ShowResults(report, c.String("format"))
+
+		threshold := c.Float64("threshold")
+		if threshold <= 0 {
+			return nil
+		}
+		var exceeded []string
+		for _, u := range report {
+			if u.Exceeds(threshold) {
+				exceeded = append(exceeded, fmt.Sprintf("%s (%s) %.2f%%", u.SubnetID, u.CIDR, u.UsedPercent))
+			}
+		}
+		if len(exceeded) > 0 {
+			return cli.NewExitError(fmt.Sprintf("subnets over %.2f%% usage: %s", threshold, strings.Join(exceeded, ", ")), 2)
+		}
+		return nil
+	},
+}
+
 var Commands = cli.Command{
 	Name:  "subnet",
 	Usage: "GCloud subnets API",
@@ -353,6 +463,7 @@ var Commands = cli.Command{
 		&subnetDeleteCommand,
 		&subnetCreateCommand,
 		&subnetUpdateCommand,
+		&subnetUsageCommand,
 		{
 			Name:  "metadata",
 			Usage: "Network metadata",
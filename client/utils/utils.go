@@ -1,8 +1,10 @@
 package utils
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -221,6 +223,20 @@ func IntFromIndex(content []int, idx int, defaultValue int) int {
 	return defaultValue
 }
 
+// Confirm asks a yes/no question and reports whether the answer is yes.
+func Confirm(r io.Reader, w io.Writer, question string) (bool, error) {
+	_, _ = fmt.Fprintf(w, "%s [y/N]: ", question)
+	answer, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	}
+	return false, nil
+}
+
 func StringSliceToMapInterface(slice []string) (map[string]interface{}, error) {
 	m := make(map[string]interface{})
 	for _, s := range slice {
@@ -0,0 +1,37 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package aiflavors
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of aiflavors operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	List(opts ListOptsBuilder) pagination.Pager
+	ListAll(opts ListOptsBuilder) ([]AIFlavor, error)
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// List calls the package level List function with the service client.
+func (s *Service) List(opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(opts ListOptsBuilder) ([]AIFlavor, error) {
+	return ListAll(s.ServiceClient, opts)
+}
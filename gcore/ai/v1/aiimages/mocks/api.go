@@ -0,0 +1,73 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of aiimages.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/ai/v1/aiimages"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of aiimages.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	ListFunc    func(opts aiimages.ListOptsBuilder) pagination.Pager
+	ListAllFunc func(opts aiimages.ListOptsBuilder) ([]aiimages.AIImage, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ aiimages.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// List implements aiimages.API.
+func (m *API) List(opts aiimages.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: aiimages.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements aiimages.API.
+func (m *API) ListAll(opts aiimages.ListOptsBuilder) ([]aiimages.AIImage, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: aiimages.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
@@ -0,0 +1,190 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package ai
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/utils/metadata"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of ai operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	AssignSecurityGroup(id string, opts instances.SecurityGroupOptsBuilder) SecurityGroupActionResult
+	AttachAIInstanceInterface(instance_id string, opts AttachInterfaceOptsBuilder) tasks.Result
+	Create(opts CreateOptsBuilder) tasks.Result
+	Delete(instanceID string, opts DeleteOptsBuilder) tasks.Result
+	DetachAIInstanceInterface(instance_id string, opts DetachInterfaceOptsBuilder) tasks.Result
+	Get(id string) GetResult
+	GetInstanceConsole(id string) RemoteConsoleResult
+	List() pagination.Pager
+	ListAll() ([]AICluster, error)
+	ListInterfaces(id string) pagination.Pager
+	ListInterfacesAll(id string) ([]Interface, error)
+	ListPorts(id string) pagination.Pager
+	ListPortsAll(id string) ([]AIClusterPort, error)
+	MetadataCreateOrUpdate(id string, opts map[string]interface{}) MetadataActionResult
+	MetadataDelete(id string, key string) MetadataActionResult
+	MetadataGet(id string, key string) MetadataResult
+	MetadataList(id string) pagination.Pager
+	MetadataListAll(id string) ([]metadata.Metadata, error)
+	MetadataReplace(id string, opts map[string]interface{}) MetadataActionResult
+	PowerCycleAICluster(id string) AIClusterActionResult
+	PowerCycleAIInstance(instance_id string) AIInstanceActionResult
+	RebootAICluster(id string) AIClusterActionResult
+	RebootAIInstance(instance_id string) AIInstanceActionResult
+	Resize(id string, opts ResizeAIClusterOptsBuilder) tasks.Result
+	Resume(id string) tasks.Result
+	Suspend(id string) tasks.Result
+	UnAssignSecurityGroup(id string, opts instances.SecurityGroupOptsBuilder) SecurityGroupActionResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// AssignSecurityGroup calls the package level AssignSecurityGroup function with the service client.
+func (s *Service) AssignSecurityGroup(id string, opts instances.SecurityGroupOptsBuilder) SecurityGroupActionResult {
+	return AssignSecurityGroup(s.ServiceClient, id, opts)
+}
+
+// AttachAIInstanceInterface calls the package level AttachAIInstanceInterface function with the service client.
+func (s *Service) AttachAIInstanceInterface(instance_id string, opts AttachInterfaceOptsBuilder) tasks.Result {
+	return AttachAIInstanceInterface(s.ServiceClient, instance_id, opts)
+}
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(instanceID string, opts DeleteOptsBuilder) tasks.Result {
+	return Delete(s.ServiceClient, instanceID, opts)
+}
+
+// DetachAIInstanceInterface calls the package level DetachAIInstanceInterface function with the service client.
+func (s *Service) DetachAIInstanceInterface(instance_id string, opts DetachInterfaceOptsBuilder) tasks.Result {
+	return DetachAIInstanceInterface(s.ServiceClient, instance_id, opts)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id string) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// GetInstanceConsole calls the package level GetInstanceConsole function with the service client.
+func (s *Service) GetInstanceConsole(id string) RemoteConsoleResult {
+	return GetInstanceConsole(s.ServiceClient, id)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List() pagination.Pager {
+	return List(s.ServiceClient)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll() ([]AICluster, error) {
+	return ListAll(s.ServiceClient)
+}
+
+// ListInterfaces calls the package level ListInterfaces function with the service client.
+func (s *Service) ListInterfaces(id string) pagination.Pager {
+	return ListInterfaces(s.ServiceClient, id)
+}
+
+// ListInterfacesAll calls the package level ListInterfacesAll function with the service client.
+func (s *Service) ListInterfacesAll(id string) ([]Interface, error) {
+	return ListInterfacesAll(s.ServiceClient, id)
+}
+
+// ListPorts calls the package level ListPorts function with the service client.
+func (s *Service) ListPorts(id string) pagination.Pager {
+	return ListPorts(s.ServiceClient, id)
+}
+
+// ListPortsAll calls the package level ListPortsAll function with the service client.
+func (s *Service) ListPortsAll(id string) ([]AIClusterPort, error) {
+	return ListPortsAll(s.ServiceClient, id)
+}
+
+// MetadataCreateOrUpdate calls the package level MetadataCreateOrUpdate function with the service client.
+func (s *Service) MetadataCreateOrUpdate(id string, opts map[string]interface{}) MetadataActionResult {
+	return MetadataCreateOrUpdate(s.ServiceClient, id, opts)
+}
+
+// MetadataDelete calls the package level MetadataDelete function with the service client.
+func (s *Service) MetadataDelete(id string, key string) MetadataActionResult {
+	return MetadataDelete(s.ServiceClient, id, key)
+}
+
+// MetadataGet calls the package level MetadataGet function with the service client.
+func (s *Service) MetadataGet(id string, key string) MetadataResult {
+	return MetadataGet(s.ServiceClient, id, key)
+}
+
+// MetadataList calls the package level MetadataList function with the service client.
+func (s *Service) MetadataList(id string) pagination.Pager {
+	return MetadataList(s.ServiceClient, id)
+}
+
+// MetadataListAll calls the package level MetadataListAll function with the service client.
+func (s *Service) MetadataListAll(id string) ([]metadata.Metadata, error) {
+	return MetadataListAll(s.ServiceClient, id)
+}
+
+// MetadataReplace calls the package level MetadataReplace function with the service client.
+func (s *Service) MetadataReplace(id string, opts map[string]interface{}) MetadataActionResult {
+	return MetadataReplace(s.ServiceClient, id, opts)
+}
+
+// PowerCycleAICluster calls the package level PowerCycleAICluster function with the service client.
+func (s *Service) PowerCycleAICluster(id string) AIClusterActionResult {
+	return PowerCycleAICluster(s.ServiceClient, id)
+}
+
+// PowerCycleAIInstance calls the package level PowerCycleAIInstance function with the service client.
+func (s *Service) PowerCycleAIInstance(instance_id string) AIInstanceActionResult {
+	return PowerCycleAIInstance(s.ServiceClient, instance_id)
+}
+
+// RebootAICluster calls the package level RebootAICluster function with the service client.
+func (s *Service) RebootAICluster(id string) AIClusterActionResult {
+	return RebootAICluster(s.ServiceClient, id)
+}
+
+// RebootAIInstance calls the package level RebootAIInstance function with the service client.
+func (s *Service) RebootAIInstance(instance_id string) AIInstanceActionResult {
+	return RebootAIInstance(s.ServiceClient, instance_id)
+}
+
+// Resize calls the package level Resize function with the service client.
+func (s *Service) Resize(id string, opts ResizeAIClusterOptsBuilder) tasks.Result {
+	return Resize(s.ServiceClient, id, opts)
+}
+
+// Resume calls the package level Resume function with the service client.
+func (s *Service) Resume(id string) tasks.Result {
+	return Resume(s.ServiceClient, id)
+}
+
+// Suspend calls the package level Suspend function with the service client.
+func (s *Service) Suspend(id string) tasks.Result {
+	return Suspend(s.ServiceClient, id)
+}
+
+// UnAssignSecurityGroup calls the package level UnAssignSecurityGroup function with the service client.
+func (s *Service) UnAssignSecurityGroup(id string, opts instances.SecurityGroupOptsBuilder) SecurityGroupActionResult {
+	return UnAssignSecurityGroup(s.ServiceClient, id, opts)
+}
@@ -0,0 +1,326 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of ai.API.
+package mocks
+
+import (
+	"sync"
+
+	ai "github.com/G-Core/gcorelabscloud-go/gcore/ai/v1/ais"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/utils/metadata"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of ai.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	AssignSecurityGroupFunc       func(id string, opts instances.SecurityGroupOptsBuilder) ai.SecurityGroupActionResult
+	AttachAIInstanceInterfaceFunc func(instance_id string, opts ai.AttachInterfaceOptsBuilder) tasks.Result
+	CreateFunc                    func(opts ai.CreateOptsBuilder) tasks.Result
+	DeleteFunc                    func(instanceID string, opts ai.DeleteOptsBuilder) tasks.Result
+	DetachAIInstanceInterfaceFunc func(instance_id string, opts ai.DetachInterfaceOptsBuilder) tasks.Result
+	GetFunc                       func(id string) ai.GetResult
+	GetInstanceConsoleFunc        func(id string) ai.RemoteConsoleResult
+	ListFunc                      func() pagination.Pager
+	ListAllFunc                   func() ([]ai.AICluster, error)
+	ListInterfacesFunc            func(id string) pagination.Pager
+	ListInterfacesAllFunc         func(id string) ([]ai.Interface, error)
+	ListPortsFunc                 func(id string) pagination.Pager
+	ListPortsAllFunc              func(id string) ([]ai.AIClusterPort, error)
+	MetadataCreateOrUpdateFunc    func(id string, opts map[string]interface{}) ai.MetadataActionResult
+	MetadataDeleteFunc            func(id string, key string) ai.MetadataActionResult
+	MetadataGetFunc               func(id string, key string) ai.MetadataResult
+	MetadataListFunc              func(id string) pagination.Pager
+	MetadataListAllFunc           func(id string) ([]metadata.Metadata, error)
+	MetadataReplaceFunc           func(id string, opts map[string]interface{}) ai.MetadataActionResult
+	PowerCycleAIClusterFunc       func(id string) ai.AIClusterActionResult
+	PowerCycleAIInstanceFunc      func(instance_id string) ai.AIInstanceActionResult
+	RebootAIClusterFunc           func(id string) ai.AIClusterActionResult
+	RebootAIInstanceFunc          func(instance_id string) ai.AIInstanceActionResult
+	ResizeFunc                    func(id string, opts ai.ResizeAIClusterOptsBuilder) tasks.Result
+	ResumeFunc                    func(id string) tasks.Result
+	SuspendFunc                   func(id string) tasks.Result
+	UnAssignSecurityGroupFunc     func(id string, opts instances.SecurityGroupOptsBuilder) ai.SecurityGroupActionResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ ai.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// AssignSecurityGroup implements ai.API.
+func (m *API) AssignSecurityGroup(id string, opts instances.SecurityGroupOptsBuilder) ai.SecurityGroupActionResult {
+	m.record("AssignSecurityGroup", id, opts)
+	if m.AssignSecurityGroupFunc == nil {
+		panic("mocks: ai.API.AssignSecurityGroupFunc is not set")
+	}
+	return m.AssignSecurityGroupFunc(id, opts)
+}
+
+// AttachAIInstanceInterface implements ai.API.
+func (m *API) AttachAIInstanceInterface(instance_id string, opts ai.AttachInterfaceOptsBuilder) tasks.Result {
+	m.record("AttachAIInstanceInterface", instance_id, opts)
+	if m.AttachAIInstanceInterfaceFunc == nil {
+		panic("mocks: ai.API.AttachAIInstanceInterfaceFunc is not set")
+	}
+	return m.AttachAIInstanceInterfaceFunc(instance_id, opts)
+}
+
+// Create implements ai.API.
+func (m *API) Create(opts ai.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: ai.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements ai.API.
+func (m *API) Delete(instanceID string, opts ai.DeleteOptsBuilder) tasks.Result {
+	m.record("Delete", instanceID, opts)
+	if m.DeleteFunc == nil {
+		panic("mocks: ai.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(instanceID, opts)
+}
+
+// DetachAIInstanceInterface implements ai.API.
+func (m *API) DetachAIInstanceInterface(instance_id string, opts ai.DetachInterfaceOptsBuilder) tasks.Result {
+	m.record("DetachAIInstanceInterface", instance_id, opts)
+	if m.DetachAIInstanceInterfaceFunc == nil {
+		panic("mocks: ai.API.DetachAIInstanceInterfaceFunc is not set")
+	}
+	return m.DetachAIInstanceInterfaceFunc(instance_id, opts)
+}
+
+// Get implements ai.API.
+func (m *API) Get(id string) ai.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: ai.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// GetInstanceConsole implements ai.API.
+func (m *API) GetInstanceConsole(id string) ai.RemoteConsoleResult {
+	m.record("GetInstanceConsole", id)
+	if m.GetInstanceConsoleFunc == nil {
+		panic("mocks: ai.API.GetInstanceConsoleFunc is not set")
+	}
+	return m.GetInstanceConsoleFunc(id)
+}
+
+// List implements ai.API.
+func (m *API) List() pagination.Pager {
+	m.record("List")
+	if m.ListFunc == nil {
+		panic("mocks: ai.API.ListFunc is not set")
+	}
+	return m.ListFunc()
+}
+
+// ListAll implements ai.API.
+func (m *API) ListAll() ([]ai.AICluster, error) {
+	m.record("ListAll")
+	if m.ListAllFunc == nil {
+		panic("mocks: ai.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc()
+}
+
+// ListInterfaces implements ai.API.
+func (m *API) ListInterfaces(id string) pagination.Pager {
+	m.record("ListInterfaces", id)
+	if m.ListInterfacesFunc == nil {
+		panic("mocks: ai.API.ListInterfacesFunc is not set")
+	}
+	return m.ListInterfacesFunc(id)
+}
+
+// ListInterfacesAll implements ai.API.
+func (m *API) ListInterfacesAll(id string) ([]ai.Interface, error) {
+	m.record("ListInterfacesAll", id)
+	if m.ListInterfacesAllFunc == nil {
+		panic("mocks: ai.API.ListInterfacesAllFunc is not set")
+	}
+	return m.ListInterfacesAllFunc(id)
+}
+
+// ListPorts implements ai.API.
+func (m *API) ListPorts(id string) pagination.Pager {
+	m.record("ListPorts", id)
+	if m.ListPortsFunc == nil {
+		panic("mocks: ai.API.ListPortsFunc is not set")
+	}
+	return m.ListPortsFunc(id)
+}
+
+// ListPortsAll implements ai.API.
+func (m *API) ListPortsAll(id string) ([]ai.AIClusterPort, error) {
+	m.record("ListPortsAll", id)
+	if m.ListPortsAllFunc == nil {
+		panic("mocks: ai.API.ListPortsAllFunc is not set")
+	}
+	return m.ListPortsAllFunc(id)
+}
+
+// MetadataCreateOrUpdate implements ai.API.
+func (m *API) MetadataCreateOrUpdate(id string, opts map[string]interface{}) ai.MetadataActionResult {
+	m.record("MetadataCreateOrUpdate", id, opts)
+	if m.MetadataCreateOrUpdateFunc == nil {
+		panic("mocks: ai.API.MetadataCreateOrUpdateFunc is not set")
+	}
+	return m.MetadataCreateOrUpdateFunc(id, opts)
+}
+
+// MetadataDelete implements ai.API.
+func (m *API) MetadataDelete(id string, key string) ai.MetadataActionResult {
+	m.record("MetadataDelete", id, key)
+	if m.MetadataDeleteFunc == nil {
+		panic("mocks: ai.API.MetadataDeleteFunc is not set")
+	}
+	return m.MetadataDeleteFunc(id, key)
+}
+
+// MetadataGet implements ai.API.
+func (m *API) MetadataGet(id string, key string) ai.MetadataResult {
+	m.record("MetadataGet", id, key)
+	if m.MetadataGetFunc == nil {
+		panic("mocks: ai.API.MetadataGetFunc is not set")
+	}
+	return m.MetadataGetFunc(id, key)
+}
+
+// MetadataList implements ai.API.
+func (m *API) MetadataList(id string) pagination.Pager {
+	m.record("MetadataList", id)
+	if m.MetadataListFunc == nil {
+		panic("mocks: ai.API.MetadataListFunc is not set")
+	}
+	return m.MetadataListFunc(id)
+}
+
+// MetadataListAll implements ai.API.
+func (m *API) MetadataListAll(id string) ([]metadata.Metadata, error) {
+	m.record("MetadataListAll", id)
+	if m.MetadataListAllFunc == nil {
+		panic("mocks: ai.API.MetadataListAllFunc is not set")
+	}
+	return m.MetadataListAllFunc(id)
+}
+
+// MetadataReplace implements ai.API.
+func (m *API) MetadataReplace(id string, opts map[string]interface{}) ai.MetadataActionResult {
+	m.record("MetadataReplace", id, opts)
+	if m.MetadataReplaceFunc == nil {
+		panic("mocks: ai.API.MetadataReplaceFunc is not set")
+	}
+	return m.MetadataReplaceFunc(id, opts)
+}
+
+// PowerCycleAICluster implements ai.API.
+func (m *API) PowerCycleAICluster(id string) ai.AIClusterActionResult {
+	m.record("PowerCycleAICluster", id)
+	if m.PowerCycleAIClusterFunc == nil {
+		panic("mocks: ai.API.PowerCycleAIClusterFunc is not set")
+	}
+	return m.PowerCycleAIClusterFunc(id)
+}
+
+// PowerCycleAIInstance implements ai.API.
+func (m *API) PowerCycleAIInstance(instance_id string) ai.AIInstanceActionResult {
+	m.record("PowerCycleAIInstance", instance_id)
+	if m.PowerCycleAIInstanceFunc == nil {
+		panic("mocks: ai.API.PowerCycleAIInstanceFunc is not set")
+	}
+	return m.PowerCycleAIInstanceFunc(instance_id)
+}
+
+// RebootAICluster implements ai.API.
+func (m *API) RebootAICluster(id string) ai.AIClusterActionResult {
+	m.record("RebootAICluster", id)
+	if m.RebootAIClusterFunc == nil {
+		panic("mocks: ai.API.RebootAIClusterFunc is not set")
+	}
+	return m.RebootAIClusterFunc(id)
+}
+
+// RebootAIInstance implements ai.API.
+func (m *API) RebootAIInstance(instance_id string) ai.AIInstanceActionResult {
+	m.record("RebootAIInstance", instance_id)
+	if m.RebootAIInstanceFunc == nil {
+		panic("mocks: ai.API.RebootAIInstanceFunc is not set")
+	}
+	return m.RebootAIInstanceFunc(instance_id)
+}
+
+// Resize implements ai.API.
+func (m *API) Resize(id string, opts ai.ResizeAIClusterOptsBuilder) tasks.Result {
+	m.record("Resize", id, opts)
+	if m.ResizeFunc == nil {
+		panic("mocks: ai.API.ResizeFunc is not set")
+	}
+	return m.ResizeFunc(id, opts)
+}
+
+// Resume implements ai.API.
+func (m *API) Resume(id string) tasks.Result {
+	m.record("Resume", id)
+	if m.ResumeFunc == nil {
+		panic("mocks: ai.API.ResumeFunc is not set")
+	}
+	return m.ResumeFunc(id)
+}
+
+// Suspend implements ai.API.
+func (m *API) Suspend(id string) tasks.Result {
+	m.record("Suspend", id)
+	if m.SuspendFunc == nil {
+		panic("mocks: ai.API.SuspendFunc is not set")
+	}
+	return m.SuspendFunc(id)
+}
+
+// UnAssignSecurityGroup implements ai.API.
+func (m *API) UnAssignSecurityGroup(id string, opts instances.SecurityGroupOptsBuilder) ai.SecurityGroupActionResult {
+	m.record("UnAssignSecurityGroup", id, opts)
+	if m.UnAssignSecurityGroupFunc == nil {
+		panic("mocks: ai.API.UnAssignSecurityGroupFunc is not set")
+	}
+	return m.UnAssignSecurityGroupFunc(id, opts)
+}
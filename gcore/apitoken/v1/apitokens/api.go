@@ -0,0 +1,48 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package apitokens
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+)
+
+// API is the set of apitokens operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Create(clientID int, opts CreateOptsBuilder) CreateResult
+	Delete(clientID int, tokenID int) DeleteResult
+	Get(clientID int, tokenID int) GetResult
+	List(clientID int, opts ListOptsBuilder) ListResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(clientID int, opts CreateOptsBuilder) CreateResult {
+	return Create(s.ServiceClient, clientID, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(clientID int, tokenID int) DeleteResult {
+	return Delete(s.ServiceClient, clientID, tokenID)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(clientID int, tokenID int) GetResult {
+	return Get(s.ServiceClient, clientID, tokenID)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(clientID int, opts ListOptsBuilder) ListResult {
+	return List(s.ServiceClient, clientID, opts)
+}
@@ -0,0 +1,92 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of apitokens.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/apitoken/v1/apitokens"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of apitokens.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc func(clientID int, opts apitokens.CreateOptsBuilder) apitokens.CreateResult
+	DeleteFunc func(clientID int, tokenID int) apitokens.DeleteResult
+	GetFunc    func(clientID int, tokenID int) apitokens.GetResult
+	ListFunc   func(clientID int, opts apitokens.ListOptsBuilder) apitokens.ListResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ apitokens.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements apitokens.API.
+func (m *API) Create(clientID int, opts apitokens.CreateOptsBuilder) apitokens.CreateResult {
+	m.record("Create", clientID, opts)
+	if m.CreateFunc == nil {
+		panic("mocks: apitokens.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(clientID, opts)
+}
+
+// Delete implements apitokens.API.
+func (m *API) Delete(clientID int, tokenID int) apitokens.DeleteResult {
+	m.record("Delete", clientID, tokenID)
+	if m.DeleteFunc == nil {
+		panic("mocks: apitokens.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(clientID, tokenID)
+}
+
+// Get implements apitokens.API.
+func (m *API) Get(clientID int, tokenID int) apitokens.GetResult {
+	m.record("Get", clientID, tokenID)
+	if m.GetFunc == nil {
+		panic("mocks: apitokens.API.GetFunc is not set")
+	}
+	return m.GetFunc(clientID, tokenID)
+}
+
+// List implements apitokens.API.
+func (m *API) List(clientID int, opts apitokens.ListOptsBuilder) apitokens.ListResult {
+	m.record("List", clientID, opts)
+	if m.ListFunc == nil {
+		panic("mocks: apitokens.API.ListFunc is not set")
+	}
+	return m.ListFunc(clientID, opts)
+}
@@ -0,0 +1,30 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package bmcapacity
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+)
+
+// API is the set of bmcapacity operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	GetAvailableNodes() GetAvailableNodesResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// GetAvailableNodes calls the package level GetAvailableNodes function with the service client.
+func (s *Service) GetAvailableNodes() GetAvailableNodesResult {
+	return GetAvailableNodes(s.ServiceClient)
+}
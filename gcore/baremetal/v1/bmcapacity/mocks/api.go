@@ -0,0 +1,62 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of bmcapacity.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/baremetal/v1/bmcapacity"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of bmcapacity.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	GetAvailableNodesFunc func() bmcapacity.GetAvailableNodesResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ bmcapacity.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// GetAvailableNodes implements bmcapacity.API.
+func (m *API) GetAvailableNodes() bmcapacity.GetAvailableNodesResult {
+	m.record("GetAvailableNodes")
+	if m.GetAvailableNodesFunc == nil {
+		panic("mocks: bmcapacity.API.GetAvailableNodesFunc is not set")
+	}
+	return m.GetAvailableNodesFunc()
+}
@@ -0,0 +1,51 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package bminstances
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of bminstances operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Create(opts CreateOptsBuilder) tasks.Result
+	List(opts ListOptsBuilder) pagination.Pager
+	ListAll(opts ListOptsBuilder) ([]instances.Instance, error)
+	Rebuild(instanceID string, opts RebuildInstanceOptsBuilder) tasks.Result
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, opts)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(opts ListOptsBuilder) ([]instances.Instance, error) {
+	return ListAll(s.ServiceClient, opts)
+}
+
+// Rebuild calls the package level Rebuild function with the service client.
+func (s *Service) Rebuild(instanceID string, opts RebuildInstanceOptsBuilder) tasks.Result {
+	return Rebuild(s.ServiceClient, instanceID, opts)
+}
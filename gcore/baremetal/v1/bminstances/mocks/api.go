@@ -0,0 +1,95 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of bminstances.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/baremetal/v1/bminstances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of bminstances.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc  func(opts bminstances.CreateOptsBuilder) tasks.Result
+	ListFunc    func(opts bminstances.ListOptsBuilder) pagination.Pager
+	ListAllFunc func(opts bminstances.ListOptsBuilder) ([]instances.Instance, error)
+	RebuildFunc func(instanceID string, opts bminstances.RebuildInstanceOptsBuilder) tasks.Result
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ bminstances.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements bminstances.API.
+func (m *API) Create(opts bminstances.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: bminstances.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// List implements bminstances.API.
+func (m *API) List(opts bminstances.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: bminstances.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements bminstances.API.
+func (m *API) ListAll(opts bminstances.ListOptsBuilder) ([]instances.Instance, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: bminstances.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// Rebuild implements bminstances.API.
+func (m *API) Rebuild(instanceID string, opts bminstances.RebuildInstanceOptsBuilder) tasks.Result {
+	m.record("Rebuild", instanceID, opts)
+	if m.RebuildFunc == nil {
+		panic("mocks: bminstances.API.RebuildFunc is not set")
+	}
+	return m.RebuildFunc(instanceID, opts)
+}
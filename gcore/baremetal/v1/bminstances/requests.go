@@ -1,10 +1,13 @@
 package bminstances
 
 import (
+	"fmt"
+
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
 	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
 	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/utils/userdata"
 	"github.com/G-Core/gcorelabscloud-go/pagination"
 )
 
@@ -59,22 +62,26 @@ type CreateOptsBuilder interface {
 
 // CreateOpts represents options used to create a instance.
 type CreateOpts struct {
-	Flavor        string                     `json:"flavor" required:"true"`
-	Names         []string                   `json:"names,omitempty" validate:"required_without=NameTemplates"`
-	NameTemplates []string                   `json:"name_templates,omitempty" validate:"required_without=Names"`
-	ImageID       string                     `json:"image_id,omitempty" validate:"required_without=AppTemplateID"`
-	AppTemplateID string                     `json:"apptemplate_id,omitempty" validate:"required_without=ImageID"`
-	Interfaces    []InterfaceOpts            `json:"interfaces" required:"true" validate:"required,dive"`
-	Keypair       string                     `json:"keypair_name,omitempty"`
-	Password      string                     `json:"password" validate:"omitempty,required_with=Username"`
-	Username      string                     `json:"username" validate:"omitempty,required_with=Password"`
-	UserData      string                     `json:"user_data,omitempty" validate:"omitempty,base64"`
-	AppConfig     map[string]interface{}     `json:"app_config,omitempty" validate:"omitempty"`
-	Metadata      *instances.MetadataSetOpts `json:"metadata,omitempty" validate:"omitempty,dive"`
+	Flavor          string                     `json:"flavor" required:"true"`
+	Names           []string                   `json:"names,omitempty" validate:"required_without=NameTemplates"`
+	NameTemplates   []string                   `json:"name_templates,omitempty" validate:"required_without=Names"`
+	ImageID         string                     `json:"image_id,omitempty" validate:"required_without=AppTemplateID"`
+	AppTemplateID   string                     `json:"apptemplate_id,omitempty" validate:"required_without=ImageID"`
+	Interfaces      []InterfaceOpts            `json:"interfaces" required:"true" validate:"required,dive"`
+	Keypair         string                     `json:"keypair_name,omitempty"`
+	Password        string                     `json:"password" validate:"omitempty,required_with=Username"`
+	Username        string                     `json:"username" validate:"omitempty,required_with=Password"`
+	UserData        string                     `json:"user_data,omitempty" validate:"omitempty,base64"`
+	UserDataBuilder *userdata.Builder          `json:"-" validate:"-"`
+	AppConfig       map[string]interface{}     `json:"app_config,omitempty" validate:"omitempty"`
+	Metadata        *instances.MetadataSetOpts `json:"metadata,omitempty" validate:"omitempty,dive"`
 }
 
 // Validate
 func (opts CreateOpts) Validate() error {
+	if opts.UserData != "" && opts.UserDataBuilder != nil {
+		return fmt.Errorf("UserData and UserDataBuilder are mutually exclusive")
+	}
 	return gcorecloud.ValidateStruct(opts)
 }
 
@@ -106,6 +113,11 @@ func (opts CreateOpts) ToInstanceCreateMap() (map[string]interface{}, error) {
 	} else {
 		delete(mp, "metadata")
 	}
+	if opts.UserDataBuilder != nil {
+		if mp["user_data"], err = opts.UserDataBuilder.Build(); err != nil {
+			return nil, err
+		}
+	}
 	return mp, nil
 }
 
@@ -8,6 +8,7 @@ import (
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/gcore/baremetal/v1/bminstances"
 	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/utils/userdata"
 )
 
 func TestValidateCreateInstanceInterfaceOpts(t *testing.T) {
@@ -74,3 +75,25 @@ func TestValidateCreateInstanceInterfaceOpts(t *testing.T) {
 	err = opts.Validate()
 	require.NoError(t, err)
 }
+
+func TestCreateOptsUserDataBuilder(t *testing.T) {
+	builder := userdata.New().ShellScript("setup.sh", "#!/bin/sh\necho setup\n").Gzip(true)
+	expected, err := builder.Build()
+	require.NoError(t, err)
+
+	opts := bminstances.CreateOpts{
+		Flavor:  "bm1-infrastructure-small",
+		Names:   []string{"name"},
+		ImageID: "28bfe198-a003-4283-8dca-ab5da4a71b62",
+		Interfaces: []bminstances.InterfaceOpts{{
+			Type: types.ExternalInterfaceType,
+		}},
+		UserDataBuilder: builder,
+	}
+	mp, err := opts.ToInstanceCreateMap()
+	require.NoError(t, err)
+	require.Equal(t, expected, mp["user_data"])
+
+	opts.UserData = expected
+	require.Error(t, opts.Validate())
+}
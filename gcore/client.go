@@ -321,6 +321,8 @@ func initClientOpts(client *gcorecloud.ProviderClient, eo gcorecloud.EndpointOpt
 	sc.ResourceBase = url
 	sc.Type = clientType
 	sc.RegionID = eo.Region
+	sc.ProjectID = eo.Project
+	sc.Name = eo.Name
 	return sc, nil
 }
 
@@ -0,0 +1,86 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package ddos
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of ddos operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	ActivateProfile(id int, opts ActivateProfileOptsBuilder) tasks.Result
+	CheckRegionCoverage() CheckRegionCoverageResult
+	CreateProfile(opts CreateProfileOptsBuilder) tasks.Result
+	DeleteProfile(profileID int) tasks.Result
+	GetAccessibility() GetAccessStatusResult
+	ListAllProfileTemplates() ([]ProfileTemplate, error)
+	ListAllProfiles() ([]Profile, error)
+	ListProfileTemplates() pagination.Pager
+	ListProfiles() pagination.Pager
+	UpdateProfile(id int, opts UpdateProfileOptsBuilder) tasks.Result
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// ActivateProfile calls the package level ActivateProfile function with the service client.
+func (s *Service) ActivateProfile(id int, opts ActivateProfileOptsBuilder) tasks.Result {
+	return ActivateProfile(s.ServiceClient, id, opts)
+}
+
+// CheckRegionCoverage calls the package level CheckRegionCoverage function with the service client.
+func (s *Service) CheckRegionCoverage() CheckRegionCoverageResult {
+	return CheckRegionCoverage(s.ServiceClient)
+}
+
+// CreateProfile calls the package level CreateProfile function with the service client.
+func (s *Service) CreateProfile(opts CreateProfileOptsBuilder) tasks.Result {
+	return CreateProfile(s.ServiceClient, opts)
+}
+
+// DeleteProfile calls the package level DeleteProfile function with the service client.
+func (s *Service) DeleteProfile(profileID int) tasks.Result {
+	return DeleteProfile(s.ServiceClient, profileID)
+}
+
+// GetAccessibility calls the package level GetAccessibility function with the service client.
+func (s *Service) GetAccessibility() GetAccessStatusResult {
+	return GetAccessibility(s.ServiceClient)
+}
+
+// ListAllProfileTemplates calls the package level ListAllProfileTemplates function with the service client.
+func (s *Service) ListAllProfileTemplates() ([]ProfileTemplate, error) {
+	return ListAllProfileTemplates(s.ServiceClient)
+}
+
+// ListAllProfiles calls the package level ListAllProfiles function with the service client.
+func (s *Service) ListAllProfiles() ([]Profile, error) {
+	return ListAllProfiles(s.ServiceClient)
+}
+
+// ListProfileTemplates calls the package level ListProfileTemplates function with the service client.
+func (s *Service) ListProfileTemplates() pagination.Pager {
+	return ListProfileTemplates(s.ServiceClient)
+}
+
+// ListProfiles calls the package level ListProfiles function with the service client.
+func (s *Service) ListProfiles() pagination.Pager {
+	return ListProfiles(s.ServiceClient)
+}
+
+// UpdateProfile calls the package level UpdateProfile function with the service client.
+func (s *Service) UpdateProfile(id int, opts UpdateProfileOptsBuilder) tasks.Result {
+	return UpdateProfile(s.ServiceClient, id, opts)
+}
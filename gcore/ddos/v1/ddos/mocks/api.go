@@ -0,0 +1,154 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of ddos.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/ddos/v1/ddos"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of ddos.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	ActivateProfileFunc         func(id int, opts ddos.ActivateProfileOptsBuilder) tasks.Result
+	CheckRegionCoverageFunc     func() ddos.CheckRegionCoverageResult
+	CreateProfileFunc           func(opts ddos.CreateProfileOptsBuilder) tasks.Result
+	DeleteProfileFunc           func(profileID int) tasks.Result
+	GetAccessibilityFunc        func() ddos.GetAccessStatusResult
+	ListAllProfileTemplatesFunc func() ([]ddos.ProfileTemplate, error)
+	ListAllProfilesFunc         func() ([]ddos.Profile, error)
+	ListProfileTemplatesFunc    func() pagination.Pager
+	ListProfilesFunc            func() pagination.Pager
+	UpdateProfileFunc           func(id int, opts ddos.UpdateProfileOptsBuilder) tasks.Result
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ ddos.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// ActivateProfile implements ddos.API.
+func (m *API) ActivateProfile(id int, opts ddos.ActivateProfileOptsBuilder) tasks.Result {
+	m.record("ActivateProfile", id, opts)
+	if m.ActivateProfileFunc == nil {
+		panic("mocks: ddos.API.ActivateProfileFunc is not set")
+	}
+	return m.ActivateProfileFunc(id, opts)
+}
+
+// CheckRegionCoverage implements ddos.API.
+func (m *API) CheckRegionCoverage() ddos.CheckRegionCoverageResult {
+	m.record("CheckRegionCoverage")
+	if m.CheckRegionCoverageFunc == nil {
+		panic("mocks: ddos.API.CheckRegionCoverageFunc is not set")
+	}
+	return m.CheckRegionCoverageFunc()
+}
+
+// CreateProfile implements ddos.API.
+func (m *API) CreateProfile(opts ddos.CreateProfileOptsBuilder) tasks.Result {
+	m.record("CreateProfile", opts)
+	if m.CreateProfileFunc == nil {
+		panic("mocks: ddos.API.CreateProfileFunc is not set")
+	}
+	return m.CreateProfileFunc(opts)
+}
+
+// DeleteProfile implements ddos.API.
+func (m *API) DeleteProfile(profileID int) tasks.Result {
+	m.record("DeleteProfile", profileID)
+	if m.DeleteProfileFunc == nil {
+		panic("mocks: ddos.API.DeleteProfileFunc is not set")
+	}
+	return m.DeleteProfileFunc(profileID)
+}
+
+// GetAccessibility implements ddos.API.
+func (m *API) GetAccessibility() ddos.GetAccessStatusResult {
+	m.record("GetAccessibility")
+	if m.GetAccessibilityFunc == nil {
+		panic("mocks: ddos.API.GetAccessibilityFunc is not set")
+	}
+	return m.GetAccessibilityFunc()
+}
+
+// ListAllProfileTemplates implements ddos.API.
+func (m *API) ListAllProfileTemplates() ([]ddos.ProfileTemplate, error) {
+	m.record("ListAllProfileTemplates")
+	if m.ListAllProfileTemplatesFunc == nil {
+		panic("mocks: ddos.API.ListAllProfileTemplatesFunc is not set")
+	}
+	return m.ListAllProfileTemplatesFunc()
+}
+
+// ListAllProfiles implements ddos.API.
+func (m *API) ListAllProfiles() ([]ddos.Profile, error) {
+	m.record("ListAllProfiles")
+	if m.ListAllProfilesFunc == nil {
+		panic("mocks: ddos.API.ListAllProfilesFunc is not set")
+	}
+	return m.ListAllProfilesFunc()
+}
+
+// ListProfileTemplates implements ddos.API.
+func (m *API) ListProfileTemplates() pagination.Pager {
+	m.record("ListProfileTemplates")
+	if m.ListProfileTemplatesFunc == nil {
+		panic("mocks: ddos.API.ListProfileTemplatesFunc is not set")
+	}
+	return m.ListProfileTemplatesFunc()
+}
+
+// ListProfiles implements ddos.API.
+func (m *API) ListProfiles() pagination.Pager {
+	m.record("ListProfiles")
+	if m.ListProfilesFunc == nil {
+		panic("mocks: ddos.API.ListProfilesFunc is not set")
+	}
+	return m.ListProfilesFunc()
+}
+
+// UpdateProfile implements ddos.API.
+func (m *API) UpdateProfile(id int, opts ddos.UpdateProfileOptsBuilder) tasks.Result {
+	m.record("UpdateProfile", id, opts)
+	if m.UpdateProfileFunc == nil {
+		panic("mocks: ddos.API.UpdateProfileFunc is not set")
+	}
+	return m.UpdateProfileFunc(id, opts)
+}
@@ -0,0 +1,98 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package faas
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of faas operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	CreateFunction(nsName string, opts CreateFunctionOptsBuilder) tasks.Result
+	CreateNamespace(opts CreateNamespaceOptsBuilder) tasks.Result
+	DeleteFunction(nsName string, fName string) tasks.Result
+	DeleteNamespace(name string) tasks.Result
+	GetFunction(nsName string, fName string) FunctionResult
+	GetNamespace(name string) NamespaceResult
+	ListFunctions(nsName string, opts ListOptsBuilder) pagination.Pager
+	ListFunctionsALL(nsName string, opts ListOptsBuilder) ([]Function, error)
+	ListNamespace(opts ListOptsBuilder) pagination.Pager
+	ListNamespaceALL(opts ListOptsBuilder) ([]Namespace, error)
+	UpdateFunction(nsName string, fName string, opts UpdateFunctionOptsBuilder) tasks.Result
+	UpdateNamespace(name string, opts UpdateNamespaceOptsBuilder) tasks.Result
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// CreateFunction calls the package level CreateFunction function with the service client.
+func (s *Service) CreateFunction(nsName string, opts CreateFunctionOptsBuilder) tasks.Result {
+	return CreateFunction(s.ServiceClient, nsName, opts)
+}
+
+// CreateNamespace calls the package level CreateNamespace function with the service client.
+func (s *Service) CreateNamespace(opts CreateNamespaceOptsBuilder) tasks.Result {
+	return CreateNamespace(s.ServiceClient, opts)
+}
+
+// DeleteFunction calls the package level DeleteFunction function with the service client.
+func (s *Service) DeleteFunction(nsName string, fName string) tasks.Result {
+	return DeleteFunction(s.ServiceClient, nsName, fName)
+}
+
+// DeleteNamespace calls the package level DeleteNamespace function with the service client.
+func (s *Service) DeleteNamespace(name string) tasks.Result {
+	return DeleteNamespace(s.ServiceClient, name)
+}
+
+// GetFunction calls the package level GetFunction function with the service client.
+func (s *Service) GetFunction(nsName string, fName string) FunctionResult {
+	return GetFunction(s.ServiceClient, nsName, fName)
+}
+
+// GetNamespace calls the package level GetNamespace function with the service client.
+func (s *Service) GetNamespace(name string) NamespaceResult {
+	return GetNamespace(s.ServiceClient, name)
+}
+
+// ListFunctions calls the package level ListFunctions function with the service client.
+func (s *Service) ListFunctions(nsName string, opts ListOptsBuilder) pagination.Pager {
+	return ListFunctions(s.ServiceClient, nsName, opts)
+}
+
+// ListFunctionsALL calls the package level ListFunctionsALL function with the service client.
+func (s *Service) ListFunctionsALL(nsName string, opts ListOptsBuilder) ([]Function, error) {
+	return ListFunctionsALL(s.ServiceClient, nsName, opts)
+}
+
+// ListNamespace calls the package level ListNamespace function with the service client.
+func (s *Service) ListNamespace(opts ListOptsBuilder) pagination.Pager {
+	return ListNamespace(s.ServiceClient, opts)
+}
+
+// ListNamespaceALL calls the package level ListNamespaceALL function with the service client.
+func (s *Service) ListNamespaceALL(opts ListOptsBuilder) ([]Namespace, error) {
+	return ListNamespaceALL(s.ServiceClient, opts)
+}
+
+// UpdateFunction calls the package level UpdateFunction function with the service client.
+func (s *Service) UpdateFunction(nsName string, fName string, opts UpdateFunctionOptsBuilder) tasks.Result {
+	return UpdateFunction(s.ServiceClient, nsName, fName, opts)
+}
+
+// UpdateNamespace calls the package level UpdateNamespace function with the service client.
+func (s *Service) UpdateNamespace(name string, opts UpdateNamespaceOptsBuilder) tasks.Result {
+	return UpdateNamespace(s.ServiceClient, name, opts)
+}
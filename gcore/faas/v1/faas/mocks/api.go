@@ -0,0 +1,174 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of faas.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/faas/v1/faas"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of faas.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunctionFunc   func(nsName string, opts faas.CreateFunctionOptsBuilder) tasks.Result
+	CreateNamespaceFunc  func(opts faas.CreateNamespaceOptsBuilder) tasks.Result
+	DeleteFunctionFunc   func(nsName string, fName string) tasks.Result
+	DeleteNamespaceFunc  func(name string) tasks.Result
+	GetFunctionFunc      func(nsName string, fName string) faas.FunctionResult
+	GetNamespaceFunc     func(name string) faas.NamespaceResult
+	ListFunctionsFunc    func(nsName string, opts faas.ListOptsBuilder) pagination.Pager
+	ListFunctionsALLFunc func(nsName string, opts faas.ListOptsBuilder) ([]faas.Function, error)
+	ListNamespaceFunc    func(opts faas.ListOptsBuilder) pagination.Pager
+	ListNamespaceALLFunc func(opts faas.ListOptsBuilder) ([]faas.Namespace, error)
+	UpdateFunctionFunc   func(nsName string, fName string, opts faas.UpdateFunctionOptsBuilder) tasks.Result
+	UpdateNamespaceFunc  func(name string, opts faas.UpdateNamespaceOptsBuilder) tasks.Result
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ faas.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// CreateFunction implements faas.API.
+func (m *API) CreateFunction(nsName string, opts faas.CreateFunctionOptsBuilder) tasks.Result {
+	m.record("CreateFunction", nsName, opts)
+	if m.CreateFunctionFunc == nil {
+		panic("mocks: faas.API.CreateFunctionFunc is not set")
+	}
+	return m.CreateFunctionFunc(nsName, opts)
+}
+
+// CreateNamespace implements faas.API.
+func (m *API) CreateNamespace(opts faas.CreateNamespaceOptsBuilder) tasks.Result {
+	m.record("CreateNamespace", opts)
+	if m.CreateNamespaceFunc == nil {
+		panic("mocks: faas.API.CreateNamespaceFunc is not set")
+	}
+	return m.CreateNamespaceFunc(opts)
+}
+
+// DeleteFunction implements faas.API.
+func (m *API) DeleteFunction(nsName string, fName string) tasks.Result {
+	m.record("DeleteFunction", nsName, fName)
+	if m.DeleteFunctionFunc == nil {
+		panic("mocks: faas.API.DeleteFunctionFunc is not set")
+	}
+	return m.DeleteFunctionFunc(nsName, fName)
+}
+
+// DeleteNamespace implements faas.API.
+func (m *API) DeleteNamespace(name string) tasks.Result {
+	m.record("DeleteNamespace", name)
+	if m.DeleteNamespaceFunc == nil {
+		panic("mocks: faas.API.DeleteNamespaceFunc is not set")
+	}
+	return m.DeleteNamespaceFunc(name)
+}
+
+// GetFunction implements faas.API.
+func (m *API) GetFunction(nsName string, fName string) faas.FunctionResult {
+	m.record("GetFunction", nsName, fName)
+	if m.GetFunctionFunc == nil {
+		panic("mocks: faas.API.GetFunctionFunc is not set")
+	}
+	return m.GetFunctionFunc(nsName, fName)
+}
+
+// GetNamespace implements faas.API.
+func (m *API) GetNamespace(name string) faas.NamespaceResult {
+	m.record("GetNamespace", name)
+	if m.GetNamespaceFunc == nil {
+		panic("mocks: faas.API.GetNamespaceFunc is not set")
+	}
+	return m.GetNamespaceFunc(name)
+}
+
+// ListFunctions implements faas.API.
+func (m *API) ListFunctions(nsName string, opts faas.ListOptsBuilder) pagination.Pager {
+	m.record("ListFunctions", nsName, opts)
+	if m.ListFunctionsFunc == nil {
+		panic("mocks: faas.API.ListFunctionsFunc is not set")
+	}
+	return m.ListFunctionsFunc(nsName, opts)
+}
+
+// ListFunctionsALL implements faas.API.
+func (m *API) ListFunctionsALL(nsName string, opts faas.ListOptsBuilder) ([]faas.Function, error) {
+	m.record("ListFunctionsALL", nsName, opts)
+	if m.ListFunctionsALLFunc == nil {
+		panic("mocks: faas.API.ListFunctionsALLFunc is not set")
+	}
+	return m.ListFunctionsALLFunc(nsName, opts)
+}
+
+// ListNamespace implements faas.API.
+func (m *API) ListNamespace(opts faas.ListOptsBuilder) pagination.Pager {
+	m.record("ListNamespace", opts)
+	if m.ListNamespaceFunc == nil {
+		panic("mocks: faas.API.ListNamespaceFunc is not set")
+	}
+	return m.ListNamespaceFunc(opts)
+}
+
+// ListNamespaceALL implements faas.API.
+func (m *API) ListNamespaceALL(opts faas.ListOptsBuilder) ([]faas.Namespace, error) {
+	m.record("ListNamespaceALL", opts)
+	if m.ListNamespaceALLFunc == nil {
+		panic("mocks: faas.API.ListNamespaceALLFunc is not set")
+	}
+	return m.ListNamespaceALLFunc(opts)
+}
+
+// UpdateFunction implements faas.API.
+func (m *API) UpdateFunction(nsName string, fName string, opts faas.UpdateFunctionOptsBuilder) tasks.Result {
+	m.record("UpdateFunction", nsName, fName, opts)
+	if m.UpdateFunctionFunc == nil {
+		panic("mocks: faas.API.UpdateFunctionFunc is not set")
+	}
+	return m.UpdateFunctionFunc(nsName, fName, opts)
+}
+
+// UpdateNamespace implements faas.API.
+func (m *API) UpdateNamespace(name string, opts faas.UpdateNamespaceOptsBuilder) tasks.Result {
+	m.record("UpdateNamespace", name, opts)
+	if m.UpdateNamespaceFunc == nil {
+		panic("mocks: faas.API.UpdateNamespaceFunc is not set")
+	}
+	return m.UpdateNamespaceFunc(name, opts)
+}
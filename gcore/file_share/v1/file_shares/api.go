@@ -0,0 +1,128 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package file_shares
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of file_shares operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Create(opts CreateOptsBuilder) tasks.Result
+	CreateAccessRule(fileShareID string, opts CreateAccessRuleOptsBuilder) CreateAccessRuleResult
+	Delete(fileShareID string) tasks.Result
+	DeleteAccessRule(fileShareID string, ruleID string) DeleteResult
+	Extend(fileShareID string, opts ResizeOptsBuilder) tasks.Result
+	Get(id string) GetResult
+	List() pagination.Pager
+	ListAccessRules(fileShareID string) pagination.Pager
+	ListAll() ([]FileShare, error)
+	MetadataCreateOrUpdate(id string, opts map[string]interface{}) MetadataActionResult
+	MetadataDelete(id string, key string) MetadataActionResult
+	MetadataGet(id string, key string) MetadataResult
+	MetadataList(id string) pagination.Pager
+	MetadataListAll(id string) ([]Metadata, error)
+	MetadataReplace(id string, opts map[string]interface{}) MetadataActionResult
+	Update(fileShareID string, opts UpdateOptsBuilder) UpdateResult
+	WaitForStatus(id string, status string, opts gcorecloud.WaitOpts) (*FileShare, error)
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, opts)
+}
+
+// CreateAccessRule calls the package level CreateAccessRule function with the service client.
+func (s *Service) CreateAccessRule(fileShareID string, opts CreateAccessRuleOptsBuilder) CreateAccessRuleResult {
+	return CreateAccessRule(s.ServiceClient, fileShareID, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(fileShareID string) tasks.Result {
+	return Delete(s.ServiceClient, fileShareID)
+}
+
+// DeleteAccessRule calls the package level DeleteAccessRule function with the service client.
+func (s *Service) DeleteAccessRule(fileShareID string, ruleID string) DeleteResult {
+	return DeleteAccessRule(s.ServiceClient, fileShareID, ruleID)
+}
+
+// Extend calls the package level Extend function with the service client.
+func (s *Service) Extend(fileShareID string, opts ResizeOptsBuilder) tasks.Result {
+	return Extend(s.ServiceClient, fileShareID, opts)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id string) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List() pagination.Pager {
+	return List(s.ServiceClient)
+}
+
+// ListAccessRules calls the package level ListAccessRules function with the service client.
+func (s *Service) ListAccessRules(fileShareID string) pagination.Pager {
+	return ListAccessRules(s.ServiceClient, fileShareID)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll() ([]FileShare, error) {
+	return ListAll(s.ServiceClient)
+}
+
+// MetadataCreateOrUpdate calls the package level MetadataCreateOrUpdate function with the service client.
+func (s *Service) MetadataCreateOrUpdate(id string, opts map[string]interface{}) MetadataActionResult {
+	return MetadataCreateOrUpdate(s.ServiceClient, id, opts)
+}
+
+// MetadataDelete calls the package level MetadataDelete function with the service client.
+func (s *Service) MetadataDelete(id string, key string) MetadataActionResult {
+	return MetadataDelete(s.ServiceClient, id, key)
+}
+
+// MetadataGet calls the package level MetadataGet function with the service client.
+func (s *Service) MetadataGet(id string, key string) MetadataResult {
+	return MetadataGet(s.ServiceClient, id, key)
+}
+
+// MetadataList calls the package level MetadataList function with the service client.
+func (s *Service) MetadataList(id string) pagination.Pager {
+	return MetadataList(s.ServiceClient, id)
+}
+
+// MetadataListAll calls the package level MetadataListAll function with the service client.
+func (s *Service) MetadataListAll(id string) ([]Metadata, error) {
+	return MetadataListAll(s.ServiceClient, id)
+}
+
+// MetadataReplace calls the package level MetadataReplace function with the service client.
+func (s *Service) MetadataReplace(id string, opts map[string]interface{}) MetadataActionResult {
+	return MetadataReplace(s.ServiceClient, id, opts)
+}
+
+// Update calls the package level Update function with the service client.
+func (s *Service) Update(fileShareID string, opts UpdateOptsBuilder) UpdateResult {
+	return Update(s.ServiceClient, fileShareID, opts)
+}
+
+// WaitForStatus calls the package level WaitForStatus function with the service client.
+func (s *Service) WaitForStatus(id string, status string, opts gcorecloud.WaitOpts) (*FileShare, error) {
+	return WaitForStatus(s.ServiceClient, id, status, opts)
+}
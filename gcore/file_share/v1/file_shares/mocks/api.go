@@ -0,0 +1,225 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of file_shares.API.
+package mocks
+
+import (
+	"sync"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/file_share/v1/file_shares"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of file_shares.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc                 func(opts file_shares.CreateOptsBuilder) tasks.Result
+	CreateAccessRuleFunc       func(fileShareID string, opts file_shares.CreateAccessRuleOptsBuilder) file_shares.CreateAccessRuleResult
+	DeleteFunc                 func(fileShareID string) tasks.Result
+	DeleteAccessRuleFunc       func(fileShareID string, ruleID string) file_shares.DeleteResult
+	ExtendFunc                 func(fileShareID string, opts file_shares.ResizeOptsBuilder) tasks.Result
+	GetFunc                    func(id string) file_shares.GetResult
+	ListFunc                   func() pagination.Pager
+	ListAccessRulesFunc        func(fileShareID string) pagination.Pager
+	ListAllFunc                func() ([]file_shares.FileShare, error)
+	MetadataCreateOrUpdateFunc func(id string, opts map[string]interface{}) file_shares.MetadataActionResult
+	MetadataDeleteFunc         func(id string, key string) file_shares.MetadataActionResult
+	MetadataGetFunc            func(id string, key string) file_shares.MetadataResult
+	MetadataListFunc           func(id string) pagination.Pager
+	MetadataListAllFunc        func(id string) ([]file_shares.Metadata, error)
+	MetadataReplaceFunc        func(id string, opts map[string]interface{}) file_shares.MetadataActionResult
+	UpdateFunc                 func(fileShareID string, opts file_shares.UpdateOptsBuilder) file_shares.UpdateResult
+	WaitForStatusFunc          func(id string, status string, opts gcorecloud.WaitOpts) (*file_shares.FileShare, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ file_shares.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements file_shares.API.
+func (m *API) Create(opts file_shares.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: file_shares.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// CreateAccessRule implements file_shares.API.
+func (m *API) CreateAccessRule(fileShareID string, opts file_shares.CreateAccessRuleOptsBuilder) file_shares.CreateAccessRuleResult {
+	m.record("CreateAccessRule", fileShareID, opts)
+	if m.CreateAccessRuleFunc == nil {
+		panic("mocks: file_shares.API.CreateAccessRuleFunc is not set")
+	}
+	return m.CreateAccessRuleFunc(fileShareID, opts)
+}
+
+// Delete implements file_shares.API.
+func (m *API) Delete(fileShareID string) tasks.Result {
+	m.record("Delete", fileShareID)
+	if m.DeleteFunc == nil {
+		panic("mocks: file_shares.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(fileShareID)
+}
+
+// DeleteAccessRule implements file_shares.API.
+func (m *API) DeleteAccessRule(fileShareID string, ruleID string) file_shares.DeleteResult {
+	m.record("DeleteAccessRule", fileShareID, ruleID)
+	if m.DeleteAccessRuleFunc == nil {
+		panic("mocks: file_shares.API.DeleteAccessRuleFunc is not set")
+	}
+	return m.DeleteAccessRuleFunc(fileShareID, ruleID)
+}
+
+// Extend implements file_shares.API.
+func (m *API) Extend(fileShareID string, opts file_shares.ResizeOptsBuilder) tasks.Result {
+	m.record("Extend", fileShareID, opts)
+	if m.ExtendFunc == nil {
+		panic("mocks: file_shares.API.ExtendFunc is not set")
+	}
+	return m.ExtendFunc(fileShareID, opts)
+}
+
+// Get implements file_shares.API.
+func (m *API) Get(id string) file_shares.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: file_shares.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// List implements file_shares.API.
+func (m *API) List() pagination.Pager {
+	m.record("List")
+	if m.ListFunc == nil {
+		panic("mocks: file_shares.API.ListFunc is not set")
+	}
+	return m.ListFunc()
+}
+
+// ListAccessRules implements file_shares.API.
+func (m *API) ListAccessRules(fileShareID string) pagination.Pager {
+	m.record("ListAccessRules", fileShareID)
+	if m.ListAccessRulesFunc == nil {
+		panic("mocks: file_shares.API.ListAccessRulesFunc is not set")
+	}
+	return m.ListAccessRulesFunc(fileShareID)
+}
+
+// ListAll implements file_shares.API.
+func (m *API) ListAll() ([]file_shares.FileShare, error) {
+	m.record("ListAll")
+	if m.ListAllFunc == nil {
+		panic("mocks: file_shares.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc()
+}
+
+// MetadataCreateOrUpdate implements file_shares.API.
+func (m *API) MetadataCreateOrUpdate(id string, opts map[string]interface{}) file_shares.MetadataActionResult {
+	m.record("MetadataCreateOrUpdate", id, opts)
+	if m.MetadataCreateOrUpdateFunc == nil {
+		panic("mocks: file_shares.API.MetadataCreateOrUpdateFunc is not set")
+	}
+	return m.MetadataCreateOrUpdateFunc(id, opts)
+}
+
+// MetadataDelete implements file_shares.API.
+func (m *API) MetadataDelete(id string, key string) file_shares.MetadataActionResult {
+	m.record("MetadataDelete", id, key)
+	if m.MetadataDeleteFunc == nil {
+		panic("mocks: file_shares.API.MetadataDeleteFunc is not set")
+	}
+	return m.MetadataDeleteFunc(id, key)
+}
+
+// MetadataGet implements file_shares.API.
+func (m *API) MetadataGet(id string, key string) file_shares.MetadataResult {
+	m.record("MetadataGet", id, key)
+	if m.MetadataGetFunc == nil {
+		panic("mocks: file_shares.API.MetadataGetFunc is not set")
+	}
+	return m.MetadataGetFunc(id, key)
+}
+
+// MetadataList implements file_shares.API.
+func (m *API) MetadataList(id string) pagination.Pager {
+	m.record("MetadataList", id)
+	if m.MetadataListFunc == nil {
+		panic("mocks: file_shares.API.MetadataListFunc is not set")
+	}
+	return m.MetadataListFunc(id)
+}
+
+// MetadataListAll implements file_shares.API.
+func (m *API) MetadataListAll(id string) ([]file_shares.Metadata, error) {
+	m.record("MetadataListAll", id)
+	if m.MetadataListAllFunc == nil {
+		panic("mocks: file_shares.API.MetadataListAllFunc is not set")
+	}
+	return m.MetadataListAllFunc(id)
+}
+
+// MetadataReplace implements file_shares.API.
+func (m *API) MetadataReplace(id string, opts map[string]interface{}) file_shares.MetadataActionResult {
+	m.record("MetadataReplace", id, opts)
+	if m.MetadataReplaceFunc == nil {
+		panic("mocks: file_shares.API.MetadataReplaceFunc is not set")
+	}
+	return m.MetadataReplaceFunc(id, opts)
+}
+
+// Update implements file_shares.API.
+func (m *API) Update(fileShareID string, opts file_shares.UpdateOptsBuilder) file_shares.UpdateResult {
+	m.record("Update", fileShareID, opts)
+	if m.UpdateFunc == nil {
+		panic("mocks: file_shares.API.UpdateFunc is not set")
+	}
+	return m.UpdateFunc(fileShareID, opts)
+}
+
+// WaitForStatus implements file_shares.API.
+func (m *API) WaitForStatus(id string, status string, opts gcorecloud.WaitOpts) (*file_shares.FileShare, error) {
+	m.record("WaitForStatus", id, status, opts)
+	if m.WaitForStatusFunc == nil {
+		panic("mocks: file_shares.API.WaitForStatusFunc is not set")
+	}
+	return m.WaitForStatusFunc(id, status, opts)
+}
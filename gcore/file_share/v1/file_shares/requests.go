@@ -1,7 +1,9 @@
 package file_shares
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
 
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 
@@ -269,3 +271,31 @@ func MetadataGet(client *gcorecloud.ServiceClient, id string, key string) (r Met
 	_, r.Err = client.Get(url, &r.Body, nil) // nolint
 	return
 }
+
+// WaitForStatus polls the file share until it reaches the status, e.g. "available" after a create or an extend.
+// It fails fast when the file share enters an error status, such as "error" or "extending_error".
+// The wait is cancelled with the context of the client.
+func WaitForStatus(c *gcorecloud.ServiceClient, id string, status string, opts gcorecloud.WaitOpts) (*FileShare, error) {
+	var fileShare *FileShare
+	err := c.WaitForResource(id, opts, func(c *gcorecloud.ServiceClient) (bool, error) {
+		current, err := Get(c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		fileShare = current
+		if fileShare.Status == status {
+			return true, nil
+		}
+		if strings.Contains(fileShare.Status, "error") {
+			return false, gcorecloud.ErrResourceErrorState
+		}
+		return false, nil
+	})
+	if err != nil {
+		if fileShare != nil {
+			return fileShare, fmt.Errorf("waiting for file share %s status %s, current status %s: %w", id, status, fileShare.Status, err)
+		}
+		return nil, fmt.Errorf("waiting for file share %s status %s: %w", id, status, err)
+	}
+	return fileShare, nil
+}
@@ -1,9 +1,12 @@
 package testing
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/G-Core/gcorelabscloud-go/gcore/file_share/v1/file_shares"
 	"github.com/G-Core/gcorelabscloud-go/gcore/utils/metadata/v1/metadata"
@@ -12,6 +15,8 @@ import (
 	fake "github.com/G-Core/gcorelabscloud-go/testhelper/client"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 )
 
 const fileSharePath = "file_shares"
@@ -475,3 +480,51 @@ func TestMetadataDelete(t *testing.T) {
 	err := metadata.MetadataDelete(client, FileShare1.ID, Metadata1.Key).ExtractErr()
 	require.NoError(t, err)
 }
+
+func handleFileShareStatuses(t *testing.T, statuses ...string) *int {
+	calls := 0
+	th.Mux.HandleFunc(prepareGetTestURL(FileShare1.ID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Authorization", fmt.Sprintf("Bearer %s", fake.AccessToken))
+
+		status := statuses[len(statuses)-1]
+		if calls < len(statuses) {
+			status = statuses[calls]
+		}
+		calls++
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprint(w, strings.Replace(GetResponse, `"status": "available"`, fmt.Sprintf(`"status": %q`, status), 1))
+		if err != nil {
+			log.Error(err)
+		}
+	})
+	return &calls
+}
+
+func TestWaitForStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := handleFileShareStatuses(t, "creating", "creating", "available")
+
+	client := fake.ServiceTokenClient(fileSharePath, "v1")
+	fileShare, err := file_shares.WaitForStatus(client, FileShare1.ID, "available", gcorecloud.WaitOpts{Interval: time.Millisecond})
+
+	require.NoError(t, err)
+	require.Equal(t, "available", fileShare.Status)
+	require.Equal(t, 3, *calls)
+}
+
+func TestWaitForStatusErrorState(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := handleFileShareStatuses(t, "extending", "extending_error", "available")
+
+	client := fake.ServiceTokenClient(fileSharePath, "v1")
+	_, err := file_shares.WaitForStatus(client, FileShare1.ID, "available", gcorecloud.WaitOpts{Interval: time.Millisecond})
+
+	require.Error(t, err)
+	require.True(t, errors.Is(err, gcorecloud.ErrResourceErrorState))
+	require.Equal(t, 2, *calls)
+}
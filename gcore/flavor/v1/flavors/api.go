@@ -0,0 +1,49 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package flavors
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of flavors operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	IDFromName(name string) (string, error)
+	List(opts ListOptsBuilder) pagination.Pager
+	ListAll(opts ListOptsBuilder) ([]Flavor, error)
+	Select(r Requirements) ([]Match, error)
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// IDFromName calls the package level IDFromName function with the service client.
+func (s *Service) IDFromName(name string) (string, error) {
+	return IDFromName(s.ServiceClient, name)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(opts ListOptsBuilder) ([]Flavor, error) {
+	return ListAll(s.ServiceClient, opts)
+}
+
+// Select calls the package level Select function with the service client.
+func (s *Service) Select(r Requirements) ([]Match, error) {
+	return Select(s.ServiceClient, r)
+}
@@ -0,0 +1,93 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of flavors.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/flavor/v1/flavors"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of flavors.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	IDFromNameFunc func(name string) (string, error)
+	ListFunc       func(opts flavors.ListOptsBuilder) pagination.Pager
+	ListAllFunc    func(opts flavors.ListOptsBuilder) ([]flavors.Flavor, error)
+	SelectFunc     func(r flavors.Requirements) ([]flavors.Match, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ flavors.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// IDFromName implements flavors.API.
+func (m *API) IDFromName(name string) (string, error) {
+	m.record("IDFromName", name)
+	if m.IDFromNameFunc == nil {
+		panic("mocks: flavors.API.IDFromNameFunc is not set")
+	}
+	return m.IDFromNameFunc(name)
+}
+
+// List implements flavors.API.
+func (m *API) List(opts flavors.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: flavors.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements flavors.API.
+func (m *API) ListAll(opts flavors.ListOptsBuilder) ([]flavors.Flavor, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: flavors.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// Select implements flavors.API.
+func (m *API) Select(r flavors.Requirements) ([]flavors.Match, error) {
+	m.record("Select", r)
+	if m.SelectFunc == nil {
+		panic("mocks: flavors.API.SelectFunc is not set")
+	}
+	return m.SelectFunc(r)
+}
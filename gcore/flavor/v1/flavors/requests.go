@@ -1,8 +1,14 @@
 package flavors
 
 import (
+	"fmt"
+	"path"
+	"sort"
+
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/pagination"
+
+	"github.com/shopspring/decimal"
 )
 
 // ListOptsBuilder allows extensions to add additional parameters to the List request.
@@ -83,3 +89,129 @@ func IDFromName(client *gcorecloud.ServiceClient, name string) (string, error) {
 		return "", gcorecloud.ErrMultipleResourcesFound{Name: name, Count: count, ResourceType: "flavors"}
 	}
 }
+
+// Requirements describe the flavors accepted by Select and Rank. Zero fields accept any flavor.
+type Requirements struct {
+	MinVCPUs int `validate:"gte=0"`
+	// MinRAM is in MiB.
+	MinRAM int `validate:"gte=0"`
+	// GPU and IPU require the presence, if true, or the absence, if false, of the accelerator.
+	GPU *bool
+	IPU *bool
+	// Include and Exclude are flavor name patterns in path.Match syntax, e.g. "g1-*".
+	// A flavor should match one of the Include patterns, if any, and none of the Exclude patterns.
+	Include []string
+	Exclude []string
+	// Baremetal selects the flavors of baremetal servers instead of virtual machines.
+	Baremetal bool
+	// MaxPricePerHour excludes the flavors above the price and the flavors without price.
+	MaxPricePerHour *decimal.Decimal
+}
+
+// Validate checks the requirements.
+func (r Requirements) Validate() error {
+	for _, pattern := range append(append([]string{}, r.Include...), r.Exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid flavor name pattern %q: %w", pattern, err)
+		}
+	}
+	return gcorecloud.ValidateStruct(r)
+}
+
+// Matches reports whether the flavor meets the requirements.
+func (r Requirements) Matches(f Flavor) bool {
+	if f.VCPUS < r.MinVCPUs || f.RAM < r.MinRAM {
+		return false
+	}
+	hw := HardwareDescription{}
+	if f.HardwareDescription != nil {
+		hw = *f.HardwareDescription
+	}
+	if r.GPU != nil && *r.GPU != (hw.GPU != "") {
+		return false
+	}
+	if r.IPU != nil && *r.IPU != (hw.IPU != "") {
+		return false
+	}
+	if len(r.Include) > 0 && !matchAny(r.Include, f.FlavorName) {
+		return false
+	}
+	if matchAny(r.Exclude, f.FlavorName) {
+		return false
+	}
+	if r.MaxPricePerHour != nil && (f.PricePerHour == nil || f.PricePerHour.GreaterThan(*r.MaxPricePerHour)) {
+		return false
+	}
+	return true
+}
+
+// fit returns the relative excess of vCPUs and RAM of the flavor over the requirements.
+func (r Requirements) fit(f Flavor) float64 {
+	var fit float64
+	if r.MinVCPUs > 0 {
+		fit += float64(f.VCPUS-r.MinVCPUs) / float64(r.MinVCPUs)
+	}
+	if r.MinRAM > 0 {
+		fit += float64(f.RAM-r.MinRAM) / float64(r.MinRAM)
+	}
+	return fit
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Rank returns the flavors meeting the requirements, cheapest first. Flavors with the same price are ordered by
+// fit, the closest to the requirements first, and flavors without price come last.
+func Rank(flavors []Flavor, r Requirements) ([]Match, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	matches := make([]Match, 0, len(flavors))
+	for _, f := range flavors {
+		if r.Matches(f) {
+			matches = append(matches, Match{Flavor: f, Fit: r.fit(f)})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		pi, pj := matches[i].PricePerHour, matches[j].PricePerHour
+		switch {
+		case pi != nil && pj == nil:
+			return true
+		case pi == nil && pj != nil:
+			return false
+		case pi != nil && !pi.Equal(*pj):
+			return pi.LessThan(*pj)
+		case matches[i].Fit != matches[j].Fit:
+			return matches[i].Fit < matches[j].Fit
+		}
+		return matches[i].FlavorName < matches[j].FlavorName
+	})
+	return matches, nil
+}
+
+// Select lists the flavors with prices and ranks the ones meeting the requirements, see Rank.
+func Select(c *gcorecloud.ServiceClient, r Requirements) ([]Match, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	if r.Baremetal {
+		c = baremetalClient(c)
+	}
+	includePrices := true
+	all, err := ListAll(c, ListOpts{IncludePrices: &includePrices})
+	if err != nil {
+		return nil, err
+	}
+	return Rank(all, r)
+}
+
+// baremetalClient returns a copy of the flavors client pointing to the baremetal flavors API.
+func baremetalClient(c *gcorecloud.ServiceClient) *gcorecloud.ServiceClient {
+	return c.ForService("bmflavors")
+}
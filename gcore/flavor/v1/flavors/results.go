@@ -66,7 +66,15 @@ type HardwareDescription struct {
 	Disk    string `json:"disk,omitempty"`
 	Network string `json:"network,omitempty"`
 	RAM     string `json:"ram,omitempty"`
-	IPU		string `json:"ipu,omitempty"`
+	IPU     string `json:"ipu,omitempty"`
+	GPU     string `json:"gpu,omitempty"`
+}
+
+// Match represents a flavor matching requirements. Fit is the relative excess of vCPUs and RAM over the
+// requirements, zero for an exact fit.
+type Match struct {
+	Flavor
+	Fit float64 `json:"fit"`
 }
 
 // FlavorPage is the page returned by a pager when traversing over a
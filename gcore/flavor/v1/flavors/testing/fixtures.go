@@ -77,3 +77,55 @@ var (
 
 	ExpectedFlavorSlice = []flavors.Flavor{Flavor1}
 )
+
+const SelectListResponse = `
+{
+  "count": 5,
+  "results": [
+    {
+      "flavor_id": "g1-standard-4-8",
+      "flavor_name": "g1-standard-4-8",
+      "price_status": "show",
+      "currency_code": "USD",
+      "price_per_hour": 0.12,
+      "ram": 8192,
+      "vcpus": 4
+    },
+    {
+      "flavor_id": "g1-standard-2-4",
+      "flavor_name": "g1-standard-2-4",
+      "price_status": "show",
+      "currency_code": "USD",
+      "price_per_hour": 0.06,
+      "ram": 4096,
+      "vcpus": 2
+    },
+    {
+      "flavor_id": "g1-cpu-4-4",
+      "flavor_name": "g1-cpu-4-4",
+      "price_status": "show",
+      "currency_code": "USD",
+      "price_per_hour": 0.12,
+      "ram": 4096,
+      "vcpus": 4
+    },
+    {
+      "flavor_id": "g1-gpu-8-32",
+      "flavor_name": "g1-gpu-8-32",
+      "price_status": "show",
+      "currency_code": "USD",
+      "price_per_hour": 1.5,
+      "ram": 32768,
+      "vcpus": 8,
+      "hardware_description": {"gpu": "1x A100"}
+    },
+    {
+      "flavor_id": "g1-custom-16-64",
+      "flavor_name": "g1-custom-16-64",
+      "price_status": "hide",
+      "ram": 65536,
+      "vcpus": 16
+    }
+  ]
+}
+`
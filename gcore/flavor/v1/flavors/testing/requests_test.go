@@ -11,6 +11,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/shopspring/decimal"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/G-Core/gcorelabscloud-go/pagination"
@@ -118,3 +119,76 @@ func TestListMalformedResponse(t *testing.T) {
 	require.Error(t, err)
 
 }
+
+func matchNames(matches []flavors.Match) []string {
+	names := make([]string, 0, len(matches))
+	for _, m := range matches {
+		names = append(names, m.FlavorName)
+	}
+	return names
+}
+
+func TestSelect(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(prepareListTestURL(), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Authorization", fmt.Sprintf("Bearer %s", fake.AccessToken))
+		require.Equal(t, "true", r.URL.Query().Get("include_prices"))
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprint(w, SelectListResponse)
+		if err != nil {
+			log.Error(err)
+		}
+	})
+
+	client := fake.ServiceTokenClient("flavors", "v1")
+	gpu, noGPU := true, false
+	maxPrice := decimal.NewFromFloat(0.1)
+
+	matches, err := flavors.Select(client, flavors.Requirements{MinVCPUs: 4, MinRAM: 4096})
+	require.NoError(t, err)
+	require.Equal(t, []string{"g1-cpu-4-4", "g1-standard-4-8", "g1-gpu-8-32", "g1-custom-16-64"}, matchNames(matches))
+	require.Equal(t, float64(0), matches[0].Fit)
+	require.Equal(t, float64(1), matches[1].Fit)
+
+	matches, err = flavors.Select(client, flavors.Requirements{MinVCPUs: 4, GPU: &noGPU, Exclude: []string{"*-custom-*"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"g1-cpu-4-4", "g1-standard-4-8"}, matchNames(matches))
+
+	matches, err = flavors.Select(client, flavors.Requirements{GPU: &gpu})
+	require.NoError(t, err)
+	require.Equal(t, []string{"g1-gpu-8-32"}, matchNames(matches))
+
+	matches, err = flavors.Select(client, flavors.Requirements{Include: []string{"g1-standard-*"}, MaxPricePerHour: &maxPrice})
+	require.NoError(t, err)
+	require.Equal(t, []string{"g1-standard-2-4"}, matchNames(matches))
+
+	_, err = flavors.Select(client, flavors.Requirements{Include: []string{"["}})
+	require.Error(t, err)
+}
+
+func TestSelectBaremetal(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(fmt.Sprintf("/v1/bmflavors/%d/%d", fake.ProjectID, fake.RegionID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprint(w, ListResponse)
+		if err != nil {
+			log.Error(err)
+		}
+	})
+
+	client := fake.ServiceTokenClient("flavors", "v1")
+	matches, err := flavors.Select(client, flavors.Requirements{Baremetal: true})
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Equal(t, Flavor1, matches[0].Flavor)
+}
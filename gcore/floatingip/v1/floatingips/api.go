@@ -0,0 +1,80 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package floatingips
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of floatingips operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Assign(floatingIPID string, opts CreateOptsBuilder) UpdateResult
+	Create(opts CreateOptsBuilder) tasks.Result
+	Delete(floatingID string) tasks.Result
+	EnsureAssigned(opts EnsureAssignedOpts) (*EnsureResult, error)
+	EnsurePool(opts PoolOpts) (*PoolReport, error)
+	Get(id string) GetResult
+	List(opts ListOptsBuilder) pagination.Pager
+	ListAll(opts ListOptsBuilder) ([]FloatingIPDetail, error)
+	UnAssign(floatingIPID string) UpdateResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Assign calls the package level Assign function with the service client.
+func (s *Service) Assign(floatingIPID string, opts CreateOptsBuilder) UpdateResult {
+	return Assign(s.ServiceClient, floatingIPID, opts)
+}
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(floatingID string) tasks.Result {
+	return Delete(s.ServiceClient, floatingID)
+}
+
+// EnsureAssigned calls the package level EnsureAssigned function with the service client.
+func (s *Service) EnsureAssigned(opts EnsureAssignedOpts) (*EnsureResult, error) {
+	return EnsureAssigned(s.ServiceClient, opts)
+}
+
+// EnsurePool calls the package level EnsurePool function with the service client.
+func (s *Service) EnsurePool(opts PoolOpts) (*PoolReport, error) {
+	return EnsurePool(s.ServiceClient, opts)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id string) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(opts ListOptsBuilder) ([]FloatingIPDetail, error) {
+	return ListAll(s.ServiceClient, opts)
+}
+
+// UnAssign calls the package level UnAssign function with the service client.
+func (s *Service) UnAssign(floatingIPID string) UpdateResult {
+	return UnAssign(s.ServiceClient, floatingIPID)
+}
@@ -0,0 +1,144 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of floatingips.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/floatingip/v1/floatingips"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of floatingips.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	AssignFunc         func(floatingIPID string, opts floatingips.CreateOptsBuilder) floatingips.UpdateResult
+	CreateFunc         func(opts floatingips.CreateOptsBuilder) tasks.Result
+	DeleteFunc         func(floatingID string) tasks.Result
+	EnsureAssignedFunc func(opts floatingips.EnsureAssignedOpts) (*floatingips.EnsureResult, error)
+	EnsurePoolFunc     func(opts floatingips.PoolOpts) (*floatingips.PoolReport, error)
+	GetFunc            func(id string) floatingips.GetResult
+	ListFunc           func(opts floatingips.ListOptsBuilder) pagination.Pager
+	ListAllFunc        func(opts floatingips.ListOptsBuilder) ([]floatingips.FloatingIPDetail, error)
+	UnAssignFunc       func(floatingIPID string) floatingips.UpdateResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ floatingips.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Assign implements floatingips.API.
+func (m *API) Assign(floatingIPID string, opts floatingips.CreateOptsBuilder) floatingips.UpdateResult {
+	m.record("Assign", floatingIPID, opts)
+	if m.AssignFunc == nil {
+		panic("mocks: floatingips.API.AssignFunc is not set")
+	}
+	return m.AssignFunc(floatingIPID, opts)
+}
+
+// Create implements floatingips.API.
+func (m *API) Create(opts floatingips.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: floatingips.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements floatingips.API.
+func (m *API) Delete(floatingID string) tasks.Result {
+	m.record("Delete", floatingID)
+	if m.DeleteFunc == nil {
+		panic("mocks: floatingips.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(floatingID)
+}
+
+// EnsureAssigned implements floatingips.API.
+func (m *API) EnsureAssigned(opts floatingips.EnsureAssignedOpts) (*floatingips.EnsureResult, error) {
+	m.record("EnsureAssigned", opts)
+	if m.EnsureAssignedFunc == nil {
+		panic("mocks: floatingips.API.EnsureAssignedFunc is not set")
+	}
+	return m.EnsureAssignedFunc(opts)
+}
+
+// EnsurePool implements floatingips.API.
+func (m *API) EnsurePool(opts floatingips.PoolOpts) (*floatingips.PoolReport, error) {
+	m.record("EnsurePool", opts)
+	if m.EnsurePoolFunc == nil {
+		panic("mocks: floatingips.API.EnsurePoolFunc is not set")
+	}
+	return m.EnsurePoolFunc(opts)
+}
+
+// Get implements floatingips.API.
+func (m *API) Get(id string) floatingips.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: floatingips.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// List implements floatingips.API.
+func (m *API) List(opts floatingips.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: floatingips.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements floatingips.API.
+func (m *API) ListAll(opts floatingips.ListOptsBuilder) ([]floatingips.FloatingIPDetail, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: floatingips.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// UnAssign implements floatingips.API.
+func (m *API) UnAssign(floatingIPID string) floatingips.UpdateResult {
+	m.record("UnAssign", floatingIPID)
+	if m.UnAssignFunc == nil {
+		panic("mocks: floatingips.API.UnAssignFunc is not set")
+	}
+	return m.UnAssignFunc(floatingIPID)
+}
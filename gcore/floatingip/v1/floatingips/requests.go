@@ -1,9 +1,11 @@
 package floatingips
 
 import (
+	"fmt"
 	"net"
 
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
 	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
 	"github.com/G-Core/gcorelabscloud-go/pagination"
 )
@@ -122,3 +124,200 @@ func UnAssign(c *gcorecloud.ServiceClient, floatingIPID string) (r UpdateResult)
 	_, r.Err = c.Post(unAssignURL(c, floatingIPID), nil, &r.Body, nil)
 	return
 }
+
+// Selector selects the floating IPs by metadata: the ones with the key, and the value when set.
+type Selector struct {
+	MetadataKey   string
+	MetadataValue string
+}
+
+// Validate checks the selector has a key.
+func (s Selector) Validate() error {
+	if s.MetadataKey == "" {
+		return fmt.Errorf("metadata key is required")
+	}
+	return nil
+}
+
+func (s Selector) listOpts() ListOpts {
+	if s.MetadataValue != "" {
+		return ListOpts{MetadataKV: map[string]string{s.MetadataKey: s.MetadataValue}}
+	}
+	return ListOpts{MetadataK: s.MetadataKey}
+}
+
+// Matches reports whether the floating IP has the metadata of the selector.
+func (s Selector) Matches(fip FloatingIPDetail) bool {
+	for _, m := range fip.Metadata {
+		if m.Key == s.MetadataKey && (s.MetadataValue == "" || m.Value == s.MetadataValue) {
+			return true
+		}
+	}
+	return false
+}
+
+// metadata returns the metadata of the floating IPs created for the selector, the value is "true" when not set.
+func (s Selector) metadata() map[string]string {
+	value := s.MetadataValue
+	if value == "" {
+		value = "true"
+	}
+	return map[string]string{s.MetadataKey: value}
+}
+
+// listSelected returns the floating IPs matching the selector.
+func listSelected(c *gcorecloud.ServiceClient, selector Selector) ([]FloatingIPDetail, error) {
+	all, err := ListAll(c, selector.listOpts())
+	if err != nil {
+		return nil, err
+	}
+	var result []FloatingIPDetail
+	for _, fip := range all {
+		if selector.Matches(fip) {
+			result = append(result, fip)
+		}
+	}
+	return result, nil
+}
+
+// create creates a floating IP with the metadata of the selector and waits for it.
+func create(c *gcorecloud.ServiceClient, selector Selector, opts gcorecloud.WaitOpts) (string, error) {
+	results, err := Create(c, CreateOpts{Metadata: selector.metadata()}).Extract()
+	if err != nil {
+		return "", fmt.Errorf("creating floating IP: %w", err)
+	}
+	if len(results.Tasks) == 0 {
+		return "", fmt.Errorf("no task returned")
+	}
+	task, err := tasks.WaitForTask(c.ForService("tasks"), string(results.Tasks[0]), opts)
+	if err != nil {
+		return "", err
+	}
+	return ExtractFloatingIPIDFromTask(task)
+}
+
+// EnsureAssignedOpts represents options of EnsureAssigned.
+type EnsureAssignedOpts struct {
+	// PortID is the port to assign the floating IP to. When only InstanceID is set, the first interface of the
+	// instance is used.
+	PortID         string
+	InstanceID     string
+	FixedIPAddress net.IP
+	Selector       Selector
+	Wait           gcorecloud.WaitOpts
+}
+
+// Validate checks the port and the selector are set.
+func (opts EnsureAssignedOpts) Validate() error {
+	if opts.PortID == "" && opts.InstanceID == "" {
+		return fmt.Errorf("port or instance ID is required")
+	}
+	return opts.Selector.Validate()
+}
+
+// EnsureAssigned makes sure a floating IP of the selector is assigned to the port and active. A floating IP of the
+// selector already assigned to the port is kept, otherwise an unassigned one is reused or, when there is none, one
+// is created with the metadata of the selector.
+func EnsureAssigned(c *gcorecloud.ServiceClient, opts EnsureAssignedOpts) (*EnsureResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	portID := opts.PortID
+	if portID == "" {
+		interfaces, err := instances.ListInterfacesAll(c.ForService("instances"), opts.InstanceID)
+		if err != nil {
+			return nil, fmt.Errorf("listing interfaces of instance %s: %w", opts.InstanceID, err)
+		}
+		if len(interfaces) == 0 {
+			return nil, fmt.Errorf("instance %s has no interface", opts.InstanceID)
+		}
+		portID = interfaces[0].PortID
+	}
+
+	selected, err := listSelected(c, opts.Selector)
+	if err != nil {
+		return nil, err
+	}
+	result := &EnsureResult{Action: EnsureActionUnchanged}
+	var floatingIPID string
+	for _, fip := range selected {
+		if fip.PortID == portID && (opts.FixedIPAddress == nil || opts.FixedIPAddress.Equal(fip.FixedIPAddress)) {
+			floatingIPID = fip.ID
+			break
+		}
+	}
+	if floatingIPID == "" {
+		for _, fip := range selected {
+			if fip.PortID == "" {
+				floatingIPID = fip.ID
+				result.Action = EnsureActionReused
+				break
+			}
+		}
+	}
+	if floatingIPID == "" {
+		if floatingIPID, err = create(c, opts.Selector, opts.Wait); err != nil {
+			return nil, err
+		}
+		result.Action = EnsureActionCreated
+	}
+	if result.Action != EnsureActionUnchanged {
+		assign := CreateOpts{PortID: portID, FixedIPAddress: opts.FixedIPAddress}
+		if err := Assign(c, floatingIPID, assign).Err; err != nil {
+			return nil, fmt.Errorf("assigning floating IP %s to port %s: %w", floatingIPID, portID, err)
+		}
+	}
+
+	err = c.WaitForResource(floatingIPID, opts.Wait, func(c *gcorecloud.ServiceClient) (bool, error) {
+		fip, err := Get(c, floatingIPID).Extract()
+		if err != nil {
+			return false, err
+		}
+		result.FloatingIP = *fip
+		return fip.Status == "ACTIVE", nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("waiting for floating IP %s: %w", floatingIPID, err)
+	}
+	return result, nil
+}
+
+// PoolOpts represents options of EnsurePool.
+type PoolOpts struct {
+	// Size is the count of unassigned floating IPs of the selector to keep.
+	Size     int
+	Selector Selector
+	Wait     gcorecloud.WaitOpts
+}
+
+// EnsurePool creates floating IPs with the metadata of the selector so at least Size of them are unassigned. The
+// extra unassigned floating IPs are kept.
+func EnsurePool(c *gcorecloud.ServiceClient, opts PoolOpts) (*PoolReport, error) {
+	if err := opts.Selector.Validate(); err != nil {
+		return nil, err
+	}
+	if opts.Size < 0 {
+		return nil, fmt.Errorf("pool size should not be negative")
+	}
+	selected, err := listSelected(c, opts.Selector)
+	if err != nil {
+		return nil, err
+	}
+	report := &PoolReport{Available: []string{}, Created: []string{}}
+	for _, fip := range selected {
+		if fip.PortID == "" {
+			report.Available = append(report.Available, fip.ID)
+		} else {
+			report.Assigned++
+		}
+	}
+	for len(report.Available) < opts.Size {
+		id, err := create(c, opts.Selector, opts.Wait)
+		if err != nil {
+			return report, err
+		}
+		report.Available = append(report.Available, id)
+		report.Created = append(report.Created, id)
+	}
+	return report, nil
+}
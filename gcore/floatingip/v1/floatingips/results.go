@@ -120,3 +120,29 @@ func ExtractFloatingIPIDFromTask(task *tasks.Task) (string, error) {
 	}
 	return result.FloatingIPs[0], nil
 }
+
+// EnsureAction is what EnsureAssigned did to assign a floating IP.
+type EnsureAction string
+
+const (
+	// EnsureActionUnchanged means a floating IP of the selector was already assigned to the port.
+	EnsureActionUnchanged EnsureAction = "unchanged"
+	// EnsureActionReused means an unassigned floating IP of the selector was assigned.
+	EnsureActionReused  EnsureAction = "reused"
+	EnsureActionCreated EnsureAction = "created"
+)
+
+// EnsureResult is the floating IP assigned by EnsureAssigned.
+type EnsureResult struct {
+	Action     EnsureAction         `json:"action"`
+	FloatingIP instances.FloatingIP `json:"floating_ip"`
+}
+
+// PoolReport describes the floating IPs of a pool after EnsurePool.
+type PoolReport struct {
+	// Available are the unassigned floating IPs, including the created ones.
+	Available []string `json:"available"`
+	Created   []string `json:"created"`
+	// Assigned is the count of the floating IPs of the pool in use.
+	Assigned int `json:"assigned"`
+}
@@ -0,0 +1,97 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/floatingip/v1/floatingips"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/testhelper/fakecloud"
+	"github.com/stretchr/testify/require"
+)
+
+var fastWait = gcorecloud.WaitOpts{Timeout: 5 * time.Second, Interval: 10 * time.Millisecond}
+
+func TestEnsureAssigned(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	client := server.ServiceClient("floatingips", "v1")
+
+	results, err := instances.Create(server.ServiceClient("instances", "v1"), instances.CreateOpts{
+		Flavor:     "g1-standard-1-2",
+		Names:      []string{"web"},
+		Volumes:    []instances.CreateVolumeOpts{{Source: types.NewVolume, Size: 10}},
+		Interfaces: []instances.InterfaceInstanceCreateOpts{{InterfaceOpts: instances.InterfaceOpts{Type: types.ExternalInterfaceType}}},
+	}).Extract()
+	require.NoError(t, err)
+	task, err := tasks.WaitForTask(server.ServiceClient("tasks", "v1"), string(results.Tasks[0]), fastWait)
+	require.NoError(t, err)
+	instanceID, err := instances.ExtractInstanceIDFromTask(task)
+	require.NoError(t, err)
+
+	selector := floatingips.Selector{MetadataKey: "service", MetadataValue: "web"}
+	opts := floatingips.EnsureAssignedOpts{InstanceID: instanceID, Selector: selector, Wait: fastWait}
+	created, err := floatingips.EnsureAssigned(client, opts)
+	require.NoError(t, err)
+	require.Equal(t, floatingips.EnsureActionCreated, created.Action)
+	require.Equal(t, "ACTIVE", created.FloatingIP.Status)
+	require.NotEmpty(t, created.FloatingIP.PortID)
+
+	again, err := floatingips.EnsureAssigned(client, opts)
+	require.NoError(t, err)
+	require.Equal(t, floatingips.EnsureActionUnchanged, again.Action)
+	require.Equal(t, created.FloatingIP.ID, again.FloatingIP.ID)
+
+	_, err = floatingips.UnAssign(client, created.FloatingIP.ID).Extract()
+	require.NoError(t, err)
+	reused, err := floatingips.EnsureAssigned(client, floatingips.EnsureAssignedOpts{
+		PortID:   created.FloatingIP.PortID,
+		Selector: floatingips.Selector{MetadataKey: "service"},
+		Wait:     fastWait,
+	})
+	require.NoError(t, err)
+	require.Equal(t, floatingips.EnsureActionReused, reused.Action)
+	require.Equal(t, created.FloatingIP.ID, reused.FloatingIP.ID)
+
+	all, err := floatingips.ListAll(client, nil)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+
+	_, err = floatingips.EnsureAssigned(client, floatingips.EnsureAssignedOpts{Selector: selector})
+	require.Error(t, err)
+}
+
+func TestEnsurePool(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	client := server.ServiceClient("floatingips", "v1")
+
+	selector := floatingips.Selector{MetadataKey: "pool"}
+	_, err := floatingips.Create(client, floatingips.CreateOpts{Metadata: map[string]string{"pool": "true"}}).Extract()
+	require.NoError(t, err)
+	_, err = floatingips.Create(client, floatingips.CreateOpts{Metadata: map[string]string{"other": "true"}}).Extract()
+	require.NoError(t, err)
+
+	report, err := floatingips.EnsurePool(client, floatingips.PoolOpts{Size: 3, Selector: selector, Wait: fastWait})
+	require.NoError(t, err)
+	require.Len(t, report.Available, 3)
+	require.Len(t, report.Created, 2)
+	require.Zero(t, report.Assigned)
+
+	report, err = floatingips.EnsurePool(client, floatingips.PoolOpts{Size: 2, Selector: selector, Wait: fastWait})
+	require.NoError(t, err)
+	require.Len(t, report.Available, 3)
+	require.Empty(t, report.Created)
+
+	all, err := floatingips.ListAll(client, nil)
+	require.NoError(t, err)
+	require.Len(t, all, 4)
+	for _, fip := range all {
+		if selector.Matches(fip) {
+			require.Equal(t, "true", fip.Metadata[0].Value)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package resources
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of resources operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Get(stackID string, resourceName string) GetResult
+	List(stackID string, opts ListOptsBuilder) pagination.Pager
+	ListAll(stackID string, opts ListOptsBuilder) ([]ResourceList, error)
+	MarkUnhealthy(stackID string, resourceName string, opts MarkUnhealthyOptsBuilder) MarkUnhealthyResult
+	Metadata(id string, resource string) MetadataResult
+	MetadataURL(stackID string, resourceName string) string
+	Signal(id string, resource string, body []byte) SignalResult
+	SignalURL(stackID string, resourceName string) string
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(stackID string, resourceName string) GetResult {
+	return Get(s.ServiceClient, stackID, resourceName)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(stackID string, opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, stackID, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(stackID string, opts ListOptsBuilder) ([]ResourceList, error) {
+	return ListAll(s.ServiceClient, stackID, opts)
+}
+
+// MarkUnhealthy calls the package level MarkUnhealthy function with the service client.
+func (s *Service) MarkUnhealthy(stackID string, resourceName string, opts MarkUnhealthyOptsBuilder) MarkUnhealthyResult {
+	return MarkUnhealthy(s.ServiceClient, stackID, resourceName, opts)
+}
+
+// Metadata calls the package level Metadata function with the service client.
+func (s *Service) Metadata(id string, resource string) MetadataResult {
+	return Metadata(s.ServiceClient, id, resource)
+}
+
+// MetadataURL calls the package level MetadataURL function with the service client.
+func (s *Service) MetadataURL(stackID string, resourceName string) string {
+	return MetadataURL(s.ServiceClient, stackID, resourceName)
+}
+
+// Signal calls the package level Signal function with the service client.
+func (s *Service) Signal(id string, resource string, body []byte) SignalResult {
+	return Signal(s.ServiceClient, id, resource, body)
+}
+
+// SignalURL calls the package level SignalURL function with the service client.
+func (s *Service) SignalURL(stackID string, resourceName string) string {
+	return SignalURL(s.ServiceClient, stackID, resourceName)
+}
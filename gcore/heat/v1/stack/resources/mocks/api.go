@@ -0,0 +1,133 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of resources.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/heat/v1/stack/resources"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of resources.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	GetFunc           func(stackID string, resourceName string) resources.GetResult
+	ListFunc          func(stackID string, opts resources.ListOptsBuilder) pagination.Pager
+	ListAllFunc       func(stackID string, opts resources.ListOptsBuilder) ([]resources.ResourceList, error)
+	MarkUnhealthyFunc func(stackID string, resourceName string, opts resources.MarkUnhealthyOptsBuilder) resources.MarkUnhealthyResult
+	MetadataFunc      func(id string, resource string) resources.MetadataResult
+	MetadataURLFunc   func(stackID string, resourceName string) string
+	SignalFunc        func(id string, resource string, body []byte) resources.SignalResult
+	SignalURLFunc     func(stackID string, resourceName string) string
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ resources.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Get implements resources.API.
+func (m *API) Get(stackID string, resourceName string) resources.GetResult {
+	m.record("Get", stackID, resourceName)
+	if m.GetFunc == nil {
+		panic("mocks: resources.API.GetFunc is not set")
+	}
+	return m.GetFunc(stackID, resourceName)
+}
+
+// List implements resources.API.
+func (m *API) List(stackID string, opts resources.ListOptsBuilder) pagination.Pager {
+	m.record("List", stackID, opts)
+	if m.ListFunc == nil {
+		panic("mocks: resources.API.ListFunc is not set")
+	}
+	return m.ListFunc(stackID, opts)
+}
+
+// ListAll implements resources.API.
+func (m *API) ListAll(stackID string, opts resources.ListOptsBuilder) ([]resources.ResourceList, error) {
+	m.record("ListAll", stackID, opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: resources.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(stackID, opts)
+}
+
+// MarkUnhealthy implements resources.API.
+func (m *API) MarkUnhealthy(stackID string, resourceName string, opts resources.MarkUnhealthyOptsBuilder) resources.MarkUnhealthyResult {
+	m.record("MarkUnhealthy", stackID, resourceName, opts)
+	if m.MarkUnhealthyFunc == nil {
+		panic("mocks: resources.API.MarkUnhealthyFunc is not set")
+	}
+	return m.MarkUnhealthyFunc(stackID, resourceName, opts)
+}
+
+// Metadata implements resources.API.
+func (m *API) Metadata(id string, resource string) resources.MetadataResult {
+	m.record("Metadata", id, resource)
+	if m.MetadataFunc == nil {
+		panic("mocks: resources.API.MetadataFunc is not set")
+	}
+	return m.MetadataFunc(id, resource)
+}
+
+// MetadataURL implements resources.API.
+func (m *API) MetadataURL(stackID string, resourceName string) string {
+	m.record("MetadataURL", stackID, resourceName)
+	if m.MetadataURLFunc == nil {
+		panic("mocks: resources.API.MetadataURLFunc is not set")
+	}
+	return m.MetadataURLFunc(stackID, resourceName)
+}
+
+// Signal implements resources.API.
+func (m *API) Signal(id string, resource string, body []byte) resources.SignalResult {
+	m.record("Signal", id, resource, body)
+	if m.SignalFunc == nil {
+		panic("mocks: resources.API.SignalFunc is not set")
+	}
+	return m.SignalFunc(id, resource, body)
+}
+
+// SignalURL implements resources.API.
+func (m *API) SignalURL(stackID string, resourceName string) string {
+	m.record("SignalURL", stackID, resourceName)
+	if m.SignalURLFunc == nil {
+		panic("mocks: resources.API.SignalURLFunc is not set")
+	}
+	return m.SignalURLFunc(stackID, resourceName)
+}
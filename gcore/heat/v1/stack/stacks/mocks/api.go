@@ -0,0 +1,123 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of stacks.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/heat/v1/stack/stacks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of stacks.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc      func(opts stacks.CreateOptsBuilder) stacks.CreateResult
+	DeleteFunc      func(stackID string) stacks.DeleteResult
+	GetFunc         func(id string) stacks.GetResult
+	ListFunc        func(opts stacks.ListOptsBuilder) pagination.Pager
+	ListAllFunc     func(opts stacks.ListOptsBuilder) ([]stacks.StackList, error)
+	UpdateFunc      func(stackID string, opts stacks.UpdateOptsBuilder) stacks.UpdateResult
+	UpdatePatchFunc func(stackID string, opts stacks.UpdatePatchOptsBuilder) stacks.UpdateResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ stacks.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements stacks.API.
+func (m *API) Create(opts stacks.CreateOptsBuilder) stacks.CreateResult {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: stacks.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements stacks.API.
+func (m *API) Delete(stackID string) stacks.DeleteResult {
+	m.record("Delete", stackID)
+	if m.DeleteFunc == nil {
+		panic("mocks: stacks.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(stackID)
+}
+
+// Get implements stacks.API.
+func (m *API) Get(id string) stacks.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: stacks.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// List implements stacks.API.
+func (m *API) List(opts stacks.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: stacks.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements stacks.API.
+func (m *API) ListAll(opts stacks.ListOptsBuilder) ([]stacks.StackList, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: stacks.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// Update implements stacks.API.
+func (m *API) Update(stackID string, opts stacks.UpdateOptsBuilder) stacks.UpdateResult {
+	m.record("Update", stackID, opts)
+	if m.UpdateFunc == nil {
+		panic("mocks: stacks.API.UpdateFunc is not set")
+	}
+	return m.UpdateFunc(stackID, opts)
+}
+
+// UpdatePatch implements stacks.API.
+func (m *API) UpdatePatch(stackID string, opts stacks.UpdatePatchOptsBuilder) stacks.UpdateResult {
+	m.record("UpdatePatch", stackID, opts)
+	if m.UpdatePatchFunc == nil {
+		panic("mocks: stacks.API.UpdatePatchFunc is not set")
+	}
+	return m.UpdatePatchFunc(stackID, opts)
+}
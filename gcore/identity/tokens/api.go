@@ -0,0 +1,48 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package tokens
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+)
+
+// API is the set of tokens operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Create(opts gcorecloud.AuthOptionsBuilder) TokenResult
+	RefreshGCloud(opts gcorecloud.TokenOptionsBuilder) TokenResult
+	RefreshPlatform(opts gcorecloud.TokenOptionsBuilder) TokenResult
+	SelectAccount(clientID string) TokenResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts gcorecloud.AuthOptionsBuilder) TokenResult {
+	return Create(s.ServiceClient, opts)
+}
+
+// RefreshGCloud calls the package level RefreshGCloud function with the service client.
+func (s *Service) RefreshGCloud(opts gcorecloud.TokenOptionsBuilder) TokenResult {
+	return RefreshGCloud(s.ServiceClient, opts)
+}
+
+// RefreshPlatform calls the package level RefreshPlatform function with the service client.
+func (s *Service) RefreshPlatform(opts gcorecloud.TokenOptionsBuilder) TokenResult {
+	return RefreshPlatform(s.ServiceClient, opts)
+}
+
+// SelectAccount calls the package level SelectAccount function with the service client.
+func (s *Service) SelectAccount(clientID string) TokenResult {
+	return SelectAccount(s.ServiceClient, clientID)
+}
@@ -0,0 +1,93 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of tokens.API.
+package mocks
+
+import (
+	"sync"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/identity/tokens"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of tokens.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc          func(opts gcorecloud.AuthOptionsBuilder) tokens.TokenResult
+	RefreshGCloudFunc   func(opts gcorecloud.TokenOptionsBuilder) tokens.TokenResult
+	RefreshPlatformFunc func(opts gcorecloud.TokenOptionsBuilder) tokens.TokenResult
+	SelectAccountFunc   func(clientID string) tokens.TokenResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ tokens.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements tokens.API.
+func (m *API) Create(opts gcorecloud.AuthOptionsBuilder) tokens.TokenResult {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: tokens.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// RefreshGCloud implements tokens.API.
+func (m *API) RefreshGCloud(opts gcorecloud.TokenOptionsBuilder) tokens.TokenResult {
+	m.record("RefreshGCloud", opts)
+	if m.RefreshGCloudFunc == nil {
+		panic("mocks: tokens.API.RefreshGCloudFunc is not set")
+	}
+	return m.RefreshGCloudFunc(opts)
+}
+
+// RefreshPlatform implements tokens.API.
+func (m *API) RefreshPlatform(opts gcorecloud.TokenOptionsBuilder) tokens.TokenResult {
+	m.record("RefreshPlatform", opts)
+	if m.RefreshPlatformFunc == nil {
+		panic("mocks: tokens.API.RefreshPlatformFunc is not set")
+	}
+	return m.RefreshPlatformFunc(opts)
+}
+
+// SelectAccount implements tokens.API.
+func (m *API) SelectAccount(clientID string) tokens.TokenResult {
+	m.record("SelectAccount", clientID)
+	if m.SelectAccountFunc == nil {
+		panic("mocks: tokens.API.SelectAccountFunc is not set")
+	}
+	return m.SelectAccountFunc(clientID)
+}
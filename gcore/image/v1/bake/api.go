@@ -0,0 +1,30 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package bake
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+)
+
+// API is the set of bake operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Bake(opts Opts) (*Result, error)
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Bake calls the package level Bake function with the service client.
+func (s *Service) Bake(opts Opts) (*Result, error) {
+	return Bake(s.ServiceClient, opts)
+}
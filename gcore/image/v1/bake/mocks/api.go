@@ -0,0 +1,62 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of bake.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/image/v1/bake"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of bake.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	BakeFunc func(opts bake.Opts) (*bake.Result, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ bake.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Bake implements bake.API.
+func (m *API) Bake(opts bake.Opts) (*bake.Result, error) {
+	m.record("Bake", opts)
+	if m.BakeFunc == nil {
+		panic("mocks: bake.API.BakeFunc is not set")
+	}
+	return m.BakeFunc(opts)
+}
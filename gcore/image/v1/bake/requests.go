@@ -0,0 +1,353 @@
+package bake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/image/v1/images"
+	imagetypes "github.com/G-Core/gcorelabscloud-go/gcore/image/v1/images/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/snapshot/v1/snapshots"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/utils/userdata"
+	"github.com/G-Core/gcorelabscloud-go/gcore/volume/v1/volumes"
+)
+
+const (
+	// DefaultStatusKey is the instance metadata key the provisioning sets to signal its completion.
+	DefaultStatusKey = "bake_status"
+	// StatusDone and StatusFailed are the values of the status key signalling the end of the provisioning.
+	StatusDone   = "done"
+	StatusFailed = "failed"
+	// DefaultProvisionTimeout is the time given to the provisioning to complete.
+	DefaultProvisionTimeout = 30 * time.Minute
+	// DefaultInterval is the initial interval between the polls of the instance and the tasks.
+	DefaultInterval = 5 * time.Second
+)
+
+// Build metadata set on the baked image or snapshot and on the temporary instance.
+const (
+	MetadataKeyName        = "bake_name"
+	MetadataKeyBaseImageID = "bake_base_image_id"
+	MetadataKeyFlavor      = "bake_flavor"
+	MetadataKeyStartedAt   = "bake_started_at"
+)
+
+// Opts configures a bake.
+type Opts struct {
+	// Name is the name of the baked image or snapshot.
+	Name   string `validate:"required"`
+	Target Target `validate:"required,enum"`
+	// BaseImageID is the image the temporary instance boots from.
+	BaseImageID string `validate:"required,uuid4"`
+	Flavor      string `validate:"required"`
+	// VolumeSize is the size of the boot volume in GiB.
+	VolumeSize int                `validate:"required,gt=0"`
+	VolumeType volumes.VolumeType `validate:"omitempty,enum"`
+	// Interfaces of the temporary instance, an external interface when empty. The floating IPs created with the
+	// interfaces are deleted with the instance.
+	Interfaces     []instances.InterfaceInstanceCreateOpts `validate:"omitempty,dive"`
+	SecurityGroups []gcorecloud.ItemID                     `validate:"omitempty,dive"`
+	Keypair        string
+	// UserData, base64 encoded, or UserDataBuilder provision the temporary instance. The provisioning signals its
+	// completion by setting the StatusKey instance metadata to StatusDone, or StatusFailed on failure.
+	UserData        string `validate:"omitempty,base64"`
+	UserDataBuilder *userdata.Builder
+	// StatusKey defaults to DefaultStatusKey.
+	StatusKey string
+	// Metadata is added to the build metadata of the baked image or snapshot.
+	Metadata map[string]string
+	// Image properties, used when Target is ImageTarget. Zero values default to q35, allow, linux and bios.
+	HwMachineType  imagetypes.HwMachineType  `validate:"omitempty,enum"`
+	SshKey         imagetypes.SshKeyType     `validate:"omitempty,enum"`
+	OSType         imagetypes.OSType         `validate:"omitempty,enum"`
+	HwFirmwareType imagetypes.HwFirmwareType `validate:"omitempty,enum"`
+	// ProvisionWait configures the wait for the provisioning, its timeout defaults to DefaultProvisionTimeout.
+	ProvisionWait gcorecloud.WaitOpts
+	// TaskWait configures the waits for the tasks and the instance status.
+	TaskWait gcorecloud.WaitOpts
+}
+
+// Validate checks the bake options.
+func (opts Opts) Validate() error {
+	if opts.UserData != "" && opts.UserDataBuilder != nil {
+		return fmt.Errorf("UserData and UserDataBuilder are mutually exclusive")
+	}
+	return gcorecloud.ValidateStruct(opts)
+}
+
+func (opts Opts) withDefaults() Opts {
+	if opts.StatusKey == "" {
+		opts.StatusKey = DefaultStatusKey
+	}
+	if len(opts.Interfaces) == 0 {
+		opts.Interfaces = []instances.InterfaceInstanceCreateOpts{{
+			InterfaceOpts: instances.InterfaceOpts{Type: types.ExternalInterfaceType},
+		}}
+	}
+	if opts.HwMachineType == "" {
+		opts.HwMachineType = imagetypes.HwMachineQ35
+	}
+	if opts.SshKey == "" {
+		opts.SshKey = imagetypes.SshKeyAllow
+	}
+	if opts.OSType == "" {
+		opts.OSType = imagetypes.OsLinux
+	}
+	if opts.HwFirmwareType == "" {
+		opts.HwFirmwareType = imagetypes.HwFirmwareBIOS
+	}
+	if opts.ProvisionWait.Timeout <= 0 {
+		opts.ProvisionWait.Timeout = DefaultProvisionTimeout
+	}
+	if opts.ProvisionWait.Interval <= 0 {
+		opts.ProvisionWait.Interval = DefaultInterval
+	}
+	if opts.TaskWait.Interval <= 0 {
+		opts.TaskWait.Interval = DefaultInterval
+	}
+	return opts
+}
+
+// baker keeps the clients and the temporary resources of a bake.
+type baker struct {
+	opts      Opts
+	instances *gcorecloud.ServiceClient
+	volumes   *gcorecloud.ServiceClient
+	tasks     *gcorecloud.ServiceClient
+
+	instanceID  string
+	volumeIDs   []string
+	floatingIPs []string
+	// keepVolume is set once the boot volume has a snapshot, it is kept by the cleanup.
+	keepVolume bool
+}
+
+// Bake creates a temporary instance from the base image with the provisioning user data, waits until the
+// provisioning signals its completion through the instance metadata, stops the instance and creates an image or a
+// snapshot of its boot volume, tagged with the build metadata. The temporary instance, its volumes and floating IPs
+// are deleted whatever the outcome, even when the context of the client is cancelled. A snapshot depends on its
+// volume: the boot volume is kept once it is snapshotted, see Result.VolumeID.
+//
+// When only the cleanup fails, the result is returned along with the cleanup error.
+func Bake(client *gcorecloud.ServiceClient, opts Opts) (result *Result, err error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	opts = opts.withDefaults()
+	started := time.Now()
+	b := &baker{
+		opts:      opts,
+		instances: client,
+		volumes:   client.ForService("volumes"),
+		tasks:     client.ForService("tasks"),
+	}
+	defer func() {
+		if cleanupErr := b.cleanup(); cleanupErr != nil {
+			if err == nil {
+				err = cleanupErr
+			} else {
+				err = fmt.Errorf("%w, %s", err, cleanupErr)
+			}
+		}
+	}()
+
+	metadata := buildMetadata(opts, started)
+	if err := b.createInstance(metadata); err != nil {
+		return nil, err
+	}
+	if err := b.waitProvisioned(); err != nil {
+		return nil, err
+	}
+	if _, err := instances.Stop(client, b.instanceID).Extract(); err != nil {
+		return nil, fmt.Errorf("stopping instance %s: %w", b.instanceID, err)
+	}
+	if _, err := instances.WaitForStatus(client, b.instanceID, "SHUTOFF", "stopped", opts.TaskWait); err != nil {
+		return nil, err
+	}
+
+	result = &Result{
+		Target:     opts.Target,
+		Name:       opts.Name,
+		InstanceID: b.instanceID,
+		VolumeID:   b.volumeIDs[0],
+		Metadata:   metadata,
+	}
+	switch opts.Target {
+	case ImageTarget:
+		result.ID, err = b.createImage(metadata)
+	case SnapshotTarget:
+		result.ID, err = b.createSnapshot(metadata)
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.Duration = time.Since(started)
+	return result, nil
+}
+
+func buildMetadata(opts Opts, started time.Time) map[string]string {
+	metadata := map[string]string{
+		MetadataKeyName:        opts.Name,
+		MetadataKeyBaseImageID: opts.BaseImageID,
+		MetadataKeyFlavor:      opts.Flavor,
+		MetadataKeyStartedAt:   started.UTC().Format(time.RFC3339),
+	}
+	for k, v := range opts.Metadata {
+		metadata[k] = v
+	}
+	return metadata
+}
+
+// waitTask waits for the task of the result and returns it.
+func (b *baker) waitTask(r tasks.Result) (*tasks.Task, error) {
+	results, err := r.Extract()
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Tasks) == 0 {
+		return nil, fmt.Errorf("no task returned")
+	}
+	return tasks.WaitForTask(b.tasks, string(results.Tasks[0]), b.opts.TaskWait)
+}
+
+func (b *baker) createInstance(metadata map[string]string) error {
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	instanceMetadata := &instances.MetadataSetOpts{}
+	for _, k := range keys {
+		instanceMetadata.Metadata = append(instanceMetadata.Metadata, instances.MetadataOpts{Key: k, Value: metadata[k]})
+	}
+
+	task, err := b.waitTask(instances.Create(b.instances, instances.CreateOpts{
+		Flavor: b.opts.Flavor,
+		Names:  []string{"bake-" + b.opts.Name},
+		Volumes: []instances.CreateVolumeOpts{{
+			Source:              types.Image,
+			BootIndex:           0,
+			Size:                b.opts.VolumeSize,
+			TypeName:            b.opts.VolumeType,
+			ImageID:             b.opts.BaseImageID,
+			DeleteOnTermination: b.opts.Target != SnapshotTarget,
+		}},
+		Interfaces:      b.opts.Interfaces,
+		SecurityGroups:  b.opts.SecurityGroups,
+		Keypair:         b.opts.Keypair,
+		UserData:        b.opts.UserData,
+		UserDataBuilder: b.opts.UserDataBuilder,
+		Metadata:        instanceMetadata,
+	}))
+	if task != nil && task.CreatedResources != nil {
+		var created instances.InstanceTaskResult
+		if decodeErr := gcorecloud.NativeMapToStruct(task.CreatedResources, &created); decodeErr == nil {
+			if len(created.Instances) > 0 {
+				b.instanceID = created.Instances[0]
+			}
+			b.volumeIDs = created.Volumes
+			b.floatingIPs = created.FloatingIPs
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("creating instance: %w", err)
+	}
+	if b.instanceID == "" || len(b.volumeIDs) == 0 {
+		return fmt.Errorf("creating instance: cannot decode instance information in task structure")
+	}
+	return nil
+}
+
+// waitProvisioned waits until the provisioning sets the status metadata of the instance.
+func (b *baker) waitProvisioned() error {
+	var status string
+	err := b.instances.WaitForResource(b.instanceID, b.opts.ProvisionWait, func(client *gcorecloud.ServiceClient) (bool, error) {
+		instance, err := instances.Get(client, b.instanceID).Extract()
+		if err != nil {
+			return false, err
+		}
+		if instance.Status == "ERROR" || instance.VMState == "error" {
+			return false, gcorecloud.ErrResourceErrorState
+		}
+		status = fmt.Sprint(instance.Metadata[b.opts.StatusKey])
+		switch status {
+		case StatusDone:
+			return true, nil
+		case StatusFailed:
+			return false, fmt.Errorf("provisioning failed")
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("waiting for the provisioning of instance %s: %w", b.instanceID, err)
+	}
+	return nil
+}
+
+func (b *baker) createImage(metadata map[string]string) (string, error) {
+	task, err := b.waitTask(images.Create(b.instances.ForService("images"), images.CreateOpts{
+		Name:           b.opts.Name,
+		HwMachineType:  b.opts.HwMachineType,
+		SshKey:         b.opts.SshKey,
+		OSType:         b.opts.OSType,
+		HwFirmwareType: b.opts.HwFirmwareType,
+		Source:         imagetypes.ImageSourceVolume,
+		VolumeID:       b.volumeIDs[0],
+		Metadata:       metadata,
+	}))
+	if err != nil {
+		return "", fmt.Errorf("creating image: %w", err)
+	}
+	return images.ExtractImageIDFromTask(task)
+}
+
+func (b *baker) createSnapshot(metadata map[string]string) (string, error) {
+	task, err := b.waitTask(snapshots.Create(b.instances.ForService("snapshots"), snapshots.CreateOpts{
+		VolumeID:    b.volumeIDs[0],
+		Name:        b.opts.Name,
+		Description: fmt.Sprintf("baked from image %s", b.opts.BaseImageID),
+		Metadata:    metadata,
+	}))
+	if task != nil && task.State == tasks.TaskStateFinished {
+		b.keepVolume = true
+	}
+	if err != nil {
+		return "", fmt.Errorf("creating snapshot: %w", err)
+	}
+	return snapshots.ExtractSnapshotIDFromTask(task)
+}
+
+// cleanup deletes the temporary instance with its volumes and floating IPs, then the volumes left behind by a
+// failed instance creation. The snapshotted boot volume is detached and kept. It ignores the context of the client.
+func (b *baker) cleanup() error {
+	ctx := context.Background()
+	b.instances = b.instances.WithContext(ctx)
+	b.volumes = b.volumes.WithContext(ctx)
+	b.tasks = b.tasks.WithContext(ctx)
+
+	volumeIDs := b.volumeIDs
+	if b.keepVolume {
+		volumeIDs = volumeIDs[1:]
+	}
+	if b.instanceID != "" {
+		_, err := b.waitTask(instances.Delete(b.instances, b.instanceID, instances.DeleteOpts{
+			Volumes:     volumeIDs,
+			FloatingIPs: b.floatingIPs,
+		}))
+		if err != nil {
+			return fmt.Errorf("cleanup of instance %s: %w", b.instanceID, err)
+		}
+		return nil
+	}
+	for _, id := range volumeIDs {
+		if _, err := b.waitTask(volumes.Delete(b.volumes, id, nil)); err != nil {
+			return fmt.Errorf("cleanup of volume %s: %w", id, err)
+		}
+	}
+	return nil
+}
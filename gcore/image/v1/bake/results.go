@@ -0,0 +1,17 @@
+package bake
+
+import "time"
+
+// Result describes a baked image or snapshot.
+type Result struct {
+	Target Target `json:"target"`
+	// ID is the ID of the image or the snapshot.
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// InstanceID and VolumeID are the temporary instance and its boot volume, deleted at the end of the bake. The boot
+	// volume of a snapshot is kept, the snapshot depends on it.
+	InstanceID string            `json:"instance_id"`
+	VolumeID   string            `json:"volume_id"`
+	Metadata   map[string]string `json:"metadata"`
+	Duration   time.Duration     `json:"duration"`
+}
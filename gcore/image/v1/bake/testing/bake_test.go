@@ -0,0 +1,146 @@
+package testing
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/image/v1/bake"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/testhelper/fakecloud"
+	"github.com/stretchr/testify/require"
+)
+
+const baseImageID = "a2ad7c48-8f39-4f8c-9c1a-1f1e3a6d5e2b"
+
+// provision plays the provisioning of the temporary instance: it sets the status metadata once the instance exists.
+func provision(server *fakecloud.Server, status string) chan error {
+	done := make(chan error, 1)
+	go func() {
+		client := server.ServiceClient("instances", "v1")
+		for i := 0; i < 1000; i++ {
+			if created := server.Resources("instances"); len(created) > 0 {
+				done <- instances.MetadataCreate(client, created[0]["instance_id"].(string), instances.MetadataSetOpts{
+					Metadata: []instances.MetadataOpts{{Key: bake.DefaultStatusKey, Value: status}},
+				}).ExtractErr()
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+		done <- errors.New("no instance created")
+	}()
+	return done
+}
+
+func bakeOpts(target bake.Target) bake.Opts {
+	return bake.Opts{
+		Name:          "golden",
+		Target:        target,
+		BaseImageID:   baseImageID,
+		Flavor:        "g1-standard-1-2",
+		VolumeSize:    10,
+		Metadata:      map[string]string{"git_sha": "4f2c1d"},
+		ProvisionWait: gcorecloud.WaitOpts{Timeout: 5 * time.Second, Interval: 5 * time.Millisecond},
+		TaskWait:      gcorecloud.WaitOpts{Interval: time.Millisecond},
+	}
+}
+
+func requireCleanedUp(t *testing.T, server *fakecloud.Server) {
+	require.Empty(t, server.Resources("instances"))
+	require.Empty(t, server.Resources("volumes"))
+	require.Empty(t, server.Resources("floatingips"))
+}
+
+func TestBakeImage(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	done := provision(server, bake.StatusDone)
+
+	result, err := bake.Bake(server.ServiceClient("instances", "v1"), bakeOpts(bake.ImageTarget))
+	require.NoError(t, <-done)
+	require.NoError(t, err)
+	require.Equal(t, bake.ImageTarget, result.Target)
+	require.Equal(t, "golden", result.Name)
+	require.NotEmpty(t, result.InstanceID)
+	require.NotEmpty(t, result.VolumeID)
+	require.Equal(t, "4f2c1d", result.Metadata["git_sha"])
+	require.Equal(t, baseImageID, result.Metadata[bake.MetadataKeyBaseImageID])
+	require.Equal(t, "g1-standard-1-2", result.Metadata[bake.MetadataKeyFlavor])
+
+	images := server.Resources("images")
+	require.Len(t, images, 1)
+	require.Equal(t, result.ID, images[0]["id"])
+	require.Equal(t, "golden", images[0]["name"])
+	require.Len(t, images[0]["metadata_detailed"], len(result.Metadata))
+	requireCleanedUp(t, server)
+}
+
+func TestBakeSnapshot(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	done := provision(server, bake.StatusDone)
+
+	result, err := bake.Bake(server.ServiceClient("instances", "v1"), bakeOpts(bake.SnapshotTarget))
+	require.NoError(t, <-done)
+	require.NoError(t, err)
+
+	snapshots := server.Resources("snapshots")
+	require.Len(t, snapshots, 1)
+	require.Equal(t, result.ID, snapshots[0]["id"])
+	require.Equal(t, result.VolumeID, snapshots[0]["volume_id"])
+	require.Equal(t, "golden", snapshots[0]["metadata"].(map[string]interface{})[bake.MetadataKeyName])
+
+	// The source volume of the snapshot is kept, detached.
+	require.Empty(t, server.Resources("instances"))
+	require.Empty(t, server.Resources("floatingips"))
+	volumes := server.Resources("volumes")
+	require.Len(t, volumes, 1)
+	require.Equal(t, result.VolumeID, volumes[0]["id"])
+	require.Equal(t, "available", volumes[0]["status"])
+}
+
+func TestBakeSnapshotProvisioningFailed(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	done := provision(server, bake.StatusFailed)
+
+	_, err := bake.Bake(server.ServiceClient("instances", "v1"), bakeOpts(bake.SnapshotTarget))
+	require.NoError(t, <-done)
+	require.Error(t, err)
+	require.Empty(t, server.Resources("snapshots"))
+	requireCleanedUp(t, server)
+}
+
+func TestBakeProvisioningFailed(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	done := provision(server, bake.StatusFailed)
+
+	_, err := bake.Bake(server.ServiceClient("instances", "v1"), bakeOpts(bake.ImageTarget))
+	require.NoError(t, <-done)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "provisioning failed")
+	require.Empty(t, server.Resources("images"))
+	requireCleanedUp(t, server)
+}
+
+func TestBakeProvisioningTimeout(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+
+	opts := bakeOpts(bake.ImageTarget)
+	opts.ProvisionWait.Timeout = 50 * time.Millisecond
+	_, err := bake.Bake(server.ServiceClient("instances", "v1"), opts)
+	require.True(t, errors.Is(err, gcorecloud.ErrWaitTimeout))
+	requireCleanedUp(t, server)
+}
+
+func TestBakeValidation(t *testing.T) {
+	opts := bakeOpts("iso")
+	require.Error(t, opts.Validate())
+
+	opts = bakeOpts(bake.ImageTarget)
+	opts.BaseImageID = ""
+	require.Error(t, opts.Validate())
+}
@@ -0,0 +1,2 @@
+// bake unit tests
+package testing
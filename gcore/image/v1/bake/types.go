@@ -0,0 +1,69 @@
+package bake
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Target is the kind of resource baked from the boot volume.
+type Target string
+
+const (
+	ImageTarget    Target = "image"
+	SnapshotTarget Target = "snapshot"
+)
+
+func (t Target) IsValid() error {
+	switch t {
+	case ImageTarget, SnapshotTarget:
+		return nil
+	}
+	return fmt.Errorf("invalid Target type: %v", t)
+}
+
+func (t Target) ValidOrNil() (*Target, error) {
+	if t.String() == "" {
+		return nil, nil
+	}
+	err := t.IsValid()
+	if err != nil {
+		return &t, err
+	}
+	return &t, nil
+}
+
+func (t Target) String() string {
+	return string(t)
+}
+
+func (t Target) List() []Target {
+	return []Target{ImageTarget, SnapshotTarget}
+}
+
+func (t Target) StringList() []string {
+	var s []string
+	for _, v := range t.List() {
+		s = append(s, v.String())
+	}
+	return s
+}
+
+// UnmarshalJSON - implements Unmarshaler interface for Target
+func (t *Target) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v := Target(s)
+	err := v.IsValid()
+	if err != nil {
+		return err
+	}
+	*t = v
+	return nil
+}
+
+// MarshalJSON - implements Marshaler interface for Target
+func (t *Target) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
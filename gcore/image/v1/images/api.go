@@ -0,0 +1,68 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package images
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of images operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Create(opts CreateOptsBuilder) tasks.Result
+	Delete(imageID string) tasks.Result
+	Get(id string) GetResult
+	List(opts ListOptsBuilder) pagination.Pager
+	ListAll(opts ListOptsBuilder) ([]Image, error)
+	Update(id string, opts UpdateOptsBuilder) UpdateResult
+	Upload(opts UploadOptsBuilder) tasks.Result
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(imageID string) tasks.Result {
+	return Delete(s.ServiceClient, imageID)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id string) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(opts ListOptsBuilder) ([]Image, error) {
+	return ListAll(s.ServiceClient, opts)
+}
+
+// Update calls the package level Update function with the service client.
+func (s *Service) Update(id string, opts UpdateOptsBuilder) UpdateResult {
+	return Update(s.ServiceClient, id, opts)
+}
+
+// Upload calls the package level Upload function with the service client.
+func (s *Service) Upload(opts UploadOptsBuilder) tasks.Result {
+	return Upload(s.ServiceClient, opts)
+}
@@ -0,0 +1,124 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of images.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/image/v1/images"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of images.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc  func(opts images.CreateOptsBuilder) tasks.Result
+	DeleteFunc  func(imageID string) tasks.Result
+	GetFunc     func(id string) images.GetResult
+	ListFunc    func(opts images.ListOptsBuilder) pagination.Pager
+	ListAllFunc func(opts images.ListOptsBuilder) ([]images.Image, error)
+	UpdateFunc  func(id string, opts images.UpdateOptsBuilder) images.UpdateResult
+	UploadFunc  func(opts images.UploadOptsBuilder) tasks.Result
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ images.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements images.API.
+func (m *API) Create(opts images.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: images.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements images.API.
+func (m *API) Delete(imageID string) tasks.Result {
+	m.record("Delete", imageID)
+	if m.DeleteFunc == nil {
+		panic("mocks: images.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(imageID)
+}
+
+// Get implements images.API.
+func (m *API) Get(id string) images.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: images.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// List implements images.API.
+func (m *API) List(opts images.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: images.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements images.API.
+func (m *API) ListAll(opts images.ListOptsBuilder) ([]images.Image, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: images.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// Update implements images.API.
+func (m *API) Update(id string, opts images.UpdateOptsBuilder) images.UpdateResult {
+	m.record("Update", id, opts)
+	if m.UpdateFunc == nil {
+		panic("mocks: images.API.UpdateFunc is not set")
+	}
+	return m.UpdateFunc(id, opts)
+}
+
+// Upload implements images.API.
+func (m *API) Upload(opts images.UploadOptsBuilder) tasks.Result {
+	m.record("Upload", opts)
+	if m.UploadFunc == nil {
+		panic("mocks: images.API.UploadFunc is not set")
+	}
+	return m.UploadFunc(opts)
+}
@@ -46,6 +46,7 @@ type CreateOpts struct {
 	HwFirmwareType types.HwFirmwareType  `json:"hw_firmware_type" validate:"required,enum"`
 	Source         types.ImageSourceType `json:"source" validate:"required,enum"`
 	VolumeID       string                `json:"volume_id" required:"true" validate:"required"`
+	Metadata       map[string]string     `json:"metadata,omitempty"`
 }
 
 /*
@@ -0,0 +1,36 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package console
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+)
+
+// API is the set of console operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	NewInstanceBridge(id string, opts Opts) *Bridge
+	NewSpiceBridge(id string, opts Opts) *Bridge
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// NewInstanceBridge calls the package level NewInstanceBridge function with the service client.
+func (s *Service) NewInstanceBridge(id string, opts Opts) *Bridge {
+	return NewInstanceBridge(s.ServiceClient, id, opts)
+}
+
+// NewSpiceBridge calls the package level NewSpiceBridge function with the service client.
+func (s *Service) NewSpiceBridge(id string, opts Opts) *Bridge {
+	return NewSpiceBridge(s.ServiceClient, id, opts)
+}
@@ -0,0 +1,72 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of console.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/console"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of console.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	NewInstanceBridgeFunc func(id string, opts console.Opts) *console.Bridge
+	NewSpiceBridgeFunc    func(id string, opts console.Opts) *console.Bridge
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ console.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// NewInstanceBridge implements console.API.
+func (m *API) NewInstanceBridge(id string, opts console.Opts) *console.Bridge {
+	m.record("NewInstanceBridge", id, opts)
+	if m.NewInstanceBridgeFunc == nil {
+		panic("mocks: console.API.NewInstanceBridgeFunc is not set")
+	}
+	return m.NewInstanceBridgeFunc(id, opts)
+}
+
+// NewSpiceBridge implements console.API.
+func (m *API) NewSpiceBridge(id string, opts console.Opts) *console.Bridge {
+	m.record("NewSpiceBridge", id, opts)
+	if m.NewSpiceBridgeFunc == nil {
+		panic("mocks: console.API.NewSpiceBridgeFunc is not set")
+	}
+	return m.NewSpiceBridgeFunc(id, opts)
+}
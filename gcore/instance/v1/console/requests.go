@@ -0,0 +1,236 @@
+package console
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+)
+
+// DefaultURLTTL is how long a console URL is reused for new connections. Console tokens are short-lived.
+const DefaultURLTTL = 5 * time.Minute
+
+// DefaultProtocols are the subprotocols requested from the console proxies.
+var DefaultProtocols = []string{"binary"}
+
+// Source returns a new remote console descriptor.
+type Source func() (*instances.RemoteConsole, error)
+
+// Opts configures a Bridge.
+type Opts struct {
+	// URLTTL is DefaultURLTTL if not set.
+	URLTTL time.Duration
+	// MessageType is the type of the messages sent to the console, BinaryMessage if not set.
+	MessageType MessageType
+	// DialOpts.Protocols are DefaultProtocols if not set.
+	DialOpts DialOpts
+}
+
+// Bridge connects local streams to the websocket of a remote console.
+type Bridge struct {
+	source Source
+	opts   Opts
+
+	mu      sync.Mutex
+	console *instances.RemoteConsole
+	fetched time.Time
+}
+
+// NewBridge returns a bridge to the consoles returned by the source.
+func NewBridge(source Source, opts Opts) *Bridge {
+	if opts.URLTTL <= 0 {
+		opts.URLTTL = DefaultURLTTL
+	}
+	if opts.MessageType == 0 {
+		opts.MessageType = BinaryMessage
+	}
+	if len(opts.DialOpts.Protocols) == 0 {
+		opts.DialOpts.Protocols = DefaultProtocols
+	}
+	return &Bridge{source: source, opts: opts}
+}
+
+// NewInstanceBridge returns a bridge to the console of the instance, see instances.GetInstanceConsole.
+func NewInstanceBridge(client *gcorecloud.ServiceClient, id string, opts Opts) *Bridge {
+	return NewBridge(func() (*instances.RemoteConsole, error) {
+		return instances.GetInstanceConsole(client, id).Extract()
+	}, opts)
+}
+
+// NewSpiceBridge returns a bridge to the spice console of the instance, see instances.GetSpiceConsole.
+func NewSpiceBridge(client *gcorecloud.ServiceClient, id string, opts Opts) *Bridge {
+	return NewBridge(func() (*instances.RemoteConsole, error) {
+		return instances.GetSpiceConsole(client, id).Extract()
+	}, opts)
+}
+
+// WebsocketURL returns the websocket endpoint of a console descriptor. The URL of an HTML console page is turned
+// into the websocket URL of its proxy: the path query parameter of noVNC pages, relative to the host, is used if
+// present, the directory of the page with the same query otherwise.
+func WebsocketURL(rc *instances.RemoteConsole) (string, error) {
+	u, err := url.Parse(rc.URL)
+	if err != nil {
+		return "", err
+	}
+	switch u.Scheme {
+	case "ws", "wss":
+		return u.String(), nil
+	case "http":
+		u.Scheme = "ws"
+	case "https":
+		u.Scheme = "wss"
+	default:
+		return "", fmt.Errorf("unsupported console URL scheme %q", u.Scheme)
+	}
+	if !strings.HasSuffix(u.Path, ".html") {
+		return u.String(), nil
+	}
+	if p := u.Query().Get("path"); p != "" {
+		ref, err := url.Parse(p)
+		if err != nil {
+			return "", err
+		}
+		u.Path, u.RawPath, u.RawQuery = "/"+strings.TrimPrefix(ref.Path, "/"), "", ref.RawQuery
+		return u.String(), nil
+	}
+	u.Path = path.Dir(u.Path)
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+	return u.String(), nil
+}
+
+// Console returns the console descriptor used for new connections. A new one is requested when it is older than
+// URLTTL or when refresh is set.
+func (b *Bridge) Console(refresh bool) (*instances.RemoteConsole, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !refresh && b.console != nil && time.Since(b.fetched) < b.opts.URLTTL {
+		return b.console, nil
+	}
+	rc, err := b.source()
+	if err != nil {
+		return nil, fmt.Errorf("getting remote console: %w", err)
+	}
+	b.console, b.fetched = rc, time.Now()
+	return rc, nil
+}
+
+// Dial connects to the console websocket. When the URL is refused, e.g. because its token expired, a new console
+// descriptor is requested and the connection is retried once.
+func (b *Bridge) Dial(ctx context.Context) (*Conn, error) {
+	conn, err := b.dial(ctx, false)
+	var handshakeErr HandshakeError
+	if errors.As(err, &handshakeErr) && handshakeErr.Refused() {
+		conn, err = b.dial(ctx, true)
+	}
+	return conn, err
+}
+
+func (b *Bridge) dial(ctx context.Context, refresh bool) (*Conn, error) {
+	rc, err := b.Console(refresh)
+	if err != nil {
+		return nil, err
+	}
+	wsURL, err := WebsocketURL(rc)
+	if err != nil {
+		return nil, err
+	}
+	return Dial(ctx, wsURL, b.opts.DialOpts)
+}
+
+// Serve connects to the console and bridges it with the local stream until either side is closed or ctx is done.
+func (b *Bridge) Serve(ctx context.Context, local io.ReadWriter) error {
+	conn, err := b.Dial(ctx)
+	if err != nil {
+		return err
+	}
+	return Pipe(ctx, conn, local, b.opts.MessageType)
+}
+
+// ServeListener accepts local connections, e.g. of a VNC client, and bridges each one to a new console connection.
+// It returns when ctx is done or the listener fails. The errors of the connections are reported to onError if set.
+func (b *Bridge) ServeListener(ctx context.Context, listener net.Listener, onError func(error)) error {
+	go func() {
+		<-ctx.Done()
+		_ = listener.Close()
+	}()
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		local, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer local.Close()
+			if err := b.Serve(ctx, local); err != nil && onError != nil {
+				onError(err)
+			}
+		}()
+	}
+}
+
+// Pipe copies the local input to the websocket as messages of the type and the websocket messages to the local
+// output, until either side is closed or ctx is done. The websocket is closed, as well as the local stream if it is
+// an io.Closer, so a blocked read on it does not outlive Pipe.
+func Pipe(ctx context.Context, conn *Conn, local io.ReadWriter, msgType MessageType) error {
+	done := make(chan error, 2)
+	go func() {
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				done <- err
+				return
+			}
+			if _, err := local.Write(data); err != nil {
+				done <- err
+				return
+			}
+		}
+	}()
+	go func() {
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := local.Read(buf)
+			if n > 0 {
+				if werr := conn.WriteMessage(msgType, buf[:n]); werr != nil {
+					done <- werr
+					return
+				}
+			}
+			if err != nil {
+				done <- err
+				return
+			}
+		}
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+	}
+	_ = conn.Close()
+	if closer, ok := local.(io.Closer); ok {
+		_ = closer.Close()
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, net.ErrClosed) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,238 @@
+package testing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/console"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/stretchr/testify/require"
+)
+
+// standIn is a console proxy accepting the last issued token and echoing the messages.
+type standIn struct {
+	*httptest.Server
+	mu     sync.Mutex
+	issued int
+	token  string
+}
+
+func newStandIn() *standIn {
+	s := &standIn{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		valid := s.token != "" && r.URL.Query().Get("token") == s.token
+		s.mu.Unlock()
+		if !valid {
+			http.Error(w, "invalid token", http.StatusForbidden)
+			return
+		}
+		conn, err := console.Upgrade(w, r, []string{"binary"})
+		if err != nil {
+			return
+		}
+		go func() {
+			defer conn.Close()
+			for {
+				msgType, data, err := conn.ReadMessage()
+				if err != nil {
+					return
+				}
+				if string(data) == "bye" {
+					return
+				}
+				if err := conn.WriteMessage(msgType, data); err != nil {
+					return
+				}
+			}
+		}()
+	}))
+	return s
+}
+
+// source issues a new token, the previous ones expire.
+func (s *standIn) source() (*instances.RemoteConsole, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.issued++
+	s.token = fmt.Sprintf("token-%d", s.issued)
+	host := strings.TrimPrefix(s.URL, "http://")
+	return &instances.RemoteConsole{
+		URL:      fmt.Sprintf("http://%s/vnc_auto.html?token=%s", host, s.token),
+		Type:     "novnc",
+		Protocol: "vnc",
+	}, nil
+}
+
+func (s *standIn) expire() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = ""
+}
+
+func (s *standIn) issuedCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.issued
+}
+
+func TestWebsocketURL(t *testing.T) {
+	cases := map[string]string{
+		"https://92.38.157.200:6082/spice_auto.html?token=369b":          "wss://92.38.157.200:6082/?token=369b",
+		"http://host:6080/vnc_auto.html?path=%3Ftoken%3Dabc":             "ws://host:6080/?token=abc",
+		"https://host/novnc/vnc_lite.html?path=websockify%3Ftoken%3Dabc": "wss://host/websockify?token=abc",
+		"wss://host:6083/?token=serial":                                  "wss://host:6083/?token=serial",
+		"https://host:6083/?token=serial":                                "wss://host:6083/?token=serial",
+	}
+	for consoleURL, expected := range cases {
+		actual, err := console.WebsocketURL(&instances.RemoteConsole{URL: consoleURL})
+		require.NoError(t, err)
+		require.Equal(t, expected, actual, consoleURL)
+	}
+	_, err := console.WebsocketURL(&instances.RemoteConsole{URL: "ftp://host/"})
+	require.Error(t, err)
+}
+
+func TestDialMessages(t *testing.T) {
+	server := newStandIn()
+	defer server.Close()
+	rc, err := server.source()
+	require.NoError(t, err)
+	wsURL, err := console.WebsocketURL(rc)
+	require.NoError(t, err)
+
+	conn, err := console.Dial(context.Background(), wsURL, console.DialOpts{Protocols: []string{"base64", "binary"}})
+	require.NoError(t, err)
+	defer conn.Close()
+	require.Equal(t, "binary", conn.Subprotocol())
+
+	// The pong of the ping is skipped by ReadMessage.
+	require.NoError(t, conn.Ping([]byte("ping")))
+	for _, size := range []int{0, 1, 125, 126, 65535, 65536, 200000} {
+		payload := bytes.Repeat([]byte{byte(size)}, size)
+		require.NoError(t, conn.WriteMessage(console.BinaryMessage, payload))
+		msgType, data, err := conn.ReadMessage()
+		require.NoError(t, err)
+		require.Equal(t, console.BinaryMessage, msgType)
+		require.Equal(t, payload, data, "size %d", size)
+	}
+	require.NoError(t, conn.WriteMessage(console.TextMessage, []byte("login:")))
+	msgType, data, err := conn.ReadMessage()
+	require.NoError(t, err)
+	require.Equal(t, console.TextMessage, msgType)
+	require.Equal(t, "login:", string(data))
+
+	// The server closes the connection.
+	require.NoError(t, conn.WriteMessage(console.TextMessage, []byte("bye")))
+	_, _, err = conn.ReadMessage()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestDialRefused(t *testing.T) {
+	server := newStandIn()
+	defer server.Close()
+	_, err := console.Dial(context.Background(), "ws"+strings.TrimPrefix(server.URL, "http")+"/?token=wrong", console.DialOpts{})
+	require.Error(t, err)
+	var handshakeErr console.HandshakeError
+	require.True(t, errors.As(err, &handshakeErr))
+	require.Equal(t, http.StatusForbidden, handshakeErr.StatusCode)
+	require.True(t, handshakeErr.Refused())
+}
+
+func TestBridgeRefreshesExpiredURL(t *testing.T) {
+	server := newStandIn()
+	defer server.Close()
+	bridge := console.NewBridge(server.source, console.Opts{})
+
+	conn, err := bridge.Dial(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+	conn, err = bridge.Dial(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+	require.Equal(t, 1, server.issuedCount())
+
+	server.expire()
+	conn, err = bridge.Dial(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+	require.Equal(t, 2, server.issuedCount())
+}
+
+func TestBridgeURLTTL(t *testing.T) {
+	server := newStandIn()
+	defer server.Close()
+	bridge := console.NewBridge(server.source, console.Opts{URLTTL: time.Nanosecond})
+
+	for i := 0; i < 3; i++ {
+		_, err := bridge.Console(false)
+		require.NoError(t, err)
+		time.Sleep(time.Millisecond)
+	}
+	require.Equal(t, 3, server.issuedCount())
+}
+
+func TestBridgeServeListener(t *testing.T) {
+	server := newStandIn()
+	defer server.Close()
+	bridge := console.NewBridge(server.source, console.Opts{})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() {
+		served <- bridge.ServeListener(ctx, listener, nil)
+	}()
+
+	for i := 0; i < 2; i++ {
+		local, err := net.Dial("tcp", listener.Addr().String())
+		require.NoError(t, err)
+		_, err = local.Write([]byte("RFB 003.008\n"))
+		require.NoError(t, err)
+		buf := make([]byte, 12)
+		_, err = io.ReadFull(local, buf)
+		require.NoError(t, err)
+		require.Equal(t, "RFB 003.008\n", string(buf))
+		require.NoError(t, local.Close())
+	}
+
+	cancel()
+	select {
+	case err := <-served:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("ServeListener did not return")
+	}
+}
+
+func TestServeEndsWhenConsoleCloses(t *testing.T) {
+	server := newStandIn()
+	defer server.Close()
+	bridge := console.NewBridge(server.source, console.Opts{})
+
+	local, remote := net.Pipe()
+	served := make(chan error, 1)
+	go func() {
+		served <- bridge.Serve(context.Background(), remote)
+	}()
+	_, err := local.Write([]byte("bye"))
+	require.NoError(t, err)
+
+	select {
+	case err := <-served:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve did not return")
+	}
+}
@@ -0,0 +1,2 @@
+// console unit tests
+package testing
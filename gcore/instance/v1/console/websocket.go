@@ -0,0 +1,415 @@
+package console
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1" // nolint
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MessageType is the type of a websocket data message.
+type MessageType int
+
+const (
+	TextMessage   MessageType = 1
+	BinaryMessage MessageType = 2
+)
+
+const (
+	opContinuation = 0x0
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xa
+
+	finBit  = 0x80
+	maskBit = 0x80
+
+	// maxFramePayload bounds the memory a single frame can allocate.
+	maxFramePayload = 16 << 20
+
+	closeNormal = 1000
+
+	acceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+)
+
+// ErrFrameTooLarge is returned when a frame exceeds the supported payload size.
+var ErrFrameTooLarge = errors.New("websocket frame too large")
+
+// HandshakeError is returned by Dial when the server refuses the websocket upgrade.
+type HandshakeError struct {
+	StatusCode int
+	Status     string
+}
+
+func (e HandshakeError) Error() string {
+	return fmt.Sprintf("websocket handshake failed: %s", e.Status)
+}
+
+// Refused reports whether the server refused the request itself, e.g. because the console token expired,
+// rather than failing.
+func (e HandshakeError) Refused() bool {
+	return e.StatusCode >= 400 && e.StatusCode < 500
+}
+
+// DialOpts configures the websocket handshake.
+type DialOpts struct {
+	// Protocols are the requested subprotocols in order of preference.
+	Protocols []string
+	// Header is added to the handshake request. The Origin is derived from the URL when it is not set.
+	Header    http.Header
+	TLSConfig *tls.Config
+}
+
+// Conn is a websocket connection. Reads and writes may be called concurrently with each other,
+// but not concurrently with themselves.
+type Conn struct {
+	conn     net.Conn
+	br       *bufio.Reader
+	client   bool
+	protocol string
+
+	writeMu   sync.Mutex
+	closeOnce sync.Once
+	closeErr  error
+	// message is the payload of a fragmented message being read.
+	message []byte
+	msgType MessageType
+}
+
+// Subprotocol returns the subprotocol selected during the handshake.
+func (c *Conn) Subprotocol() string {
+	return c.protocol
+}
+
+// LocalAddr returns the local network address.
+func (c *Conn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+func newKey() (string, error) {
+	key := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+func acceptKey(key string) string {
+	h := sha1.New() // nolint
+	h.Write([]byte(key + acceptGUID))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// Dial opens a websocket connection to a ws or wss URL.
+func Dial(ctx context.Context, rawURL string, opts DialOpts) (*Conn, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	var secure bool
+	switch u.Scheme {
+	case "ws":
+	case "wss":
+		secure = true
+	default:
+		return nil, fmt.Errorf("unsupported websocket scheme %q", u.Scheme)
+	}
+	host := u.Host
+	if u.Port() == "" {
+		if secure {
+			host = net.JoinHostPort(u.Hostname(), "443")
+		} else {
+			host = net.JoinHostPort(u.Hostname(), "80")
+		}
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	if secure {
+		config := &tls.Config{}
+		if opts.TLSConfig != nil {
+			config = opts.TLSConfig.Clone()
+		}
+		if config.ServerName == "" {
+			config.ServerName = u.Hostname()
+		}
+		tlsConn := tls.Client(conn, config)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+
+	c, err := handshake(conn, u, secure, opts)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	_ = conn.SetDeadline(time.Time{})
+	return c, nil
+}
+
+func handshake(conn net.Conn, u *url.URL, secure bool, opts DialOpts) (*Conn, error) {
+	key, err := newKey()
+	if err != nil {
+		return nil, err
+	}
+	scheme := "http"
+	if secure {
+		scheme = "https"
+	}
+	req := &http.Request{
+		Method:     http.MethodGet,
+		URL:        &url.URL{Scheme: scheme, Host: u.Host, Path: u.Path, RawPath: u.RawPath, RawQuery: u.RawQuery},
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     make(http.Header),
+		Host:       u.Host,
+	}
+	for k, v := range opts.Header {
+		req.Header[k] = v
+	}
+	if req.Header.Get("Origin") == "" {
+		req.Header.Set("Origin", scheme+"://"+u.Host)
+	}
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Sec-WebSocket-Key", key)
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	if len(opts.Protocols) > 0 {
+		req.Header.Set("Sec-WebSocket-Protocol", strings.Join(opts.Protocols, ", "))
+	}
+	if err := req.Write(conn); err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		return nil, err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		return nil, HandshakeError{StatusCode: resp.StatusCode, Status: resp.Status}
+	}
+	if !strings.EqualFold(resp.Header.Get("Upgrade"), "websocket") ||
+		resp.Header.Get("Sec-WebSocket-Accept") != acceptKey(key) {
+		return nil, fmt.Errorf("invalid websocket handshake response")
+	}
+	return &Conn{conn: conn, br: br, client: true, protocol: resp.Header.Get("Sec-WebSocket-Protocol")}, nil
+}
+
+// Upgrade accepts a websocket handshake on the server side and selects the first of the protocols requested
+// by the client. It is meant for stand-in console servers.
+func Upgrade(w http.ResponseWriter, r *http.Request, protocols []string) (*Conn, error) {
+	if r.Method != http.MethodGet || !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") ||
+		r.Header.Get("Sec-WebSocket-Version") != "13" || r.Header.Get("Sec-WebSocket-Key") == "" {
+		http.Error(w, "websocket handshake expected", http.StatusBadRequest)
+		return nil, fmt.Errorf("invalid websocket handshake request")
+	}
+	var protocol string
+	for _, requested := range strings.Split(r.Header.Get("Sec-WebSocket-Protocol"), ",") {
+		requested = strings.TrimSpace(requested)
+		for _, p := range protocols {
+			if protocol == "" && requested == p {
+				protocol = p
+			}
+		}
+	}
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "websocket not supported", http.StatusInternalServerError)
+		return nil, fmt.Errorf("response writer can't be hijacked")
+	}
+	conn, rw, err := hijacker.Hijack()
+	if err != nil {
+		return nil, err
+	}
+	response := "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + acceptKey(r.Header.Get("Sec-WebSocket-Key")) + "\r\n"
+	if protocol != "" {
+		response += "Sec-WebSocket-Protocol: " + protocol + "\r\n"
+	}
+	if _, err := rw.WriteString(response + "\r\n"); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if err := rw.Flush(); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return &Conn{conn: conn, br: rw.Reader, protocol: protocol}, nil
+}
+
+// writeFrame writes a single final frame. Client frames are masked.
+func (c *Conn) writeFrame(opcode byte, payload []byte) error {
+	header := make([]byte, 2, 14)
+	header[0] = finBit | opcode
+	length := len(payload)
+	switch {
+	case length < 126:
+		header[1] = byte(length)
+	case length <= 0xffff:
+		header[1] = 126
+		header = append(header, 0, 0)
+		binary.BigEndian.PutUint16(header[2:], uint16(length))
+	default:
+		header[1] = 127
+		header = append(header, make([]byte, 8)...)
+		binary.BigEndian.PutUint64(header[2:], uint64(length))
+	}
+	data := payload
+	if c.client {
+		header[1] |= maskBit
+		mask := make([]byte, 4)
+		if _, err := io.ReadFull(rand.Reader, mask); err != nil {
+			return err
+		}
+		header = append(header, mask...)
+		data = make([]byte, length)
+		for i := range payload {
+			data[i] = payload[i] ^ mask[i%4]
+		}
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if _, err := c.conn.Write(append(header, data...)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// readFrame reads a frame and unmasks its payload.
+func (c *Conn) readFrame() (fin bool, opcode byte, payload []byte, err error) {
+	var header [2]byte
+	if _, err = io.ReadFull(c.br, header[:]); err != nil {
+		return
+	}
+	fin = header[0]&finBit != 0
+	opcode = header[0] & 0x0f
+	masked := header[1]&maskBit != 0
+	length := uint64(header[1] & 0x7f)
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err = io.ReadFull(c.br, ext[:]); err != nil {
+			return
+		}
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err = io.ReadFull(c.br, ext[:]); err != nil {
+			return
+		}
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+	if length > maxFramePayload {
+		err = ErrFrameTooLarge
+		return
+	}
+	var mask [4]byte
+	if masked {
+		if _, err = io.ReadFull(c.br, mask[:]); err != nil {
+			return
+		}
+	}
+	payload = make([]byte, length)
+	if _, err = io.ReadFull(c.br, payload); err != nil {
+		return
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+	return
+}
+
+// ReadMessage reads the next data message. Fragmented messages are reassembled and pings are answered.
+// io.EOF is returned once the peer closed the connection.
+func (c *Conn) ReadMessage() (MessageType, []byte, error) {
+	for {
+		fin, opcode, payload, err := c.readFrame()
+		if err != nil {
+			return 0, nil, err
+		}
+		switch opcode {
+		case opPing:
+			if err := c.writeFrame(opPong, payload); err != nil {
+				return 0, nil, err
+			}
+			continue
+		case opPong:
+			continue
+		case opClose:
+			_ = c.Close()
+			return 0, nil, io.EOF
+		case opContinuation:
+			if c.msgType == 0 {
+				return 0, nil, fmt.Errorf("unexpected continuation frame")
+			}
+			c.message = append(c.message, payload...)
+		case byte(TextMessage), byte(BinaryMessage):
+			if c.msgType != 0 {
+				return 0, nil, fmt.Errorf("unexpected data frame in a fragmented message")
+			}
+			c.msgType, c.message = MessageType(opcode), payload
+		default:
+			return 0, nil, fmt.Errorf("unknown websocket opcode %d", opcode)
+		}
+		if len(c.message) > maxFramePayload {
+			return 0, nil, ErrFrameTooLarge
+		}
+		if fin {
+			msgType, message := c.msgType, c.message
+			c.msgType, c.message = 0, nil
+			return msgType, message, nil
+		}
+	}
+}
+
+// WriteMessage writes a data message in a single frame.
+func (c *Conn) WriteMessage(msgType MessageType, data []byte) error {
+	if msgType != TextMessage && msgType != BinaryMessage {
+		return fmt.Errorf("invalid message type %d", msgType)
+	}
+	return c.writeFrame(byte(msgType), data)
+}
+
+// Ping sends a ping, the peer answers it with a pong.
+func (c *Conn) Ping(data []byte) error {
+	return c.writeFrame(opPing, data)
+}
+
+// Close sends a normal close frame and closes the connection.
+func (c *Conn) Close() error {
+	c.closeOnce.Do(func() {
+		payload := make([]byte, 2)
+		binary.BigEndian.PutUint16(payload, closeNormal)
+		_ = c.conn.SetWriteDeadline(time.Now().Add(time.Second))
+		_ = c.writeFrame(opClose, payload)
+		c.closeErr = c.conn.Close()
+	})
+	return c.closeErr
+}
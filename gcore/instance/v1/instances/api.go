@@ -0,0 +1,287 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package instances
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/flavor/v1/flavors"
+	"github.com/G-Core/gcorelabscloud-go/gcore/snapshot/v1/snapshots"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/utils/metadata"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of instances operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	AssignSecurityGroup(id string, opts SecurityGroupOptsBuilder) SecurityGroupActionResult
+	AttachInterface(id string, opts InterfaceOptsBuilder) tasks.Result
+	Bulk(selector Selector, opts BulkOpts) ([]BulkResult, error)
+	BulkInstances(instances []Instance, opts BulkOpts) ([]BulkResult, error)
+	Create(opts CreateOptsBuilder) tasks.Result
+	Delete(instanceID string, opts DeleteOptsBuilder) tasks.Result
+	DetachInterface(id string, opts InterfaceOptsBuilder) tasks.Result
+	Get(id string) GetResult
+	GetDetails(id string, opts DetailsOpts) (*InstanceDetails, error)
+	GetInstanceConsole(id string) RemoteConsoleResult
+	GetSpiceConsole(id string) RemoteConsoleResult
+	List(opts ListOptsBuilder) pagination.Pager
+	ListAll(opts ListOptsBuilder) ([]Instance, error)
+	ListAllDetails(listOpts ListOptsBuilder, opts DetailsOpts) ([]InstanceDetails, error)
+	ListAvailableFlavors(id string, opts flavors.ListOptsBuilder) flavors.ListResult
+	ListInstanceLocation(opts ListInstanceLocationOptsBuilder) SearchLocationResult
+	ListInstanceMetrics(id string, opts ListMetricsOptsBuilder) ListMetricsResult
+	ListInterfaces(id string) pagination.Pager
+	ListInterfacesAll(id string) ([]Interface, error)
+	ListPorts(id string) pagination.Pager
+	ListPortsAll(id string) ([]InstancePorts, error)
+	ListSecurityGroups(id string) pagination.Pager
+	ListSecurityGroupsAll(id string) ([]gcorecloud.ItemIDName, error)
+	MetadataCreate(id string, opts MetadataSetOpts) MetadataActionResult
+	MetadataDelete(id string, key string) MetadataActionResult
+	MetadataGet(id string, key string) MetadataResult
+	MetadataList(id string) pagination.Pager
+	MetadataListAll(id string) ([]metadata.Metadata, error)
+	MetadataUpdate(id string, opts MetadataSetOpts) MetadataActionResult
+	PowerCycle(id string) UpdateResult
+	Reboot(id string) UpdateResult
+	RenameInstance(id string, opts RenameInstanceOptsBuilder) GetResult
+	Resize(id string, opts ChangeFlavorOptsBuilder) tasks.Result
+	RestoreFromSnapshots(id string, tag string, opts gcorecloud.WaitOpts) ([]VolumeRestore, error)
+	Resume(id string) UpdateResult
+	Select(selector Selector) ([]Instance, error)
+	SelectResizeFlavors(id string, req flavors.Requirements) ([]flavors.Match, error)
+	SnapshotAll(id string, tag string, opts gcorecloud.WaitOpts) ([]snapshots.Snapshot, error)
+	Start(id string) UpdateResult
+	Stop(id string) UpdateResult
+	Suspend(id string) UpdateResult
+	UnAssignSecurityGroup(id string, opts SecurityGroupOptsBuilder) SecurityGroupActionResult
+	WaitForStatus(id string, status string, vmState string, opts gcorecloud.WaitOpts) (*Instance, error)
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// AssignSecurityGroup calls the package level AssignSecurityGroup function with the service client.
+func (s *Service) AssignSecurityGroup(id string, opts SecurityGroupOptsBuilder) SecurityGroupActionResult {
+	return AssignSecurityGroup(s.ServiceClient, id, opts)
+}
+
+// AttachInterface calls the package level AttachInterface function with the service client.
+func (s *Service) AttachInterface(id string, opts InterfaceOptsBuilder) tasks.Result {
+	return AttachInterface(s.ServiceClient, id, opts)
+}
+
+// Bulk calls the package level Bulk function with the service client.
+func (s *Service) Bulk(selector Selector, opts BulkOpts) ([]BulkResult, error) {
+	return Bulk(s.ServiceClient, selector, opts)
+}
+
+// BulkInstances calls the package level BulkInstances function with the service client.
+func (s *Service) BulkInstances(instances []Instance, opts BulkOpts) ([]BulkResult, error) {
+	return BulkInstances(s.ServiceClient, instances, opts)
+}
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(instanceID string, opts DeleteOptsBuilder) tasks.Result {
+	return Delete(s.ServiceClient, instanceID, opts)
+}
+
+// DetachInterface calls the package level DetachInterface function with the service client.
+func (s *Service) DetachInterface(id string, opts InterfaceOptsBuilder) tasks.Result {
+	return DetachInterface(s.ServiceClient, id, opts)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id string) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// GetDetails calls the package level GetDetails function with the service client.
+func (s *Service) GetDetails(id string, opts DetailsOpts) (*InstanceDetails, error) {
+	return GetDetails(s.ServiceClient, id, opts)
+}
+
+// GetInstanceConsole calls the package level GetInstanceConsole function with the service client.
+func (s *Service) GetInstanceConsole(id string) RemoteConsoleResult {
+	return GetInstanceConsole(s.ServiceClient, id)
+}
+
+// GetSpiceConsole calls the package level GetSpiceConsole function with the service client.
+func (s *Service) GetSpiceConsole(id string) RemoteConsoleResult {
+	return GetSpiceConsole(s.ServiceClient, id)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(opts ListOptsBuilder) ([]Instance, error) {
+	return ListAll(s.ServiceClient, opts)
+}
+
+// ListAllDetails calls the package level ListAllDetails function with the service client.
+func (s *Service) ListAllDetails(listOpts ListOptsBuilder, opts DetailsOpts) ([]InstanceDetails, error) {
+	return ListAllDetails(s.ServiceClient, listOpts, opts)
+}
+
+// ListAvailableFlavors calls the package level ListAvailableFlavors function with the service client.
+func (s *Service) ListAvailableFlavors(id string, opts flavors.ListOptsBuilder) flavors.ListResult {
+	return ListAvailableFlavors(s.ServiceClient, id, opts)
+}
+
+// ListInstanceLocation calls the package level ListInstanceLocation function with the service client.
+func (s *Service) ListInstanceLocation(opts ListInstanceLocationOptsBuilder) SearchLocationResult {
+	return ListInstanceLocation(s.ServiceClient, opts)
+}
+
+// ListInstanceMetrics calls the package level ListInstanceMetrics function with the service client.
+func (s *Service) ListInstanceMetrics(id string, opts ListMetricsOptsBuilder) ListMetricsResult {
+	return ListInstanceMetrics(s.ServiceClient, id, opts)
+}
+
+// ListInterfaces calls the package level ListInterfaces function with the service client.
+func (s *Service) ListInterfaces(id string) pagination.Pager {
+	return ListInterfaces(s.ServiceClient, id)
+}
+
+// ListInterfacesAll calls the package level ListInterfacesAll function with the service client.
+func (s *Service) ListInterfacesAll(id string) ([]Interface, error) {
+	return ListInterfacesAll(s.ServiceClient, id)
+}
+
+// ListPorts calls the package level ListPorts function with the service client.
+func (s *Service) ListPorts(id string) pagination.Pager {
+	return ListPorts(s.ServiceClient, id)
+}
+
+// ListPortsAll calls the package level ListPortsAll function with the service client.
+func (s *Service) ListPortsAll(id string) ([]InstancePorts, error) {
+	return ListPortsAll(s.ServiceClient, id)
+}
+
+// ListSecurityGroups calls the package level ListSecurityGroups function with the service client.
+func (s *Service) ListSecurityGroups(id string) pagination.Pager {
+	return ListSecurityGroups(s.ServiceClient, id)
+}
+
+// ListSecurityGroupsAll calls the package level ListSecurityGroupsAll function with the service client.
+func (s *Service) ListSecurityGroupsAll(id string) ([]gcorecloud.ItemIDName, error) {
+	return ListSecurityGroupsAll(s.ServiceClient, id)
+}
+
+// MetadataCreate calls the package level MetadataCreate function with the service client.
+func (s *Service) MetadataCreate(id string, opts MetadataSetOpts) MetadataActionResult {
+	return MetadataCreate(s.ServiceClient, id, opts)
+}
+
+// MetadataDelete calls the package level MetadataDelete function with the service client.
+func (s *Service) MetadataDelete(id string, key string) MetadataActionResult {
+	return MetadataDelete(s.ServiceClient, id, key)
+}
+
+// MetadataGet calls the package level MetadataGet function with the service client.
+func (s *Service) MetadataGet(id string, key string) MetadataResult {
+	return MetadataGet(s.ServiceClient, id, key)
+}
+
+// MetadataList calls the package level MetadataList function with the service client.
+func (s *Service) MetadataList(id string) pagination.Pager {
+	return MetadataList(s.ServiceClient, id)
+}
+
+// MetadataListAll calls the package level MetadataListAll function with the service client.
+func (s *Service) MetadataListAll(id string) ([]metadata.Metadata, error) {
+	return MetadataListAll(s.ServiceClient, id)
+}
+
+// MetadataUpdate calls the package level MetadataUpdate function with the service client.
+func (s *Service) MetadataUpdate(id string, opts MetadataSetOpts) MetadataActionResult {
+	return MetadataUpdate(s.ServiceClient, id, opts)
+}
+
+// PowerCycle calls the package level PowerCycle function with the service client.
+func (s *Service) PowerCycle(id string) UpdateResult {
+	return PowerCycle(s.ServiceClient, id)
+}
+
+// Reboot calls the package level Reboot function with the service client.
+func (s *Service) Reboot(id string) UpdateResult {
+	return Reboot(s.ServiceClient, id)
+}
+
+// RenameInstance calls the package level RenameInstance function with the service client.
+func (s *Service) RenameInstance(id string, opts RenameInstanceOptsBuilder) GetResult {
+	return RenameInstance(s.ServiceClient, id, opts)
+}
+
+// Resize calls the package level Resize function with the service client.
+func (s *Service) Resize(id string, opts ChangeFlavorOptsBuilder) tasks.Result {
+	return Resize(s.ServiceClient, id, opts)
+}
+
+// RestoreFromSnapshots calls the package level RestoreFromSnapshots function with the service client.
+func (s *Service) RestoreFromSnapshots(id string, tag string, opts gcorecloud.WaitOpts) ([]VolumeRestore, error) {
+	return RestoreFromSnapshots(s.ServiceClient, id, tag, opts)
+}
+
+// Resume calls the package level Resume function with the service client.
+func (s *Service) Resume(id string) UpdateResult {
+	return Resume(s.ServiceClient, id)
+}
+
+// Select calls the package level Select function with the service client.
+func (s *Service) Select(selector Selector) ([]Instance, error) {
+	return Select(s.ServiceClient, selector)
+}
+
+// SelectResizeFlavors calls the package level SelectResizeFlavors function with the service client.
+func (s *Service) SelectResizeFlavors(id string, req flavors.Requirements) ([]flavors.Match, error) {
+	return SelectResizeFlavors(s.ServiceClient, id, req)
+}
+
+// SnapshotAll calls the package level SnapshotAll function with the service client.
+func (s *Service) SnapshotAll(id string, tag string, opts gcorecloud.WaitOpts) ([]snapshots.Snapshot, error) {
+	return SnapshotAll(s.ServiceClient, id, tag, opts)
+}
+
+// Start calls the package level Start function with the service client.
+func (s *Service) Start(id string) UpdateResult {
+	return Start(s.ServiceClient, id)
+}
+
+// Stop calls the package level Stop function with the service client.
+func (s *Service) Stop(id string) UpdateResult {
+	return Stop(s.ServiceClient, id)
+}
+
+// Suspend calls the package level Suspend function with the service client.
+func (s *Service) Suspend(id string) UpdateResult {
+	return Suspend(s.ServiceClient, id)
+}
+
+// UnAssignSecurityGroup calls the package level UnAssignSecurityGroup function with the service client.
+func (s *Service) UnAssignSecurityGroup(id string, opts SecurityGroupOptsBuilder) SecurityGroupActionResult {
+	return UnAssignSecurityGroup(s.ServiceClient, id, opts)
+}
+
+// WaitForStatus calls the package level WaitForStatus function with the service client.
+func (s *Service) WaitForStatus(id string, status string, vmState string, opts gcorecloud.WaitOpts) (*Instance, error) {
+	return WaitForStatus(s.ServiceClient, id, status, vmState, opts)
+}
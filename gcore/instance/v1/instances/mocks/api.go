@@ -0,0 +1,488 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of instances.API.
+package mocks
+
+import (
+	"sync"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/flavor/v1/flavors"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/snapshot/v1/snapshots"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/utils/metadata"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of instances.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	AssignSecurityGroupFunc   func(id string, opts instances.SecurityGroupOptsBuilder) instances.SecurityGroupActionResult
+	AttachInterfaceFunc       func(id string, opts instances.InterfaceOptsBuilder) tasks.Result
+	BulkFunc                  func(selector instances.Selector, opts instances.BulkOpts) ([]instances.BulkResult, error)
+	BulkInstancesFunc         func(instances []instances.Instance, opts instances.BulkOpts) ([]instances.BulkResult, error)
+	CreateFunc                func(opts instances.CreateOptsBuilder) tasks.Result
+	DeleteFunc                func(instanceID string, opts instances.DeleteOptsBuilder) tasks.Result
+	DetachInterfaceFunc       func(id string, opts instances.InterfaceOptsBuilder) tasks.Result
+	GetFunc                   func(id string) instances.GetResult
+	GetDetailsFunc            func(id string, opts instances.DetailsOpts) (*instances.InstanceDetails, error)
+	GetInstanceConsoleFunc    func(id string) instances.RemoteConsoleResult
+	GetSpiceConsoleFunc       func(id string) instances.RemoteConsoleResult
+	ListFunc                  func(opts instances.ListOptsBuilder) pagination.Pager
+	ListAllFunc               func(opts instances.ListOptsBuilder) ([]instances.Instance, error)
+	ListAllDetailsFunc        func(listOpts instances.ListOptsBuilder, opts instances.DetailsOpts) ([]instances.InstanceDetails, error)
+	ListAvailableFlavorsFunc  func(id string, opts flavors.ListOptsBuilder) flavors.ListResult
+	ListInstanceLocationFunc  func(opts instances.ListInstanceLocationOptsBuilder) instances.SearchLocationResult
+	ListInstanceMetricsFunc   func(id string, opts instances.ListMetricsOptsBuilder) instances.ListMetricsResult
+	ListInterfacesFunc        func(id string) pagination.Pager
+	ListInterfacesAllFunc     func(id string) ([]instances.Interface, error)
+	ListPortsFunc             func(id string) pagination.Pager
+	ListPortsAllFunc          func(id string) ([]instances.InstancePorts, error)
+	ListSecurityGroupsFunc    func(id string) pagination.Pager
+	ListSecurityGroupsAllFunc func(id string) ([]gcorecloud.ItemIDName, error)
+	MetadataCreateFunc        func(id string, opts instances.MetadataSetOpts) instances.MetadataActionResult
+	MetadataDeleteFunc        func(id string, key string) instances.MetadataActionResult
+	MetadataGetFunc           func(id string, key string) instances.MetadataResult
+	MetadataListFunc          func(id string) pagination.Pager
+	MetadataListAllFunc       func(id string) ([]metadata.Metadata, error)
+	MetadataUpdateFunc        func(id string, opts instances.MetadataSetOpts) instances.MetadataActionResult
+	PowerCycleFunc            func(id string) instances.UpdateResult
+	RebootFunc                func(id string) instances.UpdateResult
+	RenameInstanceFunc        func(id string, opts instances.RenameInstanceOptsBuilder) instances.GetResult
+	ResizeFunc                func(id string, opts instances.ChangeFlavorOptsBuilder) tasks.Result
+	RestoreFromSnapshotsFunc  func(id string, tag string, opts gcorecloud.WaitOpts) ([]instances.VolumeRestore, error)
+	ResumeFunc                func(id string) instances.UpdateResult
+	SelectFunc                func(selector instances.Selector) ([]instances.Instance, error)
+	SelectResizeFlavorsFunc   func(id string, req flavors.Requirements) ([]flavors.Match, error)
+	SnapshotAllFunc           func(id string, tag string, opts gcorecloud.WaitOpts) ([]snapshots.Snapshot, error)
+	StartFunc                 func(id string) instances.UpdateResult
+	StopFunc                  func(id string) instances.UpdateResult
+	SuspendFunc               func(id string) instances.UpdateResult
+	UnAssignSecurityGroupFunc func(id string, opts instances.SecurityGroupOptsBuilder) instances.SecurityGroupActionResult
+	WaitForStatusFunc         func(id string, status string, vmState string, opts gcorecloud.WaitOpts) (*instances.Instance, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ instances.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// AssignSecurityGroup implements instances.API.
+func (m *API) AssignSecurityGroup(id string, opts instances.SecurityGroupOptsBuilder) instances.SecurityGroupActionResult {
+	m.record("AssignSecurityGroup", id, opts)
+	if m.AssignSecurityGroupFunc == nil {
+		panic("mocks: instances.API.AssignSecurityGroupFunc is not set")
+	}
+	return m.AssignSecurityGroupFunc(id, opts)
+}
+
+// AttachInterface implements instances.API.
+func (m *API) AttachInterface(id string, opts instances.InterfaceOptsBuilder) tasks.Result {
+	m.record("AttachInterface", id, opts)
+	if m.AttachInterfaceFunc == nil {
+		panic("mocks: instances.API.AttachInterfaceFunc is not set")
+	}
+	return m.AttachInterfaceFunc(id, opts)
+}
+
+// Bulk implements instances.API.
+func (m *API) Bulk(selector instances.Selector, opts instances.BulkOpts) ([]instances.BulkResult, error) {
+	m.record("Bulk", selector, opts)
+	if m.BulkFunc == nil {
+		panic("mocks: instances.API.BulkFunc is not set")
+	}
+	return m.BulkFunc(selector, opts)
+}
+
+// BulkInstances implements instances.API.
+func (m *API) BulkInstances(instances []instances.Instance, opts instances.BulkOpts) ([]instances.BulkResult, error) {
+	m.record("BulkInstances", instances, opts)
+	if m.BulkInstancesFunc == nil {
+		panic("mocks: instances.API.BulkInstancesFunc is not set")
+	}
+	return m.BulkInstancesFunc(instances, opts)
+}
+
+// Create implements instances.API.
+func (m *API) Create(opts instances.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: instances.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements instances.API.
+func (m *API) Delete(instanceID string, opts instances.DeleteOptsBuilder) tasks.Result {
+	m.record("Delete", instanceID, opts)
+	if m.DeleteFunc == nil {
+		panic("mocks: instances.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(instanceID, opts)
+}
+
+// DetachInterface implements instances.API.
+func (m *API) DetachInterface(id string, opts instances.InterfaceOptsBuilder) tasks.Result {
+	m.record("DetachInterface", id, opts)
+	if m.DetachInterfaceFunc == nil {
+		panic("mocks: instances.API.DetachInterfaceFunc is not set")
+	}
+	return m.DetachInterfaceFunc(id, opts)
+}
+
+// Get implements instances.API.
+func (m *API) Get(id string) instances.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: instances.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// GetDetails implements instances.API.
+func (m *API) GetDetails(id string, opts instances.DetailsOpts) (*instances.InstanceDetails, error) {
+	m.record("GetDetails", id, opts)
+	if m.GetDetailsFunc == nil {
+		panic("mocks: instances.API.GetDetailsFunc is not set")
+	}
+	return m.GetDetailsFunc(id, opts)
+}
+
+// GetInstanceConsole implements instances.API.
+func (m *API) GetInstanceConsole(id string) instances.RemoteConsoleResult {
+	m.record("GetInstanceConsole", id)
+	if m.GetInstanceConsoleFunc == nil {
+		panic("mocks: instances.API.GetInstanceConsoleFunc is not set")
+	}
+	return m.GetInstanceConsoleFunc(id)
+}
+
+// GetSpiceConsole implements instances.API.
+func (m *API) GetSpiceConsole(id string) instances.RemoteConsoleResult {
+	m.record("GetSpiceConsole", id)
+	if m.GetSpiceConsoleFunc == nil {
+		panic("mocks: instances.API.GetSpiceConsoleFunc is not set")
+	}
+	return m.GetSpiceConsoleFunc(id)
+}
+
+// List implements instances.API.
+func (m *API) List(opts instances.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: instances.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements instances.API.
+func (m *API) ListAll(opts instances.ListOptsBuilder) ([]instances.Instance, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: instances.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// ListAllDetails implements instances.API.
+func (m *API) ListAllDetails(listOpts instances.ListOptsBuilder, opts instances.DetailsOpts) ([]instances.InstanceDetails, error) {
+	m.record("ListAllDetails", listOpts, opts)
+	if m.ListAllDetailsFunc == nil {
+		panic("mocks: instances.API.ListAllDetailsFunc is not set")
+	}
+	return m.ListAllDetailsFunc(listOpts, opts)
+}
+
+// ListAvailableFlavors implements instances.API.
+func (m *API) ListAvailableFlavors(id string, opts flavors.ListOptsBuilder) flavors.ListResult {
+	m.record("ListAvailableFlavors", id, opts)
+	if m.ListAvailableFlavorsFunc == nil {
+		panic("mocks: instances.API.ListAvailableFlavorsFunc is not set")
+	}
+	return m.ListAvailableFlavorsFunc(id, opts)
+}
+
+// ListInstanceLocation implements instances.API.
+func (m *API) ListInstanceLocation(opts instances.ListInstanceLocationOptsBuilder) instances.SearchLocationResult {
+	m.record("ListInstanceLocation", opts)
+	if m.ListInstanceLocationFunc == nil {
+		panic("mocks: instances.API.ListInstanceLocationFunc is not set")
+	}
+	return m.ListInstanceLocationFunc(opts)
+}
+
+// ListInstanceMetrics implements instances.API.
+func (m *API) ListInstanceMetrics(id string, opts instances.ListMetricsOptsBuilder) instances.ListMetricsResult {
+	m.record("ListInstanceMetrics", id, opts)
+	if m.ListInstanceMetricsFunc == nil {
+		panic("mocks: instances.API.ListInstanceMetricsFunc is not set")
+	}
+	return m.ListInstanceMetricsFunc(id, opts)
+}
+
+// ListInterfaces implements instances.API.
+func (m *API) ListInterfaces(id string) pagination.Pager {
+	m.record("ListInterfaces", id)
+	if m.ListInterfacesFunc == nil {
+		panic("mocks: instances.API.ListInterfacesFunc is not set")
+	}
+	return m.ListInterfacesFunc(id)
+}
+
+// ListInterfacesAll implements instances.API.
+func (m *API) ListInterfacesAll(id string) ([]instances.Interface, error) {
+	m.record("ListInterfacesAll", id)
+	if m.ListInterfacesAllFunc == nil {
+		panic("mocks: instances.API.ListInterfacesAllFunc is not set")
+	}
+	return m.ListInterfacesAllFunc(id)
+}
+
+// ListPorts implements instances.API.
+func (m *API) ListPorts(id string) pagination.Pager {
+	m.record("ListPorts", id)
+	if m.ListPortsFunc == nil {
+		panic("mocks: instances.API.ListPortsFunc is not set")
+	}
+	return m.ListPortsFunc(id)
+}
+
+// ListPortsAll implements instances.API.
+func (m *API) ListPortsAll(id string) ([]instances.InstancePorts, error) {
+	m.record("ListPortsAll", id)
+	if m.ListPortsAllFunc == nil {
+		panic("mocks: instances.API.ListPortsAllFunc is not set")
+	}
+	return m.ListPortsAllFunc(id)
+}
+
+// ListSecurityGroups implements instances.API.
+func (m *API) ListSecurityGroups(id string) pagination.Pager {
+	m.record("ListSecurityGroups", id)
+	if m.ListSecurityGroupsFunc == nil {
+		panic("mocks: instances.API.ListSecurityGroupsFunc is not set")
+	}
+	return m.ListSecurityGroupsFunc(id)
+}
+
+// ListSecurityGroupsAll implements instances.API.
+func (m *API) ListSecurityGroupsAll(id string) ([]gcorecloud.ItemIDName, error) {
+	m.record("ListSecurityGroupsAll", id)
+	if m.ListSecurityGroupsAllFunc == nil {
+		panic("mocks: instances.API.ListSecurityGroupsAllFunc is not set")
+	}
+	return m.ListSecurityGroupsAllFunc(id)
+}
+
+// MetadataCreate implements instances.API.
+func (m *API) MetadataCreate(id string, opts instances.MetadataSetOpts) instances.MetadataActionResult {
+	m.record("MetadataCreate", id, opts)
+	if m.MetadataCreateFunc == nil {
+		panic("mocks: instances.API.MetadataCreateFunc is not set")
+	}
+	return m.MetadataCreateFunc(id, opts)
+}
+
+// MetadataDelete implements instances.API.
+func (m *API) MetadataDelete(id string, key string) instances.MetadataActionResult {
+	m.record("MetadataDelete", id, key)
+	if m.MetadataDeleteFunc == nil {
+		panic("mocks: instances.API.MetadataDeleteFunc is not set")
+	}
+	return m.MetadataDeleteFunc(id, key)
+}
+
+// MetadataGet implements instances.API.
+func (m *API) MetadataGet(id string, key string) instances.MetadataResult {
+	m.record("MetadataGet", id, key)
+	if m.MetadataGetFunc == nil {
+		panic("mocks: instances.API.MetadataGetFunc is not set")
+	}
+	return m.MetadataGetFunc(id, key)
+}
+
+// MetadataList implements instances.API.
+func (m *API) MetadataList(id string) pagination.Pager {
+	m.record("MetadataList", id)
+	if m.MetadataListFunc == nil {
+		panic("mocks: instances.API.MetadataListFunc is not set")
+	}
+	return m.MetadataListFunc(id)
+}
+
+// MetadataListAll implements instances.API.
+func (m *API) MetadataListAll(id string) ([]metadata.Metadata, error) {
+	m.record("MetadataListAll", id)
+	if m.MetadataListAllFunc == nil {
+		panic("mocks: instances.API.MetadataListAllFunc is not set")
+	}
+	return m.MetadataListAllFunc(id)
+}
+
+// MetadataUpdate implements instances.API.
+func (m *API) MetadataUpdate(id string, opts instances.MetadataSetOpts) instances.MetadataActionResult {
+	m.record("MetadataUpdate", id, opts)
+	if m.MetadataUpdateFunc == nil {
+		panic("mocks: instances.API.MetadataUpdateFunc is not set")
+	}
+	return m.MetadataUpdateFunc(id, opts)
+}
+
+// PowerCycle implements instances.API.
+func (m *API) PowerCycle(id string) instances.UpdateResult {
+	m.record("PowerCycle", id)
+	if m.PowerCycleFunc == nil {
+		panic("mocks: instances.API.PowerCycleFunc is not set")
+	}
+	return m.PowerCycleFunc(id)
+}
+
+// Reboot implements instances.API.
+func (m *API) Reboot(id string) instances.UpdateResult {
+	m.record("Reboot", id)
+	if m.RebootFunc == nil {
+		panic("mocks: instances.API.RebootFunc is not set")
+	}
+	return m.RebootFunc(id)
+}
+
+// RenameInstance implements instances.API.
+func (m *API) RenameInstance(id string, opts instances.RenameInstanceOptsBuilder) instances.GetResult {
+	m.record("RenameInstance", id, opts)
+	if m.RenameInstanceFunc == nil {
+		panic("mocks: instances.API.RenameInstanceFunc is not set")
+	}
+	return m.RenameInstanceFunc(id, opts)
+}
+
+// Resize implements instances.API.
+func (m *API) Resize(id string, opts instances.ChangeFlavorOptsBuilder) tasks.Result {
+	m.record("Resize", id, opts)
+	if m.ResizeFunc == nil {
+		panic("mocks: instances.API.ResizeFunc is not set")
+	}
+	return m.ResizeFunc(id, opts)
+}
+
+// RestoreFromSnapshots implements instances.API.
+func (m *API) RestoreFromSnapshots(id string, tag string, opts gcorecloud.WaitOpts) ([]instances.VolumeRestore, error) {
+	m.record("RestoreFromSnapshots", id, tag, opts)
+	if m.RestoreFromSnapshotsFunc == nil {
+		panic("mocks: instances.API.RestoreFromSnapshotsFunc is not set")
+	}
+	return m.RestoreFromSnapshotsFunc(id, tag, opts)
+}
+
+// Resume implements instances.API.
+func (m *API) Resume(id string) instances.UpdateResult {
+	m.record("Resume", id)
+	if m.ResumeFunc == nil {
+		panic("mocks: instances.API.ResumeFunc is not set")
+	}
+	return m.ResumeFunc(id)
+}
+
+// Select implements instances.API.
+func (m *API) Select(selector instances.Selector) ([]instances.Instance, error) {
+	m.record("Select", selector)
+	if m.SelectFunc == nil {
+		panic("mocks: instances.API.SelectFunc is not set")
+	}
+	return m.SelectFunc(selector)
+}
+
+// SelectResizeFlavors implements instances.API.
+func (m *API) SelectResizeFlavors(id string, req flavors.Requirements) ([]flavors.Match, error) {
+	m.record("SelectResizeFlavors", id, req)
+	if m.SelectResizeFlavorsFunc == nil {
+		panic("mocks: instances.API.SelectResizeFlavorsFunc is not set")
+	}
+	return m.SelectResizeFlavorsFunc(id, req)
+}
+
+// SnapshotAll implements instances.API.
+func (m *API) SnapshotAll(id string, tag string, opts gcorecloud.WaitOpts) ([]snapshots.Snapshot, error) {
+	m.record("SnapshotAll", id, tag, opts)
+	if m.SnapshotAllFunc == nil {
+		panic("mocks: instances.API.SnapshotAllFunc is not set")
+	}
+	return m.SnapshotAllFunc(id, tag, opts)
+}
+
+// Start implements instances.API.
+func (m *API) Start(id string) instances.UpdateResult {
+	m.record("Start", id)
+	if m.StartFunc == nil {
+		panic("mocks: instances.API.StartFunc is not set")
+	}
+	return m.StartFunc(id)
+}
+
+// Stop implements instances.API.
+func (m *API) Stop(id string) instances.UpdateResult {
+	m.record("Stop", id)
+	if m.StopFunc == nil {
+		panic("mocks: instances.API.StopFunc is not set")
+	}
+	return m.StopFunc(id)
+}
+
+// Suspend implements instances.API.
+func (m *API) Suspend(id string) instances.UpdateResult {
+	m.record("Suspend", id)
+	if m.SuspendFunc == nil {
+		panic("mocks: instances.API.SuspendFunc is not set")
+	}
+	return m.SuspendFunc(id)
+}
+
+// UnAssignSecurityGroup implements instances.API.
+func (m *API) UnAssignSecurityGroup(id string, opts instances.SecurityGroupOptsBuilder) instances.SecurityGroupActionResult {
+	m.record("UnAssignSecurityGroup", id, opts)
+	if m.UnAssignSecurityGroupFunc == nil {
+		panic("mocks: instances.API.UnAssignSecurityGroupFunc is not set")
+	}
+	return m.UnAssignSecurityGroupFunc(id, opts)
+}
+
+// WaitForStatus implements instances.API.
+func (m *API) WaitForStatus(id string, status string, vmState string, opts gcorecloud.WaitOpts) (*instances.Instance, error) {
+	m.record("WaitForStatus", id, status, vmState, opts)
+	if m.WaitForStatusFunc == nil {
+		panic("mocks: instances.API.WaitForStatusFunc is not set")
+	}
+	return m.WaitForStatusFunc(id, status, vmState, opts)
+}
@@ -1,14 +1,23 @@
 package instances
 
 import (
+	"fmt"
 	"log"
 	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/gcore/flavor/v1/flavors"
 	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/snapshot/v1/snapshots"
 	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
 	"github.com/G-Core/gcorelabscloud-go/gcore/utils/metadata"
+	"github.com/G-Core/gcorelabscloud-go/gcore/utils/userdata"
 	"github.com/G-Core/gcorelabscloud-go/gcore/volume/v1/volumes"
 	"github.com/G-Core/gcorelabscloud-go/pagination"
 )
@@ -140,24 +149,28 @@ func (opts InterfaceInstanceCreateOpts) Validate() error {
 
 // CreateOpts represents options used to create a instance.
 type CreateOpts struct {
-	Flavor         string                        `json:"flavor" required:"true"`
-	Names          []string                      `json:"names,omitempty" validate:"required_without=NameTemplates"`
-	NameTemplates  []string                      `json:"name_templates,omitempty" validate:"required_without=Names"`
-	Volumes        []CreateVolumeOpts            `json:"volumes" validate:"dive"`
-	Interfaces     []InterfaceInstanceCreateOpts `json:"interfaces" required:"true" validate:"required,dive"`
-	SecurityGroups []gcorecloud.ItemID           `json:"security_groups,omitempty" validate:"omitempty,dive,uuid4"`
-	Keypair        string                        `json:"keypair_name,omitempty"`
-	Password       string                        `json:"password" validate:"omitempty,required_with=Username"`
-	Username       string                        `json:"username" validate:"omitempty,required_with=Password"`
-	UserData       string                        `json:"user_data" validate:"omitempty,base64"`
-	Metadata       *MetadataSetOpts              `json:"metadata,omitempty" validate:"omitempty,dive"`
-	Configuration  *MetadataSetOpts              `json:"configuration,omitempty" validate:"omitempty,dive"`
-	AllowAppPorts  bool                          `json:"allow_app_ports,omitempty"`
-	ServerGroupID  string                        `json:"servergroup_id,omitempty" validate:"omitempty,uuid4"`
+	Flavor          string                        `json:"flavor" required:"true"`
+	Names           []string                      `json:"names,omitempty" validate:"required_without=NameTemplates"`
+	NameTemplates   []string                      `json:"name_templates,omitempty" validate:"required_without=Names"`
+	Volumes         []CreateVolumeOpts            `json:"volumes" validate:"dive"`
+	Interfaces      []InterfaceInstanceCreateOpts `json:"interfaces" required:"true" validate:"required,dive"`
+	SecurityGroups  []gcorecloud.ItemID           `json:"security_groups,omitempty" validate:"omitempty,dive,uuid4"`
+	Keypair         string                        `json:"keypair_name,omitempty"`
+	Password        string                        `json:"password" validate:"omitempty,required_with=Username"`
+	Username        string                        `json:"username" validate:"omitempty,required_with=Password"`
+	UserData        string                        `json:"user_data" validate:"omitempty,base64"`
+	UserDataBuilder *userdata.Builder             `json:"-" validate:"-"`
+	Metadata        *MetadataSetOpts              `json:"metadata,omitempty" validate:"omitempty,dive"`
+	Configuration   *MetadataSetOpts              `json:"configuration,omitempty" validate:"omitempty,dive"`
+	AllowAppPorts   bool                          `json:"allow_app_ports,omitempty"`
+	ServerGroupID   string                        `json:"servergroup_id,omitempty" validate:"omitempty,uuid4"`
 }
 
 // Validate
 func (opts CreateOpts) Validate() error {
+	if opts.UserData != "" && opts.UserDataBuilder != nil {
+		return fmt.Errorf("UserData and UserDataBuilder are mutually exclusive")
+	}
 	return gcorecloud.ValidateStruct(opts)
 }
 
@@ -195,6 +208,11 @@ func (opts CreateOpts) ToInstanceCreateMap() (map[string]interface{}, error) {
 	} else {
 		delete(mp, "configuration")
 	}
+	if opts.UserDataBuilder != nil {
+		if mp["user_data"], err = opts.UserDataBuilder.Build(); err != nil {
+			return nil, err
+		}
+	}
 	return mp, nil
 }
 
@@ -671,6 +689,20 @@ func ListAvailableFlavors(client *gcorecloud.ServiceClient, id string, opts flav
 	return
 }
 
+// SelectResizeFlavors ranks the flavors available for the instance to resize into which meet the requirements,
+// see flavors.Rank. The Baremetal requirement is ignored.
+func SelectResizeFlavors(client *gcorecloud.ServiceClient, id string, req flavors.Requirements) ([]flavors.Match, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	includePrices := true
+	available, err := ListAvailableFlavors(client, id, flavors.ListOpts{IncludePrices: &includePrices}).Extract()
+	if err != nil {
+		return nil, err
+	}
+	return flavors.Rank(available, req)
+}
+
 // GetSpiceConsole retrieves a specific spice console based on instance unique ID.
 func GetSpiceConsole(client *gcorecloud.ServiceClient, id string) (r RemoteConsoleResult) {
 	url := getSpiceConsoleURL(client, id)
@@ -725,3 +757,842 @@ func ListInstanceLocation(client *gcorecloud.ServiceClient, opts ListInstanceLoc
 	_, r.Err = client.Get(url, &r.Body, nil)
 	return
 }
+
+// DefaultDetailsConcurrency is the default maximum number of concurrent sub-requests of GetDetails and ListAllDetails.
+const DefaultDetailsConcurrency = 4
+
+// DetailsOpts allows to tune the sub-requests of GetDetails and ListAllDetails.
+type DetailsOpts struct {
+	// Concurrency is the maximum number of concurrent sub-requests, DefaultDetailsConcurrency if not set.
+	Concurrency int
+	// VolumeClient is the service client of the volumes API. It is derived from the instances client if not set.
+	VolumeClient *gcorecloud.ServiceClient
+}
+
+// GetDetails retrieves an instance with its ports, floating IPs, attached volumes, security groups and metadata.
+// The sub-requests are run concurrently.
+func GetDetails(client *gcorecloud.ServiceClient, id string, opts DetailsOpts) (*InstanceDetails, error) {
+	return newDetailsFetcher(client, opts).fetch(id, nil)
+}
+
+// ListAllDetails is a convenience function that returns the details of all instances.
+// The instances are fetched by as many workers as the concurrency limit, their sub-requests share the limit.
+func ListAllDetails(client *gcorecloud.ServiceClient, listOpts ListOptsBuilder, opts DetailsOpts) ([]InstanceDetails, error) {
+	all, err := ListAll(client, listOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	f := newDetailsFetcher(client, opts)
+	result := make([]InstanceDetails, len(all))
+	errs := make([]error, len(all))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < cap(f.slots) && w < len(all); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				details, err := f.fetch(all[i].ID, &all[i])
+				if err != nil {
+					errs[i] = fmt.Errorf("instance %s: %w", all[i].ID, err)
+					continue
+				}
+				result[i] = *details
+			}
+		}()
+	}
+	for i := range all {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// detailsFetcher runs the sub-requests of GetDetails and ListAllDetails within the concurrency limit.
+type detailsFetcher struct {
+	client       *gcorecloud.ServiceClient
+	volumeClient *gcorecloud.ServiceClient
+	slots        chan struct{}
+}
+
+func newDetailsFetcher(client *gcorecloud.ServiceClient, opts DetailsOpts) *detailsFetcher {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultDetailsConcurrency
+	}
+	volumeClient := opts.VolumeClient
+	if volumeClient == nil {
+		volumeClient = volumesClient(client)
+	}
+	return &detailsFetcher{
+		client:       client,
+		volumeClient: volumeClient,
+		slots:        make(chan struct{}, concurrency),
+	}
+}
+
+// volumesClient returns a copy of the instances client pointing to the volumes API of the same project and region.
+func volumesClient(client *gcorecloud.ServiceClient) *gcorecloud.ServiceClient {
+	return client.ForService("volumes")
+}
+
+// run calls the functions concurrently and returns the first error in the order of the functions.
+func (f *detailsFetcher) run(fns ...func() error) error {
+	errs := make([]error, len(fns))
+	var wg sync.WaitGroup
+	for i, fn := range fns {
+		wg.Add(1)
+		go func(i int, fn func() error) {
+			defer wg.Done()
+			f.slots <- struct{}{}
+			defer func() { <-f.slots }()
+			errs[i] = fn()
+		}(i, fn)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fetch retrieves the details of an instance. The instance itself is only requested if it is nil.
+func (f *detailsFetcher) fetch(id string, instance *Instance) (*InstanceDetails, error) {
+	details := InstanceDetails{}
+	var interfaces []Interface
+	var ports []InstancePorts
+
+	fns := []func() error{
+		func() (err error) {
+			interfaces, err = ListInterfacesAll(f.client, id)
+			return
+		},
+		func() (err error) {
+			ports, err = ListPortsAll(f.client, id)
+			return
+		},
+		func() (err error) {
+			details.SecurityGroupsDetailed, err = ListSecurityGroupsAll(f.client, id)
+			return
+		},
+		func() (err error) {
+			details.MetadataDetailed, err = MetadataListAll(f.client, id)
+			return
+		},
+	}
+	if instance == nil {
+		fns = append(fns, func() (err error) {
+			instance, err = Get(f.client, id).Extract()
+			return
+		})
+	}
+	if err := f.run(fns...); err != nil {
+		return nil, err
+	}
+	details.Instance = *instance
+
+	details.AttachedVolumes = make([]VolumeDetails, len(instance.Volumes))
+	fns = make([]func() error, len(instance.Volumes))
+	for i, v := range instance.Volumes {
+		i, v := i, v
+		fns[i] = func() error {
+			volume, err := volumes.Get(f.volumeClient, v.ID).Extract()
+			if err != nil {
+				return fmt.Errorf("volume %s: %w", v.ID, err)
+			}
+			details.AttachedVolumes[i] = newVolumeDetails(id, v, volume)
+			return nil
+		}
+	}
+	if err := f.run(fns...); err != nil {
+		return nil, err
+	}
+
+	details.Ports, details.FloatingIPs = joinPorts(interfaces, ports)
+	return &details, nil
+}
+
+// joinPorts merges the instance interfaces with the names and the security groups of the instance ports.
+func joinPorts(interfaces []Interface, ports []InstancePorts) ([]PortDetails, []FloatingIP) {
+	byID := make(map[string]InstancePorts, len(ports))
+	for _, p := range ports {
+		byID[p.ID] = p
+	}
+	seen := make(map[string]bool, len(ports))
+	result := make([]PortDetails, 0, len(interfaces))
+	fips := make([]FloatingIP, 0)
+
+	add := func(p PortDetails) {
+		if port, ok := byID[p.ID]; ok {
+			p.Name = port.Name
+			p.SecurityGroups = port.SecurityGroups
+		}
+		seen[p.ID] = true
+		result = append(result, p)
+		fips = append(fips, p.FloatingIPs...)
+	}
+
+	for _, iface := range interfaces {
+		add(PortDetails{
+			ID:                  iface.PortID,
+			NetworkID:           iface.NetworkID,
+			NetworkName:         iface.NetworkDetails.Name,
+			MacAddress:          iface.MacAddress,
+			PortSecurityEnabled: iface.PortSecurityEnabled,
+			IPAssignments:       iface.IPAssignments,
+			FloatingIPs:         iface.FloatingIPDetails,
+		})
+		for _, sub := range iface.SubPorts {
+			add(PortDetails{
+				ID:            sub.PortID,
+				ParentPortID:  iface.PortID,
+				NetworkID:     sub.NetworkID,
+				NetworkName:   sub.NetworkDetails.Name,
+				MacAddress:    sub.MacAddress,
+				IPAssignments: sub.IPAssignments,
+				FloatingIPs:   sub.FloatingIPDetails,
+			})
+		}
+	}
+
+	for _, p := range ports {
+		if !seen[p.ID] {
+			add(PortDetails{ID: p.ID})
+		}
+	}
+	return result, fips
+}
+
+func newVolumeDetails(instanceID string, v InstanceVolume, volume *volumes.Volume) VolumeDetails {
+	details := VolumeDetails{
+		ID:                  v.ID,
+		Name:                volume.Name,
+		Size:                volume.Size,
+		VolumeType:          volume.VolumeType,
+		Status:              volume.Status,
+		Bootable:            volume.Bootable,
+		DeleteOnTermination: v.DeleteOnTermination,
+	}
+	for _, a := range volume.Attachments {
+		if a.ServerID == instanceID {
+			details.Device = a.Device
+			break
+		}
+	}
+	return details
+}
+
+const (
+	// DefaultBulkConcurrency is the default maximum number of instances processed concurrently by bulk actions.
+	DefaultBulkConcurrency = 10
+	// DefaultBulkWaitTimeout is the default time to wait for an instance to reach the target status of an action.
+	DefaultBulkWaitTimeout = 10 * time.Minute
+	// DefaultBulkPollInterval is the default interval between the status checks of an instance.
+	DefaultBulkPollInterval = 5 * time.Second
+	// DefaultBulkRestartGracePeriod is the default time after which a rebooted or power cycled instance seen ACTIVE
+	// is taken as restarted, although it was never seen restarting.
+	DefaultBulkRestartGracePeriod = 30 * time.Second
+)
+
+// Selector selects instances for bulk actions. Empty fields match any instance.
+type Selector struct {
+	// NameRegex is a regular expression matched against the instance name.
+	NameRegex string
+	// Metadata is matched against the instance metadata, all keys should be present with the same values.
+	Metadata map[string]string
+	FlavorID string
+	// Status is matched against the instance status, case insensitively.
+	Status string
+	// IncludeBaremetal selects the baremetal servers too.
+	IncludeBaremetal bool
+}
+
+// Validate checks the name regular expression.
+func (s Selector) Validate() error {
+	if _, err := regexp.Compile(s.NameRegex); err != nil {
+		return fmt.Errorf("invalid name regex: %w", err)
+	}
+	return nil
+}
+
+// ToListOpts returns the list options for the fields filtered by the API.
+func (s Selector) ToListOpts() ListOpts {
+	return ListOpts{
+		FlavorID:         s.FlavorID,
+		Metadata:         s.Metadata,
+		IncludeBaremetal: s.IncludeBaremetal,
+	}
+}
+
+// matcher returns a function reporting whether an instance matches the selector.
+func (s Selector) matcher() (func(Instance) bool, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	re := regexp.MustCompile(s.NameRegex)
+	return func(instance Instance) bool {
+		if !re.MatchString(instance.Name) {
+			return false
+		}
+		if s.FlavorID != "" && instance.Flavor.FlavorID != s.FlavorID {
+			return false
+		}
+		if s.Status != "" && !strings.EqualFold(instance.Status, s.Status) {
+			return false
+		}
+		for k, v := range s.Metadata {
+			value, ok := instance.Metadata[k]
+			if !ok || fmt.Sprint(value) != v {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// Select returns all instances matching the selector.
+func Select(client *gcorecloud.ServiceClient, selector Selector) ([]Instance, error) {
+	match, err := selector.matcher()
+	if err != nil {
+		return nil, err
+	}
+	all, err := ListAll(client, selector.ToListOpts())
+	if err != nil {
+		return nil, err
+	}
+	selected := make([]Instance, 0, len(all))
+	for _, instance := range all {
+		if match(instance) {
+			selected = append(selected, instance)
+		}
+	}
+	return selected, nil
+}
+
+// BulkOpts specifies the parameters of a bulk action.
+type BulkOpts struct {
+	Action types.PowerAction `validate:"required,enum"`
+	// Concurrency is the maximum number of instances processed concurrently, DefaultBulkConcurrency if not set.
+	Concurrency int `validate:"omitempty,gt=0"`
+	// Wait makes the action wait for the instances to reach the target status.
+	Wait bool
+	// WaitTimeout is DefaultBulkWaitTimeout if not set.
+	WaitTimeout time.Duration `validate:"omitempty,gt=0"`
+	// PollInterval is DefaultBulkPollInterval if not set.
+	PollInterval time.Duration `validate:"omitempty,gt=0"`
+	// RestartGracePeriod is the time after which a rebooted or power cycled instance seen ACTIVE is taken as
+	// restarted, as a quick restart may happen between two polls. DefaultBulkRestartGracePeriod if not set.
+	RestartGracePeriod time.Duration `validate:"omitempty,gt=0"`
+	// DryRun reports the instances the action would apply to without running it.
+	DryRun bool
+}
+
+// Validate checks the bulk options.
+func (opts BulkOpts) Validate() error {
+	return gcorecloud.ValidateStruct(opts)
+}
+
+// powerActionTargets are the status and the vm state instances reach after a power action. Instances rebooted or
+// power cycled are ACTIVE before and after the action, their wait also sees them restarting.
+var powerActionTargets = map[types.PowerAction][2]string{
+	types.StartPowerAction:      {"ACTIVE", "active"},
+	types.StopPowerAction:       {"SHUTOFF", "stopped"},
+	types.PowerCyclePowerAction: {"ACTIVE", "active"},
+	types.RebootPowerAction:     {"ACTIVE", "active"},
+	types.SuspendPowerAction:    {"SUSPENDED", "suspended"},
+	types.ResumePowerAction:     {"ACTIVE", "active"},
+}
+
+// powerActionFunc returns the request function of a power action.
+func powerActionFunc(action types.PowerAction) (func(client *gcorecloud.ServiceClient, id string) UpdateResult, error) {
+	switch action {
+	case types.StartPowerAction:
+		return Start, nil
+	case types.StopPowerAction:
+		return Stop, nil
+	case types.PowerCyclePowerAction:
+		return PowerCycle, nil
+	case types.RebootPowerAction:
+		return Reboot, nil
+	case types.SuspendPowerAction:
+		return Suspend, nil
+	case types.ResumePowerAction:
+		return Resume, nil
+	}
+	return nil, action.IsValid()
+}
+
+// Bulk runs a power action on all instances matching the selector.
+func Bulk(client *gcorecloud.ServiceClient, selector Selector, opts BulkOpts) ([]BulkResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	selected, err := Select(client, selector)
+	if err != nil {
+		return nil, err
+	}
+	return BulkInstances(client, selected, opts)
+}
+
+// BulkInstances runs a power action on the instances with bounded concurrency and reports the outcome per instance.
+// The action is skipped for instances already in its target status, unless it is a reboot or a power cycle.
+// An error is only returned for invalid options, the failures of the instances are reported in the results.
+func BulkInstances(client *gcorecloud.ServiceClient, instances []Instance, opts BulkOpts) ([]BulkResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	action, err := powerActionFunc(opts.Action)
+	if err != nil {
+		return nil, err
+	}
+	concurrency := opts.Concurrency
+	if concurrency == 0 {
+		concurrency = DefaultBulkConcurrency
+	}
+	timeout := opts.WaitTimeout
+	if timeout == 0 {
+		timeout = DefaultBulkWaitTimeout
+	}
+	interval := opts.PollInterval
+	if interval == 0 {
+		interval = DefaultBulkPollInterval
+	}
+	waitOpts := gcorecloud.WaitOpts{Timeout: timeout, Interval: interval, MaxInterval: interval}
+	grace := opts.RestartGracePeriod
+	if grace == 0 {
+		grace = DefaultBulkRestartGracePeriod
+	}
+	target := powerActionTargets[opts.Action]
+	restart := opts.Action == types.RebootPowerAction || opts.Action == types.PowerCyclePowerAction
+
+	results := make([]BulkResult, len(instances))
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, instance := range instances {
+		results[i] = BulkResult{
+			ID:      instance.ID,
+			Name:    instance.Name,
+			Action:  opts.Action,
+			Status:  instance.Status,
+			VMState: instance.VMState,
+		}
+		switch {
+		case !restart && instance.Status == target[0]:
+			results[i].Outcome = BulkOutcomeSkipped
+			continue
+		case opts.DryRun:
+			results[i].Outcome = BulkOutcomeDryRun
+			continue
+		}
+
+		wg.Add(1)
+		go func(result *BulkResult) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			start := time.Now()
+			defer func() { result.Duration = time.Since(start).Round(time.Millisecond).String() }()
+
+			updated, err := action(client, result.ID).Extract()
+			switch {
+			case err != nil || !opts.Wait:
+			case restart:
+				updated, err = waitForRestart(client, result.ID, restarting(updated), grace, waitOpts)
+			default:
+				updated, err = WaitForStatus(client, result.ID, target[0], target[1], waitOpts)
+			}
+			if updated != nil && updated.ID != "" {
+				result.Status, result.VMState = updated.Status, updated.VMState
+			}
+			if err != nil {
+				result.Outcome = BulkOutcomeFailed
+				result.Error = err.Error()
+				return
+			}
+			result.Outcome = BulkOutcomeSucceeded
+		}(&results[i])
+	}
+	wg.Wait()
+	return results, nil
+}
+
+// restarting reports whether the instance is being rebooted or power cycled: it is not ACTIVE, or has a task.
+func restarting(instance *Instance) bool {
+	if instance == nil || instance.ID == "" {
+		return false
+	}
+	return instance.Status != "ACTIVE" || instance.VMState != "active" || (instance.TaskState != nil && *instance.TaskState != "")
+}
+
+// waitForRestart polls the instance until it is ACTIVE again after a reboot or a power cycle. As the instance is
+// ACTIVE before the action, it has to be seen restarting first, unless started tells it already was, e.g. in the
+// response of the action. A restart too quick to be seen is accepted once the grace period is over.
+func waitForRestart(client *gcorecloud.ServiceClient, id string, started bool, grace time.Duration, opts gcorecloud.WaitOpts) (*Instance, error) {
+	deadline := time.Now().Add(grace)
+	var instance *Instance
+	err := client.WaitForResource(id, opts, func(client *gcorecloud.ServiceClient) (bool, error) {
+		current, err := Get(client, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		instance = current
+		if instance.Status == "ERROR" || instance.VMState == "error" {
+			return false, gcorecloud.ErrResourceErrorState
+		}
+		if restarting(instance) {
+			started = true
+			return false, nil
+		}
+		return started || !time.Now().Before(deadline), nil
+	})
+	if err != nil {
+		if instance != nil {
+			return instance, fmt.Errorf("waiting for instance %s to restart, current status %s: %w", id, instance.Status, err)
+		}
+		return nil, fmt.Errorf("waiting for instance %s to restart: %w", id, err)
+	}
+	return instance, nil
+}
+
+// WaitForStatus polls the instance until it reaches the status and, if set, the vm state. It fails fast when the
+// instance enters the ERROR status. The wait is cancelled with the context of the client.
+func WaitForStatus(client *gcorecloud.ServiceClient, id string, status string, vmState string, opts gcorecloud.WaitOpts) (*Instance, error) {
+	var instance *Instance
+	err := client.WaitForResource(id, opts, func(client *gcorecloud.ServiceClient) (bool, error) {
+		current, err := Get(client, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		instance = current
+		if instance.Status == status && (vmState == "" || instance.VMState == vmState) {
+			return true, nil
+		}
+		if instance.Status == "ERROR" || instance.VMState == "error" {
+			return false, gcorecloud.ErrResourceErrorState
+		}
+		return false, nil
+	})
+	if err != nil {
+		if instance != nil {
+			return instance, fmt.Errorf("waiting for instance %s status %s, current status %s: %w", id, status, instance.Status, err)
+		}
+		return nil, fmt.Errorf("waiting for instance %s status %s: %w", id, status, err)
+	}
+	return instance, nil
+}
+
+// Metadata keys SnapshotAll sets on the snapshots of a group.
+const (
+	SnapshotGroupMetadataKey     = "instance_snapshot_group"
+	SnapshotInstanceMetadataKey  = "instance_id"
+	SnapshotDeviceMetadataKey    = "instance_device"
+	SnapshotBootIndexMetadataKey = "instance_boot_index"
+)
+
+// attachedVolume is a volume attached to an instance with its device.
+type attachedVolume struct {
+	volume volumes.Volume
+	device string
+	// boot is set for the boot volume of the instance.
+	boot bool
+}
+
+// deviceLess orders devices the way they are named, e.g. /dev/vdz before /dev/vdaa. Unknown devices are last.
+func deviceLess(a, b string) bool {
+	switch {
+	case a == "" || b == "":
+		return a != "" && b == ""
+	case len(a) != len(b):
+		return len(a) < len(b)
+	}
+	return a < b
+}
+
+// listAttachedVolumes returns the volumes attached to the instance in boot order: the boot volume first, then the data
+// volumes in device order. The boot volume is the bootable one, the first listed by the instance if several are.
+func listAttachedVolumes(volumeClient *gcorecloud.ServiceClient, instance *Instance) ([]attachedVolume, error) {
+	var boot []attachedVolume
+	data := make([]attachedVolume, 0, len(instance.Volumes))
+	for _, v := range instance.Volumes {
+		volume, err := volumes.Get(volumeClient, v.ID).Extract()
+		if err != nil {
+			return nil, fmt.Errorf("volume %s: %w", v.ID, err)
+		}
+		attached := attachedVolume{volume: *volume, device: newVolumeDetails(instance.ID, v, volume).Device}
+		if volume.Bootable && len(boot) == 0 {
+			attached.boot = true
+			boot = append(boot, attached)
+			continue
+		}
+		data = append(data, attached)
+	}
+	sort.SliceStable(data, func(i, j int) bool { return deviceLess(data[i].device, data[j].device) })
+	return append(boot, data...), nil
+}
+
+// bootIndex returns the boot index SnapshotAll recorded in the snapshot metadata, -1 if it is missing.
+func bootIndex(s snapshots.Snapshot) int {
+	index, err := strconv.Atoi(s.Metadata[SnapshotBootIndexMetadataKey])
+	if err != nil {
+		return -1
+	}
+	return index
+}
+
+// listSnapshotGroup returns the snapshots of the group of the instance in boot order along with all the snapshots.
+func listSnapshotGroup(snapshotClient *gcorecloud.ServiceClient, id string, tag string) ([]snapshots.Snapshot, []snapshots.Snapshot, error) {
+	all, err := snapshots.ListAll(snapshotClient, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var group []snapshots.Snapshot
+	for _, s := range all {
+		if s.Metadata[SnapshotGroupMetadataKey] == tag && s.Metadata[SnapshotInstanceMetadataKey] == id {
+			group = append(group, s)
+		}
+	}
+	sort.SliceStable(group, func(i, j int) bool { return bootIndex(group[i]) < bootIndex(group[j]) })
+	return group, all, nil
+}
+
+// latestSnapshotID returns the ID of the latest snapshot of the volume. Snapshots created at the same time are
+// ordered as listed.
+func latestSnapshotID(all []snapshots.Snapshot, volumeID string) string {
+	var latest *snapshots.Snapshot
+	for i, s := range all {
+		if s.VolumeID == volumeID && (latest == nil || !s.CreatedAt.Before(latest.CreatedAt.Time)) {
+			latest = &all[i]
+		}
+	}
+	if latest == nil {
+		return ""
+	}
+	return latest.ID
+}
+
+// waitTaskResult waits for the task of the result and returns it.
+func waitTaskResult(taskClient *gcorecloud.ServiceClient, result tasks.Result, opts gcorecloud.WaitOpts) (*tasks.Task, error) {
+	results, err := result.Extract()
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Tasks) == 0 {
+		return nil, fmt.Errorf("no task returned")
+	}
+	return tasks.WaitForTask(taskClient, string(results.Tasks[0]), opts)
+}
+
+// SnapshotAll snapshots every volume attached to the instance. The snapshots share the group tag and record their
+// device and boot index in their metadata, see RestoreFromSnapshots. All the snapshots are requested before waiting
+// for any of them, which keeps them as close as possible to the same point in time.
+func SnapshotAll(client *gcorecloud.ServiceClient, id string, tag string, opts gcorecloud.WaitOpts) ([]snapshots.Snapshot, error) {
+	if tag == "" {
+		return nil, fmt.Errorf("snapshot group tag is required")
+	}
+	snapshotClient := client.ForService("snapshots")
+	existing, _, err := listSnapshotGroup(snapshotClient, id, tag)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, fmt.Errorf("snapshot group %s of instance %s already exists", tag, id)
+	}
+	instance, err := Get(client, id).Extract()
+	if err != nil {
+		return nil, err
+	}
+	attached, err := listAttachedVolumes(volumesClient(client), instance)
+	if err != nil {
+		return nil, err
+	}
+
+	taskIDs := make([]tasks.TaskID, len(attached))
+	for i, v := range attached {
+		// The boot index 0 is the boot volume, the data volumes follow it even when the instance has none.
+		index := i
+		if !attached[0].boot {
+			index++
+		}
+		results, err := snapshots.Create(snapshotClient, snapshots.CreateOpts{
+			VolumeID:    v.volume.ID,
+			Name:        fmt.Sprintf("%s-%s-%d", instance.Name, tag, index),
+			Description: fmt.Sprintf("volume %s of instance %s", v.device, instance.Name),
+			Metadata: map[string]string{
+				SnapshotGroupMetadataKey:     tag,
+				SnapshotInstanceMetadataKey:  id,
+				SnapshotDeviceMetadataKey:    v.device,
+				SnapshotBootIndexMetadataKey: strconv.Itoa(index),
+			},
+		}).Extract()
+		if err != nil {
+			return nil, fmt.Errorf("snapshotting volume %s: %w", v.volume.ID, err)
+		}
+		if len(results.Tasks) == 0 {
+			return nil, fmt.Errorf("snapshotting volume %s: no task returned", v.volume.ID)
+		}
+		taskIDs[i] = results.Tasks[0]
+	}
+
+	taskClient := client.ForService("tasks")
+	result := make([]snapshots.Snapshot, len(taskIDs))
+	for i, taskID := range taskIDs {
+		task, err := tasks.WaitForTask(taskClient, string(taskID), opts)
+		if err != nil {
+			return nil, fmt.Errorf("snapshotting volume %s: %w", attached[i].volume.ID, err)
+		}
+		snapshotID, err := snapshots.ExtractSnapshotIDFromTask(task)
+		if err != nil {
+			return nil, err
+		}
+		snapshot, err := snapshots.Get(snapshotClient, snapshotID).Extract()
+		if err != nil {
+			return nil, err
+		}
+		result[i] = *snapshot
+	}
+	return result, nil
+}
+
+// RestoreFromSnapshots restores the volumes of the instance from a snapshot group created by SnapshotAll. The instance
+// is stopped and its data volumes are detached. A volume whose latest snapshot is the group snapshot is reverted, any
+// other volume is recreated from the group snapshot and the original volume is left detached. The volumes of the group
+// are attached back in boot order, followed by the other detached volumes, and the instance is started.
+// The boot volume can only be reverted, the restore fails before any change otherwise.
+func RestoreFromSnapshots(client *gcorecloud.ServiceClient, id string, tag string, opts gcorecloud.WaitOpts) ([]VolumeRestore, error) {
+	volumeClient := volumesClient(client)
+	snapshotClient := client.ForService("snapshots")
+	taskClient := client.ForService("tasks")
+
+	group, all, err := listSnapshotGroup(snapshotClient, id, tag)
+	if err != nil {
+		return nil, err
+	}
+	if len(group) == 0 {
+		return nil, gcorecloud.ErrResourceNotFound{Name: tag, ResourceType: "snapshot group"}
+	}
+	instance, err := Get(client, id).Extract()
+	if err != nil {
+		return nil, err
+	}
+	attached, err := listAttachedVolumes(volumeClient, instance)
+	if err != nil {
+		return nil, err
+	}
+
+	restores := make([]VolumeRestore, len(group))
+	for i, s := range group {
+		restores[i] = VolumeRestore{
+			VolumeID:   s.VolumeID,
+			SnapshotID: s.ID,
+			Device:     s.Metadata[SnapshotDeviceMetadataKey],
+			BootIndex:  bootIndex(s),
+			Action:     RestoreActionRecreate,
+		}
+		if latestSnapshotID(all, s.VolumeID) == s.ID {
+			restores[i].Action = RestoreActionRevert
+		}
+		if restores[i].BootIndex == 0 && restores[i].Action != RestoreActionRevert {
+			return nil, fmt.Errorf("boot volume %s can't be replaced, snapshot %s is not its latest one", s.VolumeID, s.ID)
+		}
+	}
+
+	if instance.Status != "SHUTOFF" {
+		if _, err := Stop(client, id).Extract(); err != nil {
+			return restores, fmt.Errorf("stopping instance %s: %w", id, err)
+		}
+		if _, err := WaitForStatus(client, id, "SHUTOFF", "", opts); err != nil {
+			return restores, err
+		}
+	}
+
+	var detached []string
+	for _, v := range attached {
+		if v.boot {
+			continue
+		}
+		if _, err := volumes.Detach(volumeClient, v.volume.ID, volumes.InstanceOperationOpts{InstanceID: id}).Extract(); err != nil {
+			return restores, fmt.Errorf("detaching volume %s: %w", v.volume.ID, err)
+		}
+		if _, err := volumes.WaitForStatus(volumeClient, v.volume.ID, volumes.Available, opts); err != nil {
+			return restores, err
+		}
+		detached = append(detached, v.volume.ID)
+	}
+
+	// grouped are the volumes of the group, the replaced volumes are left detached.
+	grouped := make(map[string]bool)
+	var attach []string
+	for i := range restores {
+		r := &restores[i]
+		if r.Action == RestoreActionRevert {
+			if _, err := waitTaskResult(taskClient, volumes.Revert(volumeClient, r.VolumeID), opts); err != nil {
+				return restores, fmt.Errorf("reverting volume %s: %w", r.VolumeID, err)
+			}
+		} else {
+			if r.NewVolumeID, err = recreateVolume(volumeClient, taskClient, r.VolumeID, group[i], opts); err != nil {
+				return restores, fmt.Errorf("recreating volume %s: %w", r.VolumeID, err)
+			}
+		}
+		grouped[r.VolumeID] = true
+		if r.BootIndex != 0 {
+			volumeID := r.VolumeID
+			if r.NewVolumeID != "" {
+				volumeID = r.NewVolumeID
+			}
+			attach = append(attach, volumeID)
+		}
+	}
+	for _, volumeID := range detached {
+		if !grouped[volumeID] {
+			attach = append(attach, volumeID)
+		}
+	}
+
+	for _, volumeID := range attach {
+		if _, err := volumes.Attach(volumeClient, volumeID, volumes.InstanceOperationOpts{InstanceID: id}).Extract(); err != nil {
+			return restores, fmt.Errorf("attaching volume %s: %w", volumeID, err)
+		}
+		if _, err := volumes.WaitForStatus(volumeClient, volumeID, volumes.InUse, opts); err != nil {
+			return restores, err
+		}
+	}
+
+	if _, err := Start(client, id).Extract(); err != nil {
+		return restores, fmt.Errorf("starting instance %s: %w", id, err)
+	}
+	if _, err := WaitForStatus(client, id, "ACTIVE", "", opts); err != nil {
+		return restores, err
+	}
+	return restores, nil
+}
+
+// recreateVolume creates a volume from the snapshot with the name and the type of the volume it replaces.
+func recreateVolume(volumeClient, taskClient *gcorecloud.ServiceClient, volumeID string, snapshot snapshots.Snapshot, opts gcorecloud.WaitOpts) (string, error) {
+	volume, err := volumes.Get(volumeClient, volumeID).Extract()
+	if err != nil {
+		return "", err
+	}
+	task, err := waitTaskResult(taskClient, volumes.Create(volumeClient, volumes.CreateOpts{
+		Source:     volumes.Snapshot,
+		Name:       volume.Name,
+		Size:       snapshot.Size,
+		TypeName:   volume.VolumeType,
+		SnapshotID: snapshot.ID,
+	}), opts)
+	if err != nil {
+		return "", err
+	}
+	return volumes.ExtractVolumeIDFromTask(task)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
 	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
 	"github.com/G-Core/gcorelabscloud-go/gcore/utils/metadata"
+	"github.com/G-Core/gcorelabscloud-go/gcore/volume/v1/volumes"
 	"github.com/G-Core/gcorelabscloud-go/pagination"
 )
 
@@ -254,6 +255,86 @@ type InstancePorts struct {
 	SecurityGroups []gcorecloud.ItemIDName `json:"security_groups"`
 }
 
+// InstanceDetails represents an instance with the details of its ports, floating IPs, attached volumes,
+// security groups and metadata.
+type InstanceDetails struct {
+	Instance
+	Ports                  []PortDetails           `json:"ports"`
+	FloatingIPs            []FloatingIP            `json:"floating_ips"`
+	AttachedVolumes        []VolumeDetails         `json:"attached_volumes"`
+	SecurityGroupsDetailed []gcorecloud.ItemIDName `json:"security_groups_detailed"`
+	MetadataDetailed       []metadata.Metadata     `json:"metadata_detailed"`
+}
+
+// PortDetails represents an instance port with its addresses and security groups.
+type PortDetails struct {
+	ID                  string                  `json:"id"`
+	Name                string                  `json:"name"`
+	ParentPortID        string                  `json:"parent_port_id,omitempty"`
+	NetworkID           string                  `json:"network_id"`
+	NetworkName         string                  `json:"network_name"`
+	MacAddress          gcorecloud.MAC          `json:"mac_address"`
+	PortSecurityEnabled bool                    `json:"port_security_enabled"`
+	IPAssignments       []PortIP                `json:"ip_assignments"`
+	FloatingIPs         []FloatingIP            `json:"floating_ips"`
+	SecurityGroups      []gcorecloud.ItemIDName `json:"security_groups"`
+}
+
+// VolumeDetails represents a volume attached to an instance.
+type VolumeDetails struct {
+	ID                  string               `json:"id"`
+	Name                string               `json:"name"`
+	Size                int                  `json:"size"`
+	VolumeType          volumes.VolumeType   `json:"volume_type"`
+	Status              volumes.VolumeStatus `json:"status"`
+	Bootable            bool                 `json:"bootable"`
+	Device              string               `json:"device"`
+	DeleteOnTermination bool                 `json:"delete_on_termination"`
+}
+
+// BulkOutcome is the outcome of a bulk action for an instance.
+type BulkOutcome string
+
+const (
+	BulkOutcomeSucceeded BulkOutcome = "succeeded"
+	BulkOutcomeFailed    BulkOutcome = "failed"
+	BulkOutcomeSkipped   BulkOutcome = "skipped"
+	BulkOutcomeDryRun    BulkOutcome = "dry-run"
+)
+
+// BulkResult represents the outcome of a bulk action for an instance.
+type BulkResult struct {
+	ID       string            `json:"instance_id"`
+	Name     string            `json:"instance_name"`
+	Action   types.PowerAction `json:"action"`
+	Outcome  BulkOutcome       `json:"outcome"`
+	Status   string            `json:"status"`
+	VMState  string            `json:"vm_state"`
+	Duration string            `json:"duration,omitempty"`
+	Error    string            `json:"error,omitempty"`
+}
+
+// RestoreAction is how a volume is restored from its group snapshot.
+type RestoreAction string
+
+const (
+	// RestoreActionRevert reverts the volume in place, its group snapshot is its latest one.
+	RestoreActionRevert RestoreAction = "revert"
+	// RestoreActionRecreate creates a new volume from the group snapshot which replaces the volume.
+	RestoreActionRecreate RestoreAction = "recreate"
+)
+
+// VolumeRestore represents the restore of an instance volume from its group snapshot.
+type VolumeRestore struct {
+	VolumeID   string        `json:"volume_id"`
+	SnapshotID string        `json:"snapshot_id"`
+	Device     string        `json:"device"`
+	BootIndex  int           `json:"boot_index"`
+	Action     RestoreAction `json:"action"`
+	// NewVolumeID is the volume created from the snapshot when the volume is recreated.
+	NewVolumeID string `json:"new_volume_id,omitempty"`
+}
+
 // InstancePage is the page returned by a pager when traversing over a
 // collection of instances.
 type InstancePage struct {
@@ -0,0 +1,214 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
+	th "github.com/G-Core/gcorelabscloud-go/testhelper"
+	fake "github.com/G-Core/gcorelabscloud-go/testhelper/client"
+	"github.com/G-Core/gcorelabscloud-go/testhelper/fakecloud"
+	"github.com/stretchr/testify/require"
+)
+
+func createFakeInstances(t *testing.T, server *fakecloud.Server, flavor string, env string, names ...string) {
+	// Tasks of a fake server without delays are finished when they are returned.
+	_, err := instances.Create(server.ServiceClient("instances", "v1"), instances.CreateOpts{
+		Flavor: flavor,
+		Names:  names,
+		Interfaces: []instances.InterfaceInstanceCreateOpts{{
+			InterfaceOpts: instances.InterfaceOpts{Type: types.ExternalInterfaceType},
+		}},
+		Metadata: &instances.MetadataSetOpts{Metadata: []instances.MetadataOpts{{Key: "env", Value: env}}},
+	}).Extract()
+	require.NoError(t, err)
+}
+
+func bulkOutcomes(results []instances.BulkResult) map[string]instances.BulkOutcome {
+	outcomes := make(map[string]instances.BulkOutcome, len(results))
+	for _, r := range results {
+		outcomes[r.Name] = r.Outcome
+	}
+	return outcomes
+}
+
+func TestSelect(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	createFakeInstances(t, server, "g1-standard-1-2", "dev", "dev-web-1", "dev-web-2", "dev-db-1")
+	createFakeInstances(t, server, "g1-standard-2-4", "prod", "prod-web-1")
+	client := server.ServiceClient("instances", "v1")
+
+	names := func(selector instances.Selector) []string {
+		selected, err := instances.Select(client, selector)
+		require.NoError(t, err)
+		var result []string
+		for _, instance := range selected {
+			result = append(result, instance.Name)
+		}
+		sort.Strings(result)
+		return result
+	}
+
+	require.Equal(t, []string{"dev-web-1", "dev-web-2", "prod-web-1"}, names(instances.Selector{NameRegex: "-web-"}))
+	require.Equal(t, []string{"dev-db-1", "dev-web-1", "dev-web-2"}, names(instances.Selector{Metadata: map[string]string{"env": "dev"}}))
+	require.Equal(t, []string{"prod-web-1"}, names(instances.Selector{FlavorID: "g1-standard-2-4"}))
+	require.Equal(t, []string{"dev-web-1"}, names(instances.Selector{NameRegex: "^dev-web-1$", Status: "active"}))
+	require.Empty(t, names(instances.Selector{Status: "SHUTOFF"}))
+
+	_, err := instances.Select(client, instances.Selector{NameRegex: "("})
+	require.Error(t, err)
+}
+
+func TestBulk(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	createFakeInstances(t, server, "g1-standard-1-2", "dev", "dev-1", "dev-2", "dev-3")
+	createFakeInstances(t, server, "g1-standard-1-2", "prod", "prod-1")
+	client := server.ServiceClient("instances", "v1")
+	selector := instances.Selector{Metadata: map[string]string{"env": "dev"}}
+
+	results, err := instances.Bulk(client, selector, instances.BulkOpts{Action: types.StopPowerAction, DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, map[string]instances.BulkOutcome{
+		"dev-1": instances.BulkOutcomeDryRun,
+		"dev-2": instances.BulkOutcomeDryRun,
+		"dev-3": instances.BulkOutcomeDryRun,
+	}, bulkOutcomes(results))
+	stopped, err := instances.Select(client, instances.Selector{Status: "SHUTOFF"})
+	require.NoError(t, err)
+	require.Empty(t, stopped)
+
+	opts := instances.BulkOpts{
+		Action:       types.StopPowerAction,
+		Concurrency:  2,
+		Wait:         true,
+		PollInterval: 10 * time.Millisecond,
+	}
+	results, err = instances.Bulk(client, selector, opts)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	for _, r := range results {
+		require.Equal(t, instances.BulkOutcomeSucceeded, r.Outcome, r.Error)
+		require.Equal(t, "SHUTOFF", r.Status)
+		require.Equal(t, "stopped", r.VMState)
+		require.NotEmpty(t, r.Duration)
+	}
+
+	prod, err := instances.Select(client, instances.Selector{Metadata: map[string]string{"env": "prod"}})
+	require.NoError(t, err)
+	require.Equal(t, "ACTIVE", prod[0].Status)
+
+	results, err = instances.Bulk(client, instances.Selector{NameRegex: "^(dev-1|prod-1)$"}, opts)
+	require.NoError(t, err)
+	require.Equal(t, map[string]instances.BulkOutcome{
+		"dev-1":  instances.BulkOutcomeSkipped,
+		"prod-1": instances.BulkOutcomeSucceeded,
+	}, bulkOutcomes(results))
+}
+
+func TestBulkFailure(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	createFakeInstances(t, server, "g1-standard-1-2", "dev", "dev-1")
+	client := server.ServiceClient("instances", "v1")
+
+	selected, err := instances.Select(client, instances.Selector{})
+	require.NoError(t, err)
+	selected = append(selected, instances.Instance{ID: "2a1a6b2e-5c7c-4d36-9b0f-3a6f0e5b6c1d", Name: "missing"})
+
+	results, err := instances.BulkInstances(client, selected, instances.BulkOpts{
+		Action:       types.RebootPowerAction,
+		Wait:         true,
+		PollInterval: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]instances.BulkOutcome{
+		"dev-1":   instances.BulkOutcomeSucceeded,
+		"missing": instances.BulkOutcomeFailed,
+	}, bulkOutcomes(results))
+	require.NotEmpty(t, results[1].Error)
+
+	_, err = instances.BulkInstances(client, selected, instances.BulkOpts{Action: "hibernate"})
+	require.Error(t, err)
+}
+
+func TestBulkRebootWait(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	// The instance is still ACTIVE in the reboot response and the first poll, it is then seen rebooting.
+	rebooting := strings.Replace(GetResponse, `"status": "ACTIVE"`, `"status": "REBOOT"`, 1)
+	rebooting = strings.Replace(rebooting, `"task_state": null`, `"task_state": "rebooting"`, 1)
+	responses := []string{GetResponse, rebooting, GetResponse}
+	polls := 0
+
+	th.Mux.HandleFunc(prepareRebootTestURL(instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, GetResponse)
+	})
+	th.Mux.HandleFunc(prepareGetTestURL(instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, responses[polls])
+		if polls < len(responses)-1 {
+			polls++
+		}
+	})
+
+	client := fake.ServiceTokenClient("instances", "v1")
+	results, err := instances.BulkInstances(client, []instances.Instance{Instance1}, instances.BulkOpts{
+		Action:       types.RebootPowerAction,
+		Wait:         true,
+		PollInterval: time.Millisecond,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, instances.BulkOutcomeSucceeded, results[0].Outcome, results[0].Error)
+	require.Equal(t, "ACTIVE", results[0].Status)
+	require.Equal(t, len(responses)-1, polls)
+}
+
+func TestBulkRebootWaitUnobserved(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	// The instance is never seen rebooting: it is taken as restarted after the grace period.
+	polls := 0
+	th.Mux.HandleFunc(prepareRebootTestURL(instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, GetResponse)
+	})
+	th.Mux.HandleFunc(prepareGetTestURL(instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, GetResponse)
+		polls++
+	})
+
+	client := fake.ServiceTokenClient("instances", "v1")
+	start := time.Now()
+	results, err := instances.BulkInstances(client, []instances.Instance{Instance1}, instances.BulkOpts{
+		Action:             types.RebootPowerAction,
+		Wait:               true,
+		PollInterval:       time.Millisecond,
+		RestartGracePeriod: 50 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, instances.BulkOutcomeSucceeded, results[0].Outcome, results[0].Error)
+	require.Equal(t, "ACTIVE", results[0].Status)
+	require.True(t, time.Since(start) >= 50*time.Millisecond)
+	require.Greater(t, polls, 1)
+}
@@ -717,3 +717,48 @@ var (
 	}
 	ExpectedInstancesLocationSlice = []instances.InstanceLocation{InstanceLocation}
 )
+
+const DetailsPortsListResponse = `
+{
+  "count": 1,
+  "results": [
+    {
+      "id": "1f0ca628-a73b-42c0-bdac-7b10d023e097",
+      "name": "eth0",
+      "security_groups": [
+        {
+          "name": "Test",
+          "id": "2bf3a5d7-9072-40aa-8ac0-a64e39427a2c"
+        }
+      ]
+    }
+  ]
+}
+`
+
+const DetailsVolumeResponse = `
+{
+  "availability_zone": "nova",
+  "created_at": "2019-05-29T05:32:41+0000",
+  "volume_type": "ssd_hiiops",
+  "id": "28bfe198-a003-4283-8dca-ab5da4a71b62",
+  "name": "boot",
+  "region": "RegionOne",
+  "status": "in-use",
+  "updated_at": "2019-05-29T05:39:20+0000",
+  "size": 10,
+  "bootable": true,
+  "project_id": 1,
+  "region_id": 1,
+  "attachments": [
+    {
+      "server_id": "a7e7e8d6-0bf7-4ac9-8170-831b47ee2ba9",
+      "attachment_id": "f2ed59d9-8068-400c-be4b-c4501ef6f33c",
+      "instance_name": "Testing",
+      "attached_at": "2019-07-26T14:22:03+0000",
+      "volume_id": "28bfe198-a003-4283-8dca-ab5da4a71b62",
+      "device": "/dev/vda"
+    }
+  ]
+}
+`
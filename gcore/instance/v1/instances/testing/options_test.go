@@ -11,6 +11,7 @@ import (
 	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
 
 	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/utils/userdata"
 
 	"github.com/stretchr/testify/require"
 )
@@ -440,3 +441,29 @@ func TestInterfaceOpts(t *testing.T) {
 	err = opts.Validate()
 	require.NoError(t, err)
 }
+
+func TestCreateOptsUserDataBuilder(t *testing.T) {
+	builder := userdata.New().CloudConfig("hostname: test\n")
+	expected, err := builder.Build()
+	require.NoError(t, err)
+
+	opts := instances.CreateOpts{
+		Flavor: "g1-standard-1-2",
+		Names:  []string{"name"},
+		Interfaces: []instances.InterfaceInstanceCreateOpts{{
+			InterfaceOpts: instances.InterfaceOpts{Type: types.ExternalInterfaceType},
+		}},
+		UserDataBuilder: builder,
+	}
+	mp, err := opts.ToInstanceCreateMap()
+	require.NoError(t, err)
+	require.Equal(t, expected, mp["user_data"])
+
+	opts.UserData = expected
+	require.Error(t, opts.Validate())
+
+	opts.UserData = ""
+	opts.UserDataBuilder = userdata.New().CloudConfig("hostname: [test\n")
+	_, err = opts.ToInstanceCreateMap()
+	require.Error(t, err)
+}
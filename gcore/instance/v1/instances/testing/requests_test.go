@@ -1,9 +1,13 @@
 package testing
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/gcore/flavor/v1/flavors"
@@ -954,3 +958,212 @@ func TestListInstanceLocation(t *testing.T) {
 	require.Equal(t, InstanceLocation, ct)
 	require.Equal(t, ExpectedInstancesLocationSlice, actual)
 }
+
+// handleDetails registers the handlers of the GetDetails sub-requests and returns the maximum number of
+// concurrent requests seen by the server.
+func handleDetails(t *testing.T) func() int {
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+
+	handle := func(url, response string) {
+		th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "Authorization", fmt.Sprintf("Bearer %s", fake.AccessToken))
+
+			mu.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mu.Unlock()
+			time.Sleep(10 * time.Millisecond)
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, err := fmt.Fprint(w, response)
+			if err != nil {
+				log.Error(err)
+			}
+		})
+	}
+
+	handle(prepareListTestURL(), ListResponse)
+	handle(prepareGetTestURL(Instance1.ID), GetResponse)
+	handle(prepareListInterfacesTestURL(Instance1.ID), InterfacesResponse)
+	handle(prepareListPortsTestURL(Instance1.ID), DetailsPortsListResponse)
+	handle(prepareListSecurityGroupsTestURL(Instance1.ID), SecurityGroupsListResponse)
+	handle(prepareMetadataTestURL(Instance1.ID), MetadataListResponse)
+	handle(fmt.Sprintf("/v1/volumes/%d/%d/%s", fake.ProjectID, fake.RegionID, Instance1.Volumes[0].ID), DetailsVolumeResponse)
+
+	return func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return maxInFlight
+	}
+}
+
+func requireInstanceDetails(t *testing.T, details instances.InstanceDetails) {
+	require.Equal(t, Instance1, details.Instance)
+
+	require.Len(t, details.Ports, 1)
+	port := details.Ports[0]
+	require.Equal(t, PortID, port.ID)
+	require.Equal(t, "eth0", port.Name)
+	require.Equal(t, "test network", port.NetworkName)
+	require.Equal(t, ExpectedSecurityGroupsSlice, port.SecurityGroups)
+	require.Len(t, port.IPAssignments, 2)
+	require.Len(t, details.FloatingIPs, 2)
+	require.Equal(t, port.FloatingIPs, details.FloatingIPs)
+
+	require.Equal(t, []instances.VolumeDetails{{
+		ID:         "28bfe198-a003-4283-8dca-ab5da4a71b62",
+		Name:       "boot",
+		Size:       10,
+		VolumeType: volumes.SsdHiIops,
+		Status:     "in-use",
+		Bootable:   true,
+		Device:     "/dev/vda",
+	}}, details.AttachedVolumes)
+
+	require.Equal(t, ExpectedSecurityGroupsSlice, details.SecurityGroupsDetailed)
+	require.Len(t, details.MetadataDetailed, 2)
+}
+
+func TestGetDetails(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	maxInFlight := handleDetails(t)
+
+	client := fake.ServiceTokenClient("instances", "v1")
+	details, err := instances.GetDetails(client, Instance1.ID, instances.DetailsOpts{Concurrency: 2})
+
+	require.NoError(t, err)
+	requireInstanceDetails(t, *details)
+	require.LessOrEqual(t, maxInFlight(), 2)
+}
+
+func TestListAllDetails(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	maxInFlight := handleDetails(t)
+
+	client := fake.ServiceTokenClient("instances", "v1")
+	details, err := instances.ListAllDetails(client, instances.ListOpts{}, instances.DetailsOpts{Concurrency: 1})
+
+	require.NoError(t, err)
+	require.Len(t, details, 1)
+	requireInstanceDetails(t, details[0])
+	require.Equal(t, 1, maxInFlight())
+}
+
+func TestGetDetailsError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleDetails(t)
+	th.Mux.HandleFunc(fmt.Sprintf("/v1/volumes/%d/%d/", fake.ProjectID, fake.RegionID), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	client := fake.ServiceTokenClient("instances", "v1")
+	volumeClient := fake.ServiceTokenClient("volumes", "v1")
+	volumeClient.ResourceBase += "missing/"
+	_, err := instances.GetDetails(client, Instance1.ID, instances.DetailsOpts{VolumeClient: volumeClient})
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), Instance1.Volumes[0].ID)
+}
+
+func handleInstanceStatuses(t *testing.T, statuses ...[2]string) *int {
+	calls := 0
+	th.Mux.HandleFunc(prepareGetTestURL(Instance1.ID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Authorization", fmt.Sprintf("Bearer %s", fake.AccessToken))
+
+		status := statuses[len(statuses)-1]
+		if calls < len(statuses) {
+			status = statuses[calls]
+		}
+		calls++
+		response := strings.Replace(GetResponse, `"status": "ACTIVE"`, fmt.Sprintf(`"status": %q`, status[0]), 1)
+		response = strings.Replace(response, `"vm_state": "active"`, fmt.Sprintf(`"vm_state": %q`, status[1]), 1)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprint(w, response)
+		if err != nil {
+			log.Error(err)
+		}
+	})
+	return &calls
+}
+
+func TestWaitForStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := handleInstanceStatuses(t, [2]string{"ACTIVE", "active"}, [2]string{"ACTIVE", "stopping"}, [2]string{"SHUTOFF", "stopped"})
+
+	client := fake.ServiceTokenClient("instances", "v1")
+	instance, err := instances.WaitForStatus(client, Instance1.ID, "SHUTOFF", "stopped", gcorecloud.WaitOpts{Interval: time.Millisecond})
+
+	require.NoError(t, err)
+	require.Equal(t, "SHUTOFF", instance.Status)
+	require.Equal(t, 3, *calls)
+}
+
+func TestWaitForStatusErrorState(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := handleInstanceStatuses(t, [2]string{"ACTIVE", "active"}, [2]string{"ERROR", "error"}, [2]string{"ACTIVE", "active"})
+
+	client := fake.ServiceTokenClient("instances", "v1")
+	instance, err := instances.WaitForStatus(client, Instance1.ID, "SHUTOFF", "", gcorecloud.WaitOpts{Interval: time.Millisecond})
+
+	require.Error(t, err)
+	require.True(t, errors.Is(err, gcorecloud.ErrResourceErrorState))
+	require.Equal(t, "ERROR", instance.Status)
+	require.Equal(t, 2, *calls)
+}
+
+func TestWaitForStatusTimeout(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleInstanceStatuses(t, [2]string{"ACTIVE", "active"})
+
+	client := fake.ServiceTokenClient("instances", "v1")
+	opts := gcorecloud.WaitOpts{Timeout: 30 * time.Millisecond, Interval: time.Millisecond}
+	_, err := instances.WaitForStatus(client, Instance1.ID, "SHUTOFF", "stopped", opts)
+
+	require.Error(t, err)
+	require.True(t, errors.Is(err, gcorecloud.ErrWaitTimeout))
+	require.Contains(t, err.Error(), "current status ACTIVE")
+}
+
+func TestSelectResizeFlavors(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(prepareListAvailableFlavorsTestURL(instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		require.Equal(t, "true", r.URL.Query().Get("include_prices"))
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprint(w, ListAvailableFlavorsResponse)
+		if err != nil {
+			log.Error(err)
+		}
+	})
+
+	client := fake.ServiceTokenClient("instances", "v1")
+	matches, err := instances.SelectResizeFlavors(client, instanceID, flavors.Requirements{MinVCPUs: 1, MinRAM: 2048})
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Equal(t, AvailableFlavor, matches[0].Flavor)
+
+	matches, err = instances.SelectResizeFlavors(client, instanceID, flavors.Requirements{MinVCPUs: 2})
+	require.NoError(t, err)
+	require.Empty(t, matches)
+}
@@ -0,0 +1,174 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/snapshot/v1/snapshots"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/volume/v1/volumes"
+	"github.com/G-Core/gcorelabscloud-go/testhelper/fakecloud"
+	"github.com/stretchr/testify/require"
+)
+
+var snapshotWaitOpts = gcorecloud.WaitOpts{Interval: time.Millisecond}
+
+// createFakeMultiDiskInstance creates an instance with a boot volume and two data volumes and returns it.
+func createFakeMultiDiskInstance(t *testing.T, server *fakecloud.Server) *instances.Instance {
+	client := server.ServiceClient("instances", "v1")
+	_, err := instances.Create(client, instances.CreateOpts{
+		Flavor: "g1-standard-1-2",
+		Names:  []string{"db-1"},
+		Interfaces: []instances.InterfaceInstanceCreateOpts{{
+			InterfaceOpts: instances.InterfaceOpts{Type: types.ExternalInterfaceType},
+		}},
+		Volumes: []instances.CreateVolumeOpts{
+			{Source: types.Image, ImageID: "1b4ab5cf-7e3c-4f3f-9b0e-6a2d0c5e8f11", Size: 10, Name: "db-1-boot"},
+			{Source: types.NewVolume, Size: 20, Name: "db-1-data", BootIndex: 1},
+			{Source: types.NewVolume, Size: 30, Name: "db-1-logs", BootIndex: 2},
+		},
+	}).Extract()
+	require.NoError(t, err)
+	created, err := instances.ListAll(client, nil)
+	require.NoError(t, err)
+	require.Len(t, created, 1)
+	require.Len(t, created[0].Volumes, 3)
+	return &created[0]
+}
+
+func instanceVolumeIDs(t *testing.T, client *gcorecloud.ServiceClient, id string) []string {
+	instance, err := instances.Get(client, id).Extract()
+	require.NoError(t, err)
+	var result []string
+	for _, v := range instance.Volumes {
+		result = append(result, v.ID)
+	}
+	return result
+}
+
+func createSnapshot(t *testing.T, server *fakecloud.Server, volumeID string) {
+	results, err := snapshots.Create(server.ServiceClient("snapshots", "v1"), snapshots.CreateOpts{
+		VolumeID: volumeID,
+		Name:     "manual",
+	}).Extract()
+	require.NoError(t, err)
+	_, err = tasks.WaitForTask(server.ServiceClient("tasks", "v1"), string(results.Tasks[0]), snapshotWaitOpts)
+	require.NoError(t, err)
+}
+
+func TestSnapshotAllAndRestore(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	instance := createFakeMultiDiskInstance(t, server)
+	client := server.ServiceClient("instances", "v1")
+	original := instanceVolumeIDs(t, client, instance.ID)
+
+	group, err := instances.SnapshotAll(client, instance.ID, "nightly", snapshotWaitOpts)
+	require.NoError(t, err)
+	require.Len(t, group, 3)
+	for i, s := range group {
+		require.Equal(t, original[i], s.VolumeID)
+		require.Equal(t, "nightly", s.Metadata[instances.SnapshotGroupMetadataKey])
+		require.Equal(t, instance.ID, s.Metadata[instances.SnapshotInstanceMetadataKey])
+	}
+	require.Equal(t, "/dev/vda", group[0].Metadata[instances.SnapshotDeviceMetadataKey])
+	require.Equal(t, "2", group[2].Metadata[instances.SnapshotBootIndexMetadataKey])
+
+	_, err = instances.SnapshotAll(client, instance.ID, "nightly", snapshotWaitOpts)
+	require.Error(t, err)
+
+	// The logs volume has a newer snapshot, it can't be reverted to the group one.
+	createSnapshot(t, server, original[2])
+
+	restores, err := instances.RestoreFromSnapshots(client, instance.ID, "nightly", snapshotWaitOpts)
+	require.NoError(t, err)
+	require.Len(t, restores, 3)
+	require.Equal(t, instances.RestoreActionRevert, restores[0].Action)
+	require.Equal(t, instances.RestoreActionRevert, restores[1].Action)
+	require.Equal(t, instances.RestoreActionRecreate, restores[2].Action)
+	require.Equal(t, group[2].ID, restores[2].SnapshotID)
+	require.NotEmpty(t, restores[2].NewVolumeID)
+
+	require.Equal(t, []string{original[0], original[1], restores[2].NewVolumeID}, instanceVolumeIDs(t, client, instance.ID))
+	restored, err := instances.Get(client, instance.ID).Extract()
+	require.NoError(t, err)
+	require.Equal(t, "ACTIVE", restored.Status)
+
+	volumeClient := server.ServiceClient("volumes", "v1")
+	replaced, err := volumes.Get(volumeClient, original[2]).Extract()
+	require.NoError(t, err)
+	require.Equal(t, volumes.Available, replaced.Status)
+	recreated, err := volumes.Get(volumeClient, restores[2].NewVolumeID).Extract()
+	require.NoError(t, err)
+	require.Equal(t, group[2].ID, recreated.SnapshotID)
+	require.Equal(t, "db-1-logs", recreated.Name)
+	require.Equal(t, "/dev/vdc", recreated.Attachments[0].Device)
+
+	_, err = instances.RestoreFromSnapshots(client, instance.ID, "weekly", snapshotWaitOpts)
+	require.Error(t, err)
+	require.IsType(t, gcorecloud.ErrResourceNotFound{}, err)
+}
+
+func TestRestoreFromSnapshotsBootVolumeNotLatest(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	instance := createFakeMultiDiskInstance(t, server)
+	client := server.ServiceClient("instances", "v1")
+	original := instanceVolumeIDs(t, client, instance.ID)
+
+	_, err := instances.SnapshotAll(client, instance.ID, "nightly", snapshotWaitOpts)
+	require.NoError(t, err)
+	createSnapshot(t, server, original[0])
+
+	_, err = instances.RestoreFromSnapshots(client, instance.ID, "nightly", snapshotWaitOpts)
+	require.Error(t, err)
+
+	// Nothing is changed when the restore is refused.
+	current, err := instances.Get(client, instance.ID).Extract()
+	require.NoError(t, err)
+	require.Equal(t, "ACTIVE", current.Status)
+	require.Equal(t, original, instanceVolumeIDs(t, client, instance.ID))
+}
+
+func TestSnapshotAllBootVolumeNotFirstDevice(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	client := server.ServiceClient("instances", "v1")
+	// The data volume is attached first, the boot volume has the second device.
+	_, err := instances.Create(client, instances.CreateOpts{
+		Flavor: "g1-standard-1-2",
+		Names:  []string{"db-1"},
+		Interfaces: []instances.InterfaceInstanceCreateOpts{{
+			InterfaceOpts: instances.InterfaceOpts{Type: types.ExternalInterfaceType},
+		}},
+		Volumes: []instances.CreateVolumeOpts{
+			{Source: types.NewVolume, Size: 20, Name: "db-1-data", BootIndex: 1},
+			{Source: types.Image, ImageID: "1b4ab5cf-7e3c-4f3f-9b0e-6a2d0c5e8f11", Size: 10, Name: "db-1-boot"},
+		},
+	}).Extract()
+	require.NoError(t, err)
+	created, err := instances.ListAll(client, nil)
+	require.NoError(t, err)
+	instance := created[0]
+	original := instanceVolumeIDs(t, client, instance.ID)
+
+	group, err := instances.SnapshotAll(client, instance.ID, "nightly", snapshotWaitOpts)
+	require.NoError(t, err)
+	require.Len(t, group, 2)
+	require.Equal(t, original[1], group[0].VolumeID)
+	require.Equal(t, "0", group[0].Metadata[instances.SnapshotBootIndexMetadataKey])
+	require.Equal(t, "/dev/vdb", group[0].Metadata[instances.SnapshotDeviceMetadataKey])
+	require.Equal(t, original[0], group[1].VolumeID)
+	require.Equal(t, "1", group[1].Metadata[instances.SnapshotBootIndexMetadataKey])
+
+	// The boot volume stays attached, only the data volume is detached and attached back.
+	restores, err := instances.RestoreFromSnapshots(client, instance.ID, "nightly", snapshotWaitOpts)
+	require.NoError(t, err)
+	require.Len(t, restores, 2)
+	require.Equal(t, 0, restores[0].BootIndex)
+	require.Equal(t, original[1], restores[0].VolumeID)
+	require.Equal(t, []string{original[1], original[0]}, instanceVolumeIDs(t, client, instance.ID))
+}
@@ -0,0 +1,37 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package rolling
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+)
+
+// API is the set of rolling operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Run(selector instances.Selector, opts Opts) ([]Result, error)
+	RunInstances(targets []instances.Instance, opts Opts) ([]Result, error)
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Run calls the package level Run function with the service client.
+func (s *Service) Run(selector instances.Selector, opts Opts) ([]Result, error) {
+	return Run(s.ServiceClient, selector, opts)
+}
+
+// RunInstances calls the package level RunInstances function with the service client.
+func (s *Service) RunInstances(targets []instances.Instance, opts Opts) ([]Result, error) {
+	return RunInstances(s.ServiceClient, targets, opts)
+}
@@ -0,0 +1,73 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of rolling.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/rolling"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of rolling.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	RunFunc          func(selector instances.Selector, opts rolling.Opts) ([]rolling.Result, error)
+	RunInstancesFunc func(targets []instances.Instance, opts rolling.Opts) ([]rolling.Result, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ rolling.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Run implements rolling.API.
+func (m *API) Run(selector instances.Selector, opts rolling.Opts) ([]rolling.Result, error) {
+	m.record("Run", selector, opts)
+	if m.RunFunc == nil {
+		panic("mocks: rolling.API.RunFunc is not set")
+	}
+	return m.RunFunc(selector, opts)
+}
+
+// RunInstances implements rolling.API.
+func (m *API) RunInstances(targets []instances.Instance, opts rolling.Opts) ([]rolling.Result, error) {
+	m.record("RunInstances", targets, opts)
+	if m.RunInstancesFunc == nil {
+		panic("mocks: rolling.API.RunInstancesFunc is not set")
+	}
+	return m.RunInstancesFunc(targets, opts)
+}
@@ -0,0 +1,375 @@
+package rolling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/baremetal/v1/bminstances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/loadbalancer/v1/lbpools"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+)
+
+// Defaults of Opts.
+const (
+	DefaultBatchSize = 1
+	DefaultInterval  = 5 * time.Second
+)
+
+// ErrRolloutFailed is returned when an instance fails and the remaining batches are not started.
+var ErrRolloutFailed = errors.New("rolling operation failed")
+
+// HealthFunc reports whether a changed instance is healthy. It is polled until it returns true or an error.
+type HealthFunc func(ctx context.Context, instance instances.Instance) (bool, error)
+
+// Opts configures a rolling operation.
+type Opts struct {
+	// FlavorID resizes the instances, ImageID rebuilds the baremetal servers. One of them is required.
+	FlavorID string `validate:"required_without=ImageID"`
+	ImageID  string `validate:"required_without=FlavorID,omitempty,uuid4"`
+	// RollbackImageID is the image the baremetal servers are rebuilt from on rollback. The resized instances are
+	// rolled back to their previous flavor.
+	RollbackImageID string `validate:"omitempty,uuid4"`
+	// BatchSize is the number of instances changed concurrently, DefaultBatchSize when zero.
+	BatchSize int `validate:"gte=0"`
+	// DrainPools removes the instances from their load balancer pools during the change and adds them back afterwards.
+	DrainPools bool
+	// OnFailure defaults to StopOnFailure.
+	OnFailure FailurePolicy `validate:"omitempty,enum"`
+	// Health, if set, is checked for the instances of a batch before the next batch starts.
+	Health     HealthFunc
+	HealthWait gcorecloud.WaitOpts
+	// Wait configures the waits for the tasks and the ACTIVE status.
+	Wait gcorecloud.WaitOpts
+}
+
+// Validate checks the rolling operation options.
+func (opts Opts) Validate() error {
+	if opts.FlavorID != "" && opts.ImageID != "" {
+		return fmt.Errorf("FlavorID and ImageID are mutually exclusive")
+	}
+	if opts.ImageID != "" && opts.OnFailure == RollbackOnFailure && opts.RollbackImageID == "" {
+		return fmt.Errorf("RollbackImageID is required to roll back a rebuild")
+	}
+	return gcorecloud.ValidateStruct(opts)
+}
+
+func (opts Opts) withDefaults() Opts {
+	if opts.BatchSize == 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+	if opts.OnFailure == "" {
+		opts.OnFailure = StopOnFailure
+	}
+	if opts.Wait.Interval <= 0 {
+		opts.Wait.Interval = DefaultInterval
+	}
+	if opts.HealthWait.Interval <= 0 {
+		opts.HealthWait.Interval = DefaultInterval
+	}
+	return opts
+}
+
+// poolMember is a load balancer pool member of an instance.
+type poolMember struct {
+	poolID string
+	member lbpools.PoolMember
+}
+
+// roller keeps the clients and the pool members of a rolling operation.
+type roller struct {
+	opts       Opts
+	instances  *gcorecloud.ServiceClient
+	baremetals *gcorecloud.ServiceClient
+	lbpools    *gcorecloud.ServiceClient
+	tasks      *gcorecloud.ServiceClient
+	// members are the pool members by instance ID. The IDs are updated when a member is added back.
+	members map[string][]*poolMember
+}
+
+// Run selects the instances, ordered by name, and changes them batch by batch, see RunInstances. Only the baremetal
+// servers are selected for a rebuild.
+func Run(client *gcorecloud.ServiceClient, selector instances.Selector, opts Opts) ([]Result, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	selector.IncludeBaremetal = selector.IncludeBaremetal || opts.ImageID != ""
+	selected, err := instances.Select(client, selector)
+	if err != nil {
+		return nil, err
+	}
+	if opts.ImageID != "" {
+		baremetals, err := baremetalIDs(client.ForService("bminstances"))
+		if err != nil {
+			return nil, err
+		}
+		servers := selected[:0]
+		for _, instance := range selected {
+			if baremetals[instance.ID] {
+				servers = append(servers, instance)
+			}
+		}
+		selected = servers
+	}
+	sort.SliceStable(selected, func(i, j int) bool { return selected[i].Name < selected[j].Name })
+	return RunInstances(client, selected, opts)
+}
+
+// RunInstances resizes or rebuilds the instances batch by batch. The instances of a batch are changed concurrently:
+// each one is removed from its pools if DrainPools is set, changed, waited for until ACTIVE and added back to its
+// pools. The health of the batch is then checked. When an instance fails, the next batches are not started and,
+// with RollbackOnFailure, the changed instances are reverted. ErrRolloutFailed is returned along with the results.
+// Only baremetal servers can be rebuilt, the targets are checked against them before any change.
+func RunInstances(client *gcorecloud.ServiceClient, targets []instances.Instance, opts Opts) ([]Result, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	opts = opts.withDefaults()
+	r := &roller{
+		opts:       opts,
+		instances:  client,
+		baremetals: client.ForService("bminstances"),
+		lbpools:    client.ForService("lbpools"),
+		tasks:      client.ForService("tasks"),
+	}
+	if opts.ImageID != "" {
+		baremetals, err := baremetalIDs(r.baremetals)
+		if err != nil {
+			return nil, err
+		}
+		for _, instance := range targets {
+			if !baremetals[instance.ID] {
+				return nil, fmt.Errorf("instance %s is not a baremetal server and cannot be rebuilt", instance.Name)
+			}
+		}
+	}
+	if opts.DrainPools {
+		if err := r.loadMembers(targets); err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]Result, len(targets))
+	for i, instance := range targets {
+		results[i] = Result{ID: instance.ID, Name: instance.Name, Batch: i / opts.BatchSize, Outcome: OutcomePending}
+	}
+	updated := make([]*instances.Instance, len(targets))
+	var attempted []int
+	var failure error
+	for start := 0; start < len(targets) && failure == nil; start += opts.BatchSize {
+		end := start + opts.BatchSize
+		if end > len(targets) {
+			end = len(targets)
+		}
+		var batch []int
+		for i := start; i < end; i++ {
+			if opts.FlavorID != "" && targets[i].Flavor.FlavorID == opts.FlavorID {
+				results[i].Outcome = OutcomeSkipped
+				continue
+			}
+			batch = append(batch, i)
+		}
+		attempted = append(attempted, batch...)
+		failure = r.runBatch(batch, func(i int) error {
+			started := time.Now()
+			defer func() { results[i].Duration = time.Since(started).Round(time.Millisecond).String() }()
+			var err error
+			updated[i], err = r.change(targets[i], r.apply)
+			return err
+		}, results, OutcomeSucceeded, OutcomeFailed)
+		if failure == nil && opts.Health != nil {
+			failure = r.runBatch(batch, func(i int) error {
+				return r.checkHealth(*updated[i])
+			}, results, OutcomeSucceeded, OutcomeFailed)
+		}
+	}
+	if failure == nil {
+		return results, nil
+	}
+
+	if opts.OnFailure == RollbackOnFailure {
+		for end := len(attempted); end > 0; end -= opts.BatchSize {
+			start := end - opts.BatchSize
+			if start < 0 {
+				start = 0
+			}
+			_ = r.runBatch(attempted[start:end], func(i int) error {
+				_, err := r.change(targets[i], r.revert(targets[i]))
+				return err
+			}, results, OutcomeRolledBack, OutcomeRollbackFailed)
+		}
+	}
+	return results, fmt.Errorf("%w: %s", ErrRolloutFailed, failure)
+}
+
+// baremetalIDs returns the IDs of the baremetal servers.
+func baremetalIDs(client *gcorecloud.ServiceClient) (map[string]bool, error) {
+	servers, err := bminstances.ListAll(client, nil)
+	if err != nil {
+		return nil, fmt.Errorf("listing baremetal servers: %w", err)
+	}
+	ids := make(map[string]bool, len(servers))
+	for _, server := range servers {
+		ids[server.ID] = true
+	}
+	return ids, nil
+}
+
+// runBatch runs the step for the instances concurrently, records the outcomes and returns the first error.
+func (r *roller) runBatch(batch []int, step func(i int) error, results []Result, succeeded, failed Outcome) error {
+	errs := make([]error, len(batch))
+	var wg sync.WaitGroup
+	for n, i := range batch {
+		wg.Add(1)
+		go func(n, i int) {
+			defer wg.Done()
+			errs[n] = step(i)
+		}(n, i)
+	}
+	wg.Wait()
+
+	var first error
+	for n, i := range batch {
+		if errs[n] != nil {
+			results[i].Outcome = failed
+			if results[i].Error != "" {
+				results[i].Error += "; "
+			}
+			results[i].Error += errs[n].Error()
+			if first == nil {
+				first = fmt.Errorf("instance %s: %w", results[i].Name, errs[n])
+			}
+			continue
+		}
+		results[i].Outcome = succeeded
+	}
+	return first
+}
+
+// apply starts the change of the instance.
+func (r *roller) apply(id string) tasks.Result {
+	if r.opts.ImageID != "" {
+		return bminstances.Rebuild(r.baremetals, id, bminstances.RebuildInstanceOpts{ImageID: r.opts.ImageID})
+	}
+	return instances.Resize(r.instances, id, instances.ChangeFlavorOpts{FlavorID: r.opts.FlavorID})
+}
+
+// revert returns the function starting the rollback of the instance.
+func (r *roller) revert(instance instances.Instance) func(id string) tasks.Result {
+	return func(id string) tasks.Result {
+		if r.opts.ImageID != "" {
+			return bminstances.Rebuild(r.baremetals, id, bminstances.RebuildInstanceOpts{ImageID: r.opts.RollbackImageID})
+		}
+		return instances.Resize(r.instances, id, instances.ChangeFlavorOpts{FlavorID: instance.Flavor.FlavorID})
+	}
+}
+
+// change drains the instance, applies the change, waits for the instance to be ACTIVE and adds it back to its pools.
+// The drained members are added back even when the change fails.
+func (r *roller) change(instance instances.Instance, apply func(id string) tasks.Result) (*instances.Instance, error) {
+	members := r.members[instance.ID]
+	drained := 0
+	var err error
+	for _, m := range members {
+		if _, err = r.waitTask(lbpools.DeleteMember(r.lbpools, m.poolID, m.member.ID)); err != nil {
+			err = fmt.Errorf("removing member %s from pool %s: %w", m.member.ID, m.poolID, err)
+			break
+		}
+		drained++
+	}
+
+	var updated *instances.Instance
+	if err == nil {
+		if _, err = r.waitTask(apply(instance.ID)); err == nil {
+			updated, err = instances.WaitForStatus(r.instances, instance.ID, "ACTIVE", "", r.opts.Wait)
+		}
+	}
+
+	for _, m := range members[:drained] {
+		if addErr := r.addMember(m); addErr != nil && err == nil {
+			err = addErr
+		}
+	}
+	return updated, err
+}
+
+func (r *roller) checkHealth(instance instances.Instance) error {
+	err := gcorecloud.WaitUntil(r.instances.RequestContext(), r.opts.HealthWait, func(ctx context.Context) (bool, error) {
+		return r.opts.Health(ctx, instance)
+	})
+	if err != nil {
+		return fmt.Errorf("health check: %w", err)
+	}
+	return nil
+}
+
+// loadMembers finds the pool members of the instances by instance ID or by address.
+func (r *roller) loadMembers(targets []instances.Instance) error {
+	details := true
+	pools, err := lbpools.ListAll(r.lbpools, lbpools.ListOpts{MemberDetails: &details})
+	if err != nil {
+		return fmt.Errorf("listing load balancer pools: %w", err)
+	}
+	byID := make(map[string]bool, len(targets))
+	byAddress := make(map[string]string)
+	for _, instance := range targets {
+		byID[instance.ID] = true
+		for _, addresses := range instance.Addresses {
+			for _, address := range addresses {
+				byAddress[address.Address.String()] = instance.ID
+			}
+		}
+	}
+	r.members = make(map[string][]*poolMember)
+	for _, pool := range pools {
+		for _, member := range pool.Members {
+			instanceID := member.InstanceID
+			if !byID[instanceID] && member.Address != nil {
+				instanceID = byAddress[member.Address.String()]
+			}
+			if instanceID != "" && byID[instanceID] {
+				r.members[instanceID] = append(r.members[instanceID], &poolMember{poolID: pool.ID, member: member})
+			}
+		}
+	}
+	return nil
+}
+
+func (r *roller) addMember(m *poolMember) error {
+	opts := lbpools.CreatePoolMemberOpts{
+		ProtocolPort:   m.member.ProtocolPort,
+		Weight:         m.member.Weight,
+		SubnetID:       m.member.SubnetID,
+		InstanceID:     m.member.InstanceID,
+		MonitorAddress: m.member.MonitorAddress,
+		MonitorPort:    m.member.MonitorPort,
+	}
+	if m.member.Address != nil {
+		opts.Address = *m.member.Address
+	}
+	task, err := r.waitTask(lbpools.CreateMember(r.lbpools, m.poolID, opts))
+	if err == nil {
+		m.member.ID, err = lbpools.ExtractPoolMemberIDFromTask(task)
+	}
+	if err != nil {
+		return fmt.Errorf("adding member back to pool %s: %w", m.poolID, err)
+	}
+	return nil
+}
+
+// waitTask waits for the task of the result and returns it.
+func (r *roller) waitTask(result tasks.Result) (*tasks.Task, error) {
+	results, err := result.Extract()
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Tasks) == 0 {
+		return nil, fmt.Errorf("no task returned")
+	}
+	return tasks.WaitForTask(r.tasks, string(results.Tasks[0]), r.opts.Wait)
+}
@@ -0,0 +1,25 @@
+package rolling
+
+// Outcome is the outcome of a rolling operation for an instance.
+type Outcome string
+
+const (
+	OutcomeSucceeded Outcome = "succeeded"
+	OutcomeFailed    Outcome = "failed"
+	// OutcomeSkipped is reported for instances which already have the target flavor.
+	OutcomeSkipped        Outcome = "skipped"
+	OutcomeRolledBack     Outcome = "rolled-back"
+	OutcomeRollbackFailed Outcome = "rollback-failed"
+	// OutcomePending is reported for instances of the batches not started after a failure.
+	OutcomePending Outcome = "pending"
+)
+
+// Result is the outcome of a rolling operation for an instance.
+type Result struct {
+	ID       string  `json:"instance_id"`
+	Name     string  `json:"name"`
+	Batch    int     `json:"batch"`
+	Outcome  Outcome `json:"outcome"`
+	Duration string  `json:"duration,omitempty"`
+	Error    string  `json:"error,omitempty"`
+}
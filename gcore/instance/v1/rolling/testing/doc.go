@@ -0,0 +1,2 @@
+// rolling unit tests
+package testing
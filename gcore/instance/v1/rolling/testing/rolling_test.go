@@ -0,0 +1,268 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/baremetal/v1/bminstances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/rolling"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/loadbalancer/v1/lbpools"
+	lbtypes "github.com/G-Core/gcorelabscloud-go/gcore/loadbalancer/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/testhelper/fakecloud"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	imageID         = "1b4ab5cf-7e3c-4f3f-9b0e-6a2d0c5e8f11"
+	rollbackImageID = "5d6e7f80-1a2b-4c3d-8e9f-0a1b2c3d4e5f"
+)
+
+var waitOpts = gcorecloud.WaitOpts{Interval: time.Millisecond}
+
+func createFakeInstances(t *testing.T, server *fakecloud.Server, names ...string) []instances.Instance {
+	client := server.ServiceClient("instances", "v1")
+	_, err := instances.Create(client, instances.CreateOpts{
+		Flavor: "g1-standard-1-2",
+		Names:  names,
+		Interfaces: []instances.InterfaceInstanceCreateOpts{{
+			InterfaceOpts: instances.InterfaceOpts{Type: types.ExternalInterfaceType},
+		}},
+	}).Extract()
+	require.NoError(t, err)
+	created, err := instances.ListAll(client, nil)
+	require.NoError(t, err)
+	return created
+}
+
+// createFakePool adds the instances to a pool, by instance ID for the first one and by address for the others.
+func createFakePool(t *testing.T, server *fakecloud.Server, targets []instances.Instance) {
+	var members []lbpools.CreatePoolMemberOpts
+	for i, instance := range targets {
+		member := lbpools.CreatePoolMemberOpts{ProtocolPort: 8080}
+		for _, addresses := range instance.Addresses {
+			member.Address = addresses[0].Address
+		}
+		if i == 0 {
+			member.InstanceID = instance.ID
+		}
+		members = append(members, member)
+	}
+	_, err := lbpools.Create(server.ServiceClient("lbpools", "v1"), lbpools.CreateOpts{
+		Name:            "web",
+		Protocol:        lbtypes.ProtocolTypeHTTP,
+		LBPoolAlgorithm: lbtypes.LoadBalancerAlgorithmRoundRobin,
+		Members:         members,
+	}).Extract()
+	require.NoError(t, err)
+}
+
+func flavors(t *testing.T, server *fakecloud.Server) map[string]string {
+	result := make(map[string]string)
+	for _, instance := range server.Resources("instances") {
+		result[instance["instance_name"].(string)] = instance["flavor"].(map[string]interface{})["flavor_id"].(string)
+	}
+	return result
+}
+
+func outcomes(results []rolling.Result) map[string]rolling.Outcome {
+	result := make(map[string]rolling.Outcome, len(results))
+	for _, r := range results {
+		result[r.Name] = r.Outcome
+	}
+	return result
+}
+
+func TestRunResize(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	targets := createFakeInstances(t, server, "web-1", "web-2", "web-3")
+	createFakePool(t, server, targets)
+	client := server.ServiceClient("instances", "v1")
+
+	var mu sync.Mutex
+	var checked, checkedFlavors []string
+	var checkedMembers []int
+	opts := rolling.Opts{
+		FlavorID:   "g1-standard-2-4",
+		BatchSize:  2,
+		DrainPools: true,
+		Health: func(ctx context.Context, instance instances.Instance) (bool, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			checked = append(checked, instance.Name)
+			checkedFlavors = append(checkedFlavors, instance.Flavor.FlavorID)
+			checkedMembers = append(checkedMembers, len(server.Resources("lbpools")[0]["members"].([]interface{})))
+			return true, nil
+		},
+		HealthWait: waitOpts,
+		Wait:       waitOpts,
+	}
+	results, err := rolling.Run(client, instances.Selector{NameRegex: "^web-"}, opts)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	for i, r := range results {
+		require.Equal(t, fmt.Sprintf("web-%d", i+1), r.Name)
+		require.Equal(t, i/2, r.Batch)
+		require.Equal(t, rolling.OutcomeSucceeded, r.Outcome, r.Error)
+	}
+	require.ElementsMatch(t, []string{"web-1", "web-2", "web-3"}, checked)
+	require.Equal(t, []string{"g1-standard-2-4", "g1-standard-2-4", "g1-standard-2-4"}, checkedFlavors)
+	require.Equal(t, []int{3, 3, 3}, checkedMembers)
+	require.Equal(t, map[string]string{"web-1": "g1-standard-2-4", "web-2": "g1-standard-2-4", "web-3": "g1-standard-2-4"}, flavors(t, server))
+
+	members := server.Resources("lbpools")[0]["members"].([]interface{})
+	require.Len(t, members, 3)
+	var instanceIDs []interface{}
+	for _, raw := range members {
+		member := raw.(map[string]interface{})
+		require.Equal(t, 8080, int(member["protocol_port"].(float64)))
+		instanceIDs = append(instanceIDs, member["instance_id"])
+	}
+	require.ElementsMatch(t, []interface{}{targets[0].ID, "", ""}, instanceIDs)
+
+	results, err = rolling.Run(client, instances.Selector{NameRegex: "^web-1$"}, opts)
+	require.NoError(t, err)
+	require.Equal(t, rolling.OutcomeSkipped, results[0].Outcome)
+}
+
+func unhealthy(name string) rolling.HealthFunc {
+	return func(ctx context.Context, instance instances.Instance) (bool, error) {
+		return instance.Name != name, nil
+	}
+}
+
+func TestRunStopOnFailure(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	createFakeInstances(t, server, "web-1", "web-2", "web-3", "web-4")
+
+	results, err := rolling.Run(server.ServiceClient("instances", "v1"), instances.Selector{}, rolling.Opts{
+		FlavorID:   "g1-standard-2-4",
+		BatchSize:  2,
+		Health:     unhealthy("web-3"),
+		HealthWait: gcorecloud.WaitOpts{Timeout: 20 * time.Millisecond, Interval: time.Millisecond},
+		Wait:       waitOpts,
+	})
+	require.True(t, errors.Is(err, rolling.ErrRolloutFailed))
+	require.Equal(t, map[string]rolling.Outcome{
+		"web-1": rolling.OutcomeSucceeded,
+		"web-2": rolling.OutcomeSucceeded,
+		"web-3": rolling.OutcomeFailed,
+		"web-4": rolling.OutcomeSucceeded,
+	}, outcomes(results))
+	require.Contains(t, results[2].Error, "health check")
+	require.Equal(t, map[string]string{
+		"web-1": "g1-standard-2-4",
+		"web-2": "g1-standard-2-4",
+		"web-3": "g1-standard-2-4",
+		"web-4": "g1-standard-2-4",
+	}, flavors(t, server))
+
+	results, err = rolling.Run(server.ServiceClient("instances", "v1"), instances.Selector{}, rolling.Opts{
+		FlavorID:   "g1-standard-4-8",
+		Health:     unhealthy("web-1"),
+		HealthWait: gcorecloud.WaitOpts{Timeout: 20 * time.Millisecond, Interval: time.Millisecond},
+		Wait:       waitOpts,
+	})
+	require.True(t, errors.Is(err, rolling.ErrRolloutFailed))
+	require.Equal(t, map[string]rolling.Outcome{
+		"web-1": rolling.OutcomeFailed,
+		"web-2": rolling.OutcomePending,
+		"web-3": rolling.OutcomePending,
+		"web-4": rolling.OutcomePending,
+	}, outcomes(results))
+}
+
+func TestRunRollback(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	targets := createFakeInstances(t, server, "web-1", "web-2", "web-3", "web-4", "web-5")
+	createFakePool(t, server, targets)
+
+	results, err := rolling.Run(server.ServiceClient("instances", "v1"), instances.Selector{}, rolling.Opts{
+		FlavorID:   "g1-standard-2-4",
+		BatchSize:  2,
+		DrainPools: true,
+		OnFailure:  rolling.RollbackOnFailure,
+		Health:     unhealthy("web-4"),
+		HealthWait: gcorecloud.WaitOpts{Timeout: 20 * time.Millisecond, Interval: time.Millisecond},
+		Wait:       waitOpts,
+	})
+	require.True(t, errors.Is(err, rolling.ErrRolloutFailed))
+	require.Equal(t, map[string]rolling.Outcome{
+		"web-1": rolling.OutcomeRolledBack,
+		"web-2": rolling.OutcomeRolledBack,
+		"web-3": rolling.OutcomeRolledBack,
+		"web-4": rolling.OutcomeRolledBack,
+		"web-5": rolling.OutcomePending,
+	}, outcomes(results))
+	require.Contains(t, results[3].Error, "health check")
+	for name, flavor := range flavors(t, server) {
+		require.Equal(t, "g1-standard-1-2", flavor, name)
+	}
+	require.Len(t, server.Resources("lbpools")[0]["members"], 5)
+}
+
+// createFakeBaremetals creates baremetal servers and returns them with the instances.
+func createFakeBaremetals(t *testing.T, server *fakecloud.Server, names ...string) []instances.Instance {
+	_, err := bminstances.Create(server.ServiceClient("bminstances", "v1"), bminstances.CreateOpts{
+		Flavor:     "bm1-infrastructure-small",
+		Names:      names,
+		ImageID:    rollbackImageID,
+		Interfaces: []bminstances.InterfaceOpts{{Type: types.ExternalInterfaceType}},
+	}).Extract()
+	require.NoError(t, err)
+	created, err := instances.ListAll(server.ServiceClient("instances", "v1"), instances.ListOpts{IncludeBaremetal: true})
+	require.NoError(t, err)
+	return created
+}
+
+func images(server *fakecloud.Server) map[string]interface{} {
+	result := make(map[string]interface{})
+	for _, instance := range server.Resources("instances") {
+		result[instance["instance_name"].(string)] = instance["image_id"]
+	}
+	return result
+}
+
+func TestRunRebuild(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	createFakeInstances(t, server, "vm-1")
+	all := createFakeBaremetals(t, server, "bm-1", "bm-2")
+	client := server.ServiceClient("instances", "v1")
+
+	results, err := rolling.Run(client, instances.Selector{}, rolling.Opts{
+		ImageID:   imageID,
+		BatchSize: 2,
+		Wait:      waitOpts,
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]rolling.Outcome{"bm-1": rolling.OutcomeSucceeded, "bm-2": rolling.OutcomeSucceeded}, outcomes(results))
+	require.Equal(t, map[string]interface{}{"vm-1": nil, "bm-1": imageID, "bm-2": imageID}, images(server))
+
+	_, err = rolling.RunInstances(client, all, rolling.Opts{
+		ImageID:         rollbackImageID,
+		OnFailure:       rolling.RollbackOnFailure,
+		RollbackImageID: imageID,
+		Wait:            waitOpts,
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "vm-1 is not a baremetal server")
+	require.Equal(t, map[string]interface{}{"vm-1": nil, "bm-1": imageID, "bm-2": imageID}, images(server))
+}
+
+func TestOptsValidate(t *testing.T) {
+	require.Error(t, rolling.Opts{}.Validate())
+	require.Error(t, rolling.Opts{FlavorID: "g1-standard-2-4", ImageID: imageID}.Validate())
+	require.Error(t, rolling.Opts{ImageID: imageID, OnFailure: rolling.RollbackOnFailure}.Validate())
+	require.Error(t, rolling.Opts{FlavorID: "g1-standard-2-4", OnFailure: "retry"}.Validate())
+	require.NoError(t, rolling.Opts{ImageID: imageID, OnFailure: rolling.RollbackOnFailure, RollbackImageID: rollbackImageID}.Validate())
+}
@@ -0,0 +1,71 @@
+package rolling
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// FailurePolicy is what a rolling operation does when an instance fails.
+type FailurePolicy string
+
+const (
+	// StopOnFailure leaves the changed instances as they are and does not start the next batches.
+	StopOnFailure FailurePolicy = "stop"
+	// RollbackOnFailure reverts the changed instances, including the failed batch.
+	RollbackOnFailure FailurePolicy = "rollback"
+)
+
+func (p FailurePolicy) IsValid() error {
+	switch p {
+	case StopOnFailure, RollbackOnFailure:
+		return nil
+	}
+	return fmt.Errorf("invalid FailurePolicy type: %v", p)
+}
+
+func (p FailurePolicy) ValidOrNil() (*FailurePolicy, error) {
+	if p.String() == "" {
+		return nil, nil
+	}
+	err := p.IsValid()
+	if err != nil {
+		return &p, err
+	}
+	return &p, nil
+}
+
+func (p FailurePolicy) String() string {
+	return string(p)
+}
+
+func (p FailurePolicy) List() []FailurePolicy {
+	return []FailurePolicy{StopOnFailure, RollbackOnFailure}
+}
+
+func (p FailurePolicy) StringList() []string {
+	var s []string
+	for _, v := range p.List() {
+		s = append(s, v.String())
+	}
+	return s
+}
+
+// UnmarshalJSON - implements Unmarshaler interface for FailurePolicy
+func (p *FailurePolicy) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v := FailurePolicy(s)
+	err := v.IsValid()
+	if err != nil {
+		return err
+	}
+	*p = v
+	return nil
+}
+
+// MarshalJSON - implements Marshaler interface for FailurePolicy
+func (p *FailurePolicy) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
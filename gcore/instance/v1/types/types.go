@@ -10,6 +10,7 @@ type VolumeSource string
 type FloatingIPSource string
 type InterfaceType string
 type MetricsTimeUnit string
+type PowerAction string
 
 const (
 	AddressTypeFixed       AddressType      = "fixed"
@@ -26,6 +27,12 @@ const (
 	ReservedFixedIpType    InterfaceType    = "reserved_fixed_ip"
 	HourMetricsTimeUnit    MetricsTimeUnit  = "hour"
 	DayMetricsTimeUnit     MetricsTimeUnit  = "day"
+	StartPowerAction       PowerAction      = "start"
+	StopPowerAction        PowerAction      = "stop"
+	PowerCyclePowerAction  PowerAction      = "powercycle"
+	RebootPowerAction      PowerAction      = "reboot"
+	SuspendPowerAction     PowerAction      = "suspend"
+	ResumePowerAction      PowerAction      = "resume"
 )
 
 func (vs VolumeSource) IsValid() error {
@@ -324,3 +331,65 @@ func (u *MetricsTimeUnit) UnmarshalJSON(data []byte) error {
 func (u *MetricsTimeUnit) MarshalJSON() ([]byte, error) {
 	return json.Marshal(u.String())
 }
+
+func (a PowerAction) IsValid() error {
+	switch a {
+	case StartPowerAction, StopPowerAction, PowerCyclePowerAction, RebootPowerAction, SuspendPowerAction, ResumePowerAction:
+		return nil
+	}
+	return fmt.Errorf("invalid PowerAction type: %v", a)
+}
+
+func (a PowerAction) ValidOrNil() (*PowerAction, error) {
+	if a.String() == "" {
+		return nil, nil
+	}
+	err := a.IsValid()
+	if err != nil {
+		return &a, err
+	}
+	return &a, nil
+}
+
+func (a PowerAction) String() string {
+	return string(a)
+}
+
+func (a PowerAction) List() []PowerAction {
+	return []PowerAction{
+		StartPowerAction,
+		StopPowerAction,
+		PowerCyclePowerAction,
+		RebootPowerAction,
+		SuspendPowerAction,
+		ResumePowerAction,
+	}
+}
+
+func (a PowerAction) StringList() []string {
+	var s []string
+	for _, v := range a.List() {
+		s = append(s, v.String())
+	}
+	return s
+}
+
+// UnmarshalJSON - implements Unmarshaler interface
+func (a *PowerAction) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v := PowerAction(s)
+	err := v.IsValid()
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// MarshalJSON - implements Marshaler interface
+func (a *PowerAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
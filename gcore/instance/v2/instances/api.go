@@ -0,0 +1,135 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package instances
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/utils/metadata"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of instances operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Action(id string, opts ActionOptsBuilder) tasks.Result
+	Create(opts CreateOptsBuilder) tasks.Result
+	Delete(instanceID string, opts DeleteOptsBuilder) tasks.Result
+	Get(id string) GetResult
+	List(opts ListOptsBuilder) pagination.Pager
+	ListAll(opts ListOptsBuilder) ([]Instance, error)
+	MetadataCreateOrUpdate(id string, opts map[string]string) tasks.Result
+	MetadataDelete(id string, key string) tasks.Result
+	MetadataGet(id string, key string) metadata.MetadataResult
+	MetadataList(id string) pagination.Pager
+	MetadataListAll(id string) ([]metadata.Metadata, error)
+	MetadataReplace(id string, opts map[string]string) tasks.Result
+	Reboot(id string) tasks.Result
+	RebootHard(id string) tasks.Result
+	Resume(id string) tasks.Result
+	Start(id string) tasks.Result
+	Stop(id string) tasks.Result
+	Suspend(id string) tasks.Result
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Action calls the package level Action function with the service client.
+func (s *Service) Action(id string, opts ActionOptsBuilder) tasks.Result {
+	return Action(s.ServiceClient, id, opts)
+}
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(instanceID string, opts DeleteOptsBuilder) tasks.Result {
+	return Delete(s.ServiceClient, instanceID, opts)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id string) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(opts ListOptsBuilder) ([]Instance, error) {
+	return ListAll(s.ServiceClient, opts)
+}
+
+// MetadataCreateOrUpdate calls the package level MetadataCreateOrUpdate function with the service client.
+func (s *Service) MetadataCreateOrUpdate(id string, opts map[string]string) tasks.Result {
+	return MetadataCreateOrUpdate(s.ServiceClient, id, opts)
+}
+
+// MetadataDelete calls the package level MetadataDelete function with the service client.
+func (s *Service) MetadataDelete(id string, key string) tasks.Result {
+	return MetadataDelete(s.ServiceClient, id, key)
+}
+
+// MetadataGet calls the package level MetadataGet function with the service client.
+func (s *Service) MetadataGet(id string, key string) metadata.MetadataResult {
+	return MetadataGet(s.ServiceClient, id, key)
+}
+
+// MetadataList calls the package level MetadataList function with the service client.
+func (s *Service) MetadataList(id string) pagination.Pager {
+	return MetadataList(s.ServiceClient, id)
+}
+
+// MetadataListAll calls the package level MetadataListAll function with the service client.
+func (s *Service) MetadataListAll(id string) ([]metadata.Metadata, error) {
+	return MetadataListAll(s.ServiceClient, id)
+}
+
+// MetadataReplace calls the package level MetadataReplace function with the service client.
+func (s *Service) MetadataReplace(id string, opts map[string]string) tasks.Result {
+	return MetadataReplace(s.ServiceClient, id, opts)
+}
+
+// Reboot calls the package level Reboot function with the service client.
+func (s *Service) Reboot(id string) tasks.Result {
+	return Reboot(s.ServiceClient, id)
+}
+
+// RebootHard calls the package level RebootHard function with the service client.
+func (s *Service) RebootHard(id string) tasks.Result {
+	return RebootHard(s.ServiceClient, id)
+}
+
+// Resume calls the package level Resume function with the service client.
+func (s *Service) Resume(id string) tasks.Result {
+	return Resume(s.ServiceClient, id)
+}
+
+// Start calls the package level Start function with the service client.
+func (s *Service) Start(id string) tasks.Result {
+	return Start(s.ServiceClient, id)
+}
+
+// Stop calls the package level Stop function with the service client.
+func (s *Service) Stop(id string) tasks.Result {
+	return Stop(s.ServiceClient, id)
+}
+
+// Suspend calls the package level Suspend function with the service client.
+func (s *Service) Suspend(id string) tasks.Result {
+	return Suspend(s.ServiceClient, id)
+}
@@ -0,0 +1,235 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of instances.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v2/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/utils/metadata"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of instances.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	ActionFunc                 func(id string, opts instances.ActionOptsBuilder) tasks.Result
+	CreateFunc                 func(opts instances.CreateOptsBuilder) tasks.Result
+	DeleteFunc                 func(instanceID string, opts instances.DeleteOptsBuilder) tasks.Result
+	GetFunc                    func(id string) instances.GetResult
+	ListFunc                   func(opts instances.ListOptsBuilder) pagination.Pager
+	ListAllFunc                func(opts instances.ListOptsBuilder) ([]instances.Instance, error)
+	MetadataCreateOrUpdateFunc func(id string, opts map[string]string) tasks.Result
+	MetadataDeleteFunc         func(id string, key string) tasks.Result
+	MetadataGetFunc            func(id string, key string) metadata.MetadataResult
+	MetadataListFunc           func(id string) pagination.Pager
+	MetadataListAllFunc        func(id string) ([]metadata.Metadata, error)
+	MetadataReplaceFunc        func(id string, opts map[string]string) tasks.Result
+	RebootFunc                 func(id string) tasks.Result
+	RebootHardFunc             func(id string) tasks.Result
+	ResumeFunc                 func(id string) tasks.Result
+	StartFunc                  func(id string) tasks.Result
+	StopFunc                   func(id string) tasks.Result
+	SuspendFunc                func(id string) tasks.Result
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ instances.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Action implements instances.API.
+func (m *API) Action(id string, opts instances.ActionOptsBuilder) tasks.Result {
+	m.record("Action", id, opts)
+	if m.ActionFunc == nil {
+		panic("mocks: instances.API.ActionFunc is not set")
+	}
+	return m.ActionFunc(id, opts)
+}
+
+// Create implements instances.API.
+func (m *API) Create(opts instances.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: instances.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements instances.API.
+func (m *API) Delete(instanceID string, opts instances.DeleteOptsBuilder) tasks.Result {
+	m.record("Delete", instanceID, opts)
+	if m.DeleteFunc == nil {
+		panic("mocks: instances.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(instanceID, opts)
+}
+
+// Get implements instances.API.
+func (m *API) Get(id string) instances.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: instances.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// List implements instances.API.
+func (m *API) List(opts instances.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: instances.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements instances.API.
+func (m *API) ListAll(opts instances.ListOptsBuilder) ([]instances.Instance, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: instances.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// MetadataCreateOrUpdate implements instances.API.
+func (m *API) MetadataCreateOrUpdate(id string, opts map[string]string) tasks.Result {
+	m.record("MetadataCreateOrUpdate", id, opts)
+	if m.MetadataCreateOrUpdateFunc == nil {
+		panic("mocks: instances.API.MetadataCreateOrUpdateFunc is not set")
+	}
+	return m.MetadataCreateOrUpdateFunc(id, opts)
+}
+
+// MetadataDelete implements instances.API.
+func (m *API) MetadataDelete(id string, key string) tasks.Result {
+	m.record("MetadataDelete", id, key)
+	if m.MetadataDeleteFunc == nil {
+		panic("mocks: instances.API.MetadataDeleteFunc is not set")
+	}
+	return m.MetadataDeleteFunc(id, key)
+}
+
+// MetadataGet implements instances.API.
+func (m *API) MetadataGet(id string, key string) metadata.MetadataResult {
+	m.record("MetadataGet", id, key)
+	if m.MetadataGetFunc == nil {
+		panic("mocks: instances.API.MetadataGetFunc is not set")
+	}
+	return m.MetadataGetFunc(id, key)
+}
+
+// MetadataList implements instances.API.
+func (m *API) MetadataList(id string) pagination.Pager {
+	m.record("MetadataList", id)
+	if m.MetadataListFunc == nil {
+		panic("mocks: instances.API.MetadataListFunc is not set")
+	}
+	return m.MetadataListFunc(id)
+}
+
+// MetadataListAll implements instances.API.
+func (m *API) MetadataListAll(id string) ([]metadata.Metadata, error) {
+	m.record("MetadataListAll", id)
+	if m.MetadataListAllFunc == nil {
+		panic("mocks: instances.API.MetadataListAllFunc is not set")
+	}
+	return m.MetadataListAllFunc(id)
+}
+
+// MetadataReplace implements instances.API.
+func (m *API) MetadataReplace(id string, opts map[string]string) tasks.Result {
+	m.record("MetadataReplace", id, opts)
+	if m.MetadataReplaceFunc == nil {
+		panic("mocks: instances.API.MetadataReplaceFunc is not set")
+	}
+	return m.MetadataReplaceFunc(id, opts)
+}
+
+// Reboot implements instances.API.
+func (m *API) Reboot(id string) tasks.Result {
+	m.record("Reboot", id)
+	if m.RebootFunc == nil {
+		panic("mocks: instances.API.RebootFunc is not set")
+	}
+	return m.RebootFunc(id)
+}
+
+// RebootHard implements instances.API.
+func (m *API) RebootHard(id string) tasks.Result {
+	m.record("RebootHard", id)
+	if m.RebootHardFunc == nil {
+		panic("mocks: instances.API.RebootHardFunc is not set")
+	}
+	return m.RebootHardFunc(id)
+}
+
+// Resume implements instances.API.
+func (m *API) Resume(id string) tasks.Result {
+	m.record("Resume", id)
+	if m.ResumeFunc == nil {
+		panic("mocks: instances.API.ResumeFunc is not set")
+	}
+	return m.ResumeFunc(id)
+}
+
+// Start implements instances.API.
+func (m *API) Start(id string) tasks.Result {
+	m.record("Start", id)
+	if m.StartFunc == nil {
+		panic("mocks: instances.API.StartFunc is not set")
+	}
+	return m.StartFunc(id)
+}
+
+// Stop implements instances.API.
+func (m *API) Stop(id string) tasks.Result {
+	m.record("Stop", id)
+	if m.StopFunc == nil {
+		panic("mocks: instances.API.StopFunc is not set")
+	}
+	return m.StopFunc(id)
+}
+
+// Suspend implements instances.API.
+func (m *API) Suspend(id string) tasks.Result {
+	m.record("Suspend", id)
+	if m.SuspendFunc == nil {
+		panic("mocks: instances.API.SuspendFunc is not set")
+	}
+	return m.SuspendFunc(id)
+}
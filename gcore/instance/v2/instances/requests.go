@@ -0,0 +1,351 @@
+package instances
+
+import (
+	"fmt"
+	"net/http"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
+	typesV2 "github.com/G-Core/gcorelabscloud-go/gcore/instance/v2/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/utils/metadata"
+	"github.com/G-Core/gcorelabscloud-go/gcore/utils/userdata"
+	"github.com/G-Core/gcorelabscloud-go/gcore/volume/v1/volumes"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List request.
+type ListOptsBuilder interface {
+	ToInstanceListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through the API.
+type ListOpts struct {
+	Name               string            `q:"name"`
+	FlavorID           string            `q:"flavor_id"`
+	FlavorPrefix       string            `q:"flavor_prefix"`
+	Status             string            `q:"status"`
+	IP                 string            `q:"ip"`
+	UUID               string            `q:"uuid" validate:"omitempty,uuid4"`
+	ChangesSince       string            `q:"changes-since"`
+	ChangesBefore      string            `q:"changes-before"`
+	ExcludeSecGroup    string            `q:"exclude_secgroup"`
+	AvailableFloating  bool              `q:"available_floating"`
+	IncludeBaremetal   bool              `q:"include_baremetal"`
+	IncludeK8S         bool              `q:"include_k8s"`
+	OnlyWithFixedIP    bool              `q:"only_with_fixed_external_ip"`
+	WithInterfacesName bool              `q:"with_interfaces_name"`
+	OrderBy            string            `q:"order_by"`
+	MetadataKV         map[string]string `q:"metadata_kv" validate:"omitempty"`
+	MetadataV          []string          `q:"metadata_v" validate:"omitempty"`
+	Limit              int               `q:"limit" validate:"omitempty,gt=0"`
+	Offset             int               `q:"offset" validate:"omitempty,gt=0"`
+}
+
+// ToInstanceListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToInstanceListQuery() (string, error) {
+	if err := gcorecloud.ValidateStruct(opts); err != nil {
+		return "", err
+	}
+	q, err := gcorecloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// DeleteOptsBuilder allows extensions to add additional parameters to the Delete request.
+type DeleteOptsBuilder interface {
+	ToInstanceDeleteQuery() (string, error)
+}
+
+// DeleteOpts. Set parameters for delete operation
+type DeleteOpts struct {
+	Volumes          []string `q:"volumes" validate:"omitempty,dive,uuid4" delimiter:"comma"`
+	DeleteFloatings  bool     `q:"delete_floatings" validate:"omitempty,allowed_without=FloatingIPs"`
+	FloatingIPs      []string `q:"floatings" validate:"omitempty,allowed_without=DeleteFloatings,dive,uuid4" delimiter:"comma"`
+	ReservedFixedIPs []string `q:"reserved_fixed_ips" validate:"omitempty,dive,uuid4" delimiter:"comma"`
+}
+
+// ToInstanceDeleteQuery formats a DeleteOpts into a query string.
+func (opts DeleteOpts) ToInstanceDeleteQuery() (string, error) {
+	if err := opts.Validate(); err != nil {
+		return "", err
+	}
+	q, err := gcorecloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+func (opts *DeleteOpts) Validate() error {
+	return gcorecloud.ValidateStruct(opts)
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToInstanceCreateMap() (map[string]interface{}, error)
+}
+
+// CreateVolumeOpts represents options used to create a volume.
+type CreateVolumeOpts struct {
+	Source              types.VolumeSource `json:"source" required:"true" validate:"required,enum"`
+	BootIndex           int                `json:"boot_index"`
+	Size                int                `json:"size,omitempty" validate:"rfe=Source:image;new-volume,sfe=Source:snapshot;existing-volume"`
+	TypeName            volumes.VolumeType `json:"type_name,omitempty" validate:"omitempty"`
+	AttachmentTag       string             `json:"attachment_tag,omitempty" validate:"omitempty"`
+	Name                string             `json:"name,omitempty" validate:"omitempty"`
+	ImageID             string             `json:"image_id,omitempty" validate:"rfe=Source:image,sfe=Source:snapshot;existing-volume;new-volume,allowed_without_all=SnapshotID VolumeID,omitempty,uuid4"`
+	SnapshotID          string             `json:"snapshot_id,omitempty" validate:"rfe=Source:snapshot,sfe=Source:image;existing-volume;new-volume,allowed_without_all=ImageID VolumeID,omitempty,uuid4"`
+	VolumeID            string             `json:"volume_id,omitempty" validate:"rfe=Source:existing-volume,sfe=Source:image;snapshot;new-volume,allowed_without_all=ImageID SnapshotID,omitempty,uuid4"`
+	Metadata            map[string]string  `json:"metadata,omitempty" validate:"omitempty"`
+	DeleteOnTermination bool               `json:"delete_on_termination,omitempty"`
+}
+
+func (opts *CreateVolumeOpts) Validate() error {
+	return gcorecloud.ValidateStruct(opts)
+}
+
+// CreateNewInterfaceFloatingIPOpts represents options of a floating IP created or assigned with an interface.
+type CreateNewInterfaceFloatingIPOpts struct {
+	Source             types.FloatingIPSource `json:"source" validate:"required,enum"`
+	ExistingFloatingID string                 `json:"existing_floating_id,omitempty" validate:"rfe=Source:existing,sfe=Source:new,omitempty,uuid"`
+}
+
+// Validate
+func (opts CreateNewInterfaceFloatingIPOpts) Validate() error {
+	return gcorecloud.ValidateStruct(opts)
+}
+
+// InterfaceOpts represents an instance interface in the v2 schema. Unlike v1 the security groups belong to
+// the interface and the interface can be named.
+type InterfaceOpts struct {
+	Type           types.InterfaceType               `json:"type" required:"true" validate:"required,enum"`
+	InterfaceName  string                            `json:"interface_name,omitempty"`
+	NetworkID      string                            `json:"network_id,omitempty" validate:"rfe=Type:any_subnet;subnet,omitempty,uuid4"`
+	SubnetID       string                            `json:"subnet_id,omitempty" validate:"rfe=Type:subnet,omitempty,uuid4"`
+	PortID         string                            `json:"port_id,omitempty" validate:"rfe=Type:reserved_fixed_ip,omitempty,uuid4"`
+	IPFamily       typesV2.IPFamilyType              `json:"ip_family,omitempty" validate:"omitempty,enum"`
+	IPAddress      string                            `json:"ip_address,omitempty" validate:"omitempty,ip"`
+	FloatingIP     *CreateNewInterfaceFloatingIPOpts `json:"floating_ip,omitempty" validate:"omitempty,dive"`
+	SecurityGroups []gcorecloud.ItemID               `json:"security_groups,omitempty" validate:"omitempty,dive"`
+}
+
+// Validate
+func (opts InterfaceOpts) Validate() error {
+	return gcorecloud.ValidateStruct(opts)
+}
+
+// CreateOpts represents options used to create an instance.
+type CreateOpts struct {
+	Flavor          string              `json:"flavor" required:"true" validate:"required"`
+	Names           []string            `json:"names,omitempty" validate:"required_without=NameTemplates"`
+	NameTemplates   []string            `json:"name_templates,omitempty" validate:"required_without=Names"`
+	Volumes         []CreateVolumeOpts  `json:"volumes" required:"true" validate:"required,dive"`
+	Interfaces      []InterfaceOpts     `json:"interfaces" required:"true" validate:"required,dive"`
+	SecurityGroups  []gcorecloud.ItemID `json:"security_groups,omitempty" validate:"omitempty,dive"`
+	Keypair         string              `json:"keypair_name,omitempty"`
+	Password        string              `json:"password,omitempty" validate:"omitempty,required_with=Username"`
+	Username        string              `json:"username,omitempty" validate:"omitempty,required_with=Password"`
+	UserData        string              `json:"user_data,omitempty" validate:"omitempty,base64"`
+	UserDataBuilder *userdata.Builder   `json:"-" validate:"-"`
+	Metadata        map[string]string   `json:"metadata,omitempty" validate:"omitempty"`
+	Configuration   map[string]string   `json:"configuration,omitempty" validate:"omitempty"`
+	AllowAppPorts   bool                `json:"allow_app_ports,omitempty"`
+	ServerGroupID   string              `json:"servergroup_id,omitempty" validate:"omitempty,uuid4"`
+}
+
+// Validate
+func (opts CreateOpts) Validate() error {
+	if opts.UserData != "" && opts.UserDataBuilder != nil {
+		return fmt.Errorf("UserData and UserDataBuilder are mutually exclusive")
+	}
+	return gcorecloud.ValidateStruct(opts)
+}
+
+// ToInstanceCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToInstanceCreateMap() (map[string]interface{}, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	mp, err := gcorecloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	if opts.UserDataBuilder != nil {
+		if mp["user_data"], err = opts.UserDataBuilder.Build(); err != nil {
+			return nil, err
+		}
+	}
+	return mp, nil
+}
+
+// ActionOptsBuilder allows extensions to add additional parameters to the Action request.
+type ActionOptsBuilder interface {
+	ToInstanceActionMap() (map[string]interface{}, error)
+}
+
+// ActionOpts represents options of an instance action.
+type ActionOpts struct {
+	Action typesV2.InstanceAction `json:"action" required:"true" validate:"required,enum"`
+}
+
+// Validate
+func (opts ActionOpts) Validate() error {
+	return gcorecloud.ValidateStruct(opts)
+}
+
+// ToInstanceActionMap builds a request body from ActionOpts.
+func (opts ActionOpts) ToInstanceActionMap() (map[string]interface{}, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return gcorecloud.BuildRequestBody(opts, "")
+}
+
+// List retrieves list of instances.
+func List(client *gcorecloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToInstanceListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return InstancePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListAll is a convenience function that returns all instances.
+func ListAll(client *gcorecloud.ServiceClient, opts ListOptsBuilder) ([]Instance, error) {
+	pages, err := List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractInstances(pages)
+}
+
+// Get retrieves a specific instance based on its unique ID.
+func Get(client *gcorecloud.ServiceClient, id string) (r GetResult) {
+	url := getURL(client, id)
+	_, r.Err = client.Get(url, &r.Body, nil) // nolint
+	return
+}
+
+// Create creates an instance.
+func Create(client *gcorecloud.ServiceClient, opts CreateOptsBuilder) (r tasks.Result) {
+	b, err := opts.ToInstanceCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, nil) // nolint
+	return
+}
+
+// Delete deletes an instance together with the attached resources selected by the options.
+func Delete(client *gcorecloud.ServiceClient, instanceID string, opts DeleteOptsBuilder) (r tasks.Result) {
+	url := deleteURL(client, instanceID)
+	if opts != nil {
+		query, err := opts.ToInstanceDeleteQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	_, r.Err = client.DeleteWithResponse(url, &r.Body, nil) // nolint
+	return
+}
+
+// Action runs an action on an instance.
+func Action(client *gcorecloud.ServiceClient, id string, opts ActionOptsBuilder) (r tasks.Result) {
+	b, err := opts.ToInstanceActionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, &r.Body, &gcorecloud.RequestOpts{ // nolint
+		OkCodes: []int{http.StatusOK, http.StatusCreated, http.StatusAccepted},
+	})
+	return
+}
+
+// Start instance.
+func Start(client *gcorecloud.ServiceClient, id string) (r tasks.Result) {
+	return Action(client, id, ActionOpts{Action: typesV2.InstanceActionStart})
+}
+
+// Stop instance.
+func Stop(client *gcorecloud.ServiceClient, id string) (r tasks.Result) {
+	return Action(client, id, ActionOpts{Action: typesV2.InstanceActionStop})
+}
+
+// Reboot instance.
+func Reboot(client *gcorecloud.ServiceClient, id string) (r tasks.Result) {
+	return Action(client, id, ActionOpts{Action: typesV2.InstanceActionReboot})
+}
+
+// RebootHard instance.
+func RebootHard(client *gcorecloud.ServiceClient, id string) (r tasks.Result) {
+	return Action(client, id, ActionOpts{Action: typesV2.InstanceActionRebootHard})
+}
+
+// Suspend instance.
+func Suspend(client *gcorecloud.ServiceClient, id string) (r tasks.Result) {
+	return Action(client, id, ActionOpts{Action: typesV2.InstanceActionSuspend})
+}
+
+// Resume instance.
+func Resume(client *gcorecloud.ServiceClient, id string) (r tasks.Result) {
+	return Action(client, id, ActionOpts{Action: typesV2.InstanceActionResume})
+}
+
+// MetadataList retrieves the metadata of an instance.
+func MetadataList(client *gcorecloud.ServiceClient, id string) pagination.Pager {
+	url := metadataURL(client, id)
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return metadata.MetadataPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// MetadataListAll is a convenience function that returns all instance metadata.
+func MetadataListAll(client *gcorecloud.ServiceClient, id string) ([]metadata.Metadata, error) {
+	pages, err := MetadataList(client, id).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return metadata.ExtractMetadata(pages)
+}
+
+// MetadataGet gets defined metadata key for an instance.
+func MetadataGet(client *gcorecloud.ServiceClient, id string, key string) (r metadata.MetadataResult) {
+	url := metadataItemURL(client, id, key)
+	_, r.Err = client.Get(url, &r.Body, nil) // nolint
+	return
+}
+
+// MetadataCreateOrUpdate creates or updates the metadata keys of an instance.
+func MetadataCreateOrUpdate(client *gcorecloud.ServiceClient, id string, opts map[string]string) (r tasks.Result) {
+	_, r.Err = client.Post(metadataURL(client, id), opts, &r.Body, &gcorecloud.RequestOpts{ // nolint
+		OkCodes: []int{http.StatusOK, http.StatusCreated, http.StatusAccepted},
+	})
+	return
+}
+
+// MetadataReplace replaces the metadata of an instance.
+func MetadataReplace(client *gcorecloud.ServiceClient, id string, opts map[string]string) (r tasks.Result) {
+	_, r.Err = client.Put(metadataURL(client, id), opts, &r.Body, &gcorecloud.RequestOpts{ // nolint
+		OkCodes: []int{http.StatusOK, http.StatusAccepted},
+	})
+	return
+}
+
+// MetadataDelete deletes defined metadata key for an instance.
+func MetadataDelete(client *gcorecloud.ServiceClient, id string, key string) (r tasks.Result) {
+	_, r.Err = client.DeleteWithResponse(metadataItemURL(client, id, key), &r.Body, nil) // nolint
+	return
+}
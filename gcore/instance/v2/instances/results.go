@@ -0,0 +1,158 @@
+package instances
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/flavor/v1/flavors"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+const DefaultAvailabilityZone = "nova"
+
+type commonResult struct {
+	gcorecloud.Result
+}
+
+// Extract is a function that accepts a result and extracts an instance resource.
+func (r commonResult) Extract() (*Instance, error) {
+	var s Instance
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoStructPtr(v, "")
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as an Instance.
+type GetResult struct {
+	commonResult
+}
+
+type InstanceVolume struct {
+	ID                  string `json:"id"`
+	DeleteOnTermination bool   `json:"delete_on_termination"`
+}
+
+type InstanceAddress struct {
+	Type          types.AddressType `json:"type"`
+	Address       net.IP            `json:"addr"`
+	SubnetID      *string           `json:"subnet_id,omitempty"`
+	SubnetName    *string           `json:"subnet_name,omitempty"`
+	InterfaceName *string           `json:"interface_name,omitempty"`
+}
+
+// InstanceIsolation describes why an instance was isolated.
+type InstanceIsolation struct {
+	Reason *string `json:"reason"`
+}
+
+// Instance represents an instance structure.
+type Instance struct {
+	ID                string                       `json:"instance_id"`
+	Name              string                       `json:"instance_name"`
+	Description       string                       `json:"instance_description"`
+	CreatedAt         gcorecloud.JSONRFC3339ZZ     `json:"instance_created"`
+	Status            string                       `json:"status"`
+	VMState           string                       `json:"vm_state"`
+	TaskState         *string                      `json:"task_state"`
+	Flavor            flavors.Flavor               `json:"flavor"`
+	Metadata          map[string]interface{}       `json:"metadata"`
+	Volumes           []InstanceVolume             `json:"volumes"`
+	Addresses         map[string][]InstanceAddress `json:"addresses"`
+	SecurityGroups    []gcorecloud.ItemName        `json:"security_groups"`
+	KeypairName       *string                      `json:"keypair_name"`
+	InstanceIsolation *InstanceIsolation           `json:"instance_isolation"`
+	CreatorTaskID     *string                      `json:"creator_task_id"`
+	TaskID            *string                      `json:"task_id"`
+	ProjectID         int                          `json:"project_id"`
+	RegionID          int                          `json:"region_id"`
+	Region            string                       `json:"region"`
+	AvailabilityZone  string                       `json:"availability_zone"`
+}
+
+// UnmarshalJSON - implements Unmarshaler interface
+func (i *Instance) UnmarshalJSON(data []byte) error {
+	i.AvailabilityZone = DefaultAvailabilityZone
+	type Alias Instance
+	tmp := (*Alias)(i)
+	return json.Unmarshal(data, &tmp)
+}
+
+// InstancePage is the page returned by a pager when traversing over a
+// collection of instances.
+type InstancePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of instances has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r InstancePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gcorecloud.Link `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gcorecloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether an InstancePage struct is empty.
+func (r InstancePage) IsEmpty() (bool, error) {
+	is, err := ExtractInstances(r)
+	return len(is) == 0, err
+}
+
+// ExtractInstances accepts a Page struct, specifically an InstancePage struct,
+// and extracts the elements into a slice of instance structs. In other words,
+// a generic collection is mapped into a relevant slice.
+func ExtractInstances(r pagination.Page) ([]Instance, error) {
+	var s []Instance
+	err := ExtractInstancesInto(r, &s)
+	return s, err
+}
+
+func ExtractInstancesInto(r pagination.Page, v interface{}) error {
+	return r.(InstancePage).Result.ExtractIntoSlicePtr(v, "results")
+}
+
+type InstanceTaskResult struct {
+	Instances   []string `json:"instances"`
+	Volumes     []string `json:"volumes"`
+	FloatingIPs []string `json:"floating_ips"`
+	Ports       []string `json:"ports"`
+}
+
+// ExtractInstanceIDFromTask returns the ID of the first instance created by the task.
+func ExtractInstanceIDFromTask(task *tasks.Task) (string, error) {
+	var result InstanceTaskResult
+	err := gcorecloud.NativeMapToStruct(task.CreatedResources, &result)
+	if err != nil {
+		return "", fmt.Errorf("cannot decode instance information in task structure: %w", err)
+	}
+	if len(result.Instances) == 0 {
+		return "", fmt.Errorf("cannot decode instance information in task structure: no instances")
+	}
+	return result.Instances[0], nil
+}
+
+// ExtractInstanceIDsFromTask returns the IDs of all instances created by the task.
+func ExtractInstanceIDsFromTask(task *tasks.Task) ([]string, error) {
+	var result InstanceTaskResult
+	err := gcorecloud.NativeMapToStruct(task.CreatedResources, &result)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode instance information in task structure: %w", err)
+	}
+	if len(result.Instances) == 0 {
+		return nil, fmt.Errorf("cannot decode instance information in task structure: no instances")
+	}
+	return result.Instances, nil
+}
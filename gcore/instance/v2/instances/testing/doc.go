@@ -0,0 +1,2 @@
+// instances unit tests
+package testing
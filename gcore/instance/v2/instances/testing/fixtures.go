@@ -0,0 +1,291 @@
+package testing
+
+import (
+	"net"
+	"time"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/flavor/v1/flavors"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v2/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/utils/metadata"
+)
+
+const ListResponse = `
+{
+  "count": 1,
+  "results": [
+    {
+      "task_state": null,
+      "instance_description": "Testing",
+      "instance_name": "Testing",
+      "status": "ACTIVE",
+      "instance_created": "2019-07-11T06:58:48Z",
+      "vm_state": "active",
+      "volumes": [
+        {
+          "id": "28bfe198-a003-4283-8dca-ab5da4a71b62",
+          "delete_on_termination": false
+        }
+      ],
+      "security_groups": [
+        {
+          "name": "default"
+        }
+      ],
+      "instance_id": "a7e7e8d6-0bf7-4ac9-8170-831b47ee2ba9",
+      "task_id": "f28a4982-9be1-4e50-84e7-6d1a6d3f8a02",
+      "creator_task_id": "d1e1500b-e2be-40aa-9a4b-cc493fa1af30",
+      "keypair_name": "keypair",
+      "instance_isolation": null,
+      "addresses": {
+        "net1": [
+          {
+            "type": "fixed",
+            "addr": "10.0.0.17",
+            "interface_name": "eth0"
+          },
+          {
+            "type": "floating",
+            "addr": "92.38.157.215"
+          }
+        ]
+      },
+      "metadata": {
+        "os_distro": "centos",
+        "task_id": "d1e1500b-e2be-40aa-9a4b-cc493fa1af30"
+      },
+      "flavor": {
+        "flavor_name": "g1s-shared-1-0.5",
+        "disk": 0,
+        "flavor_id": "g1s-shared-1-0.5",
+        "vcpus": 1,
+        "ram": 512
+      },
+      "project_id": 1,
+      "region_id": 1,
+      "region": "RegionOne"
+    }
+  ]
+}
+`
+
+const GetResponse = `
+{
+  "task_state": null,
+  "instance_description": "Testing",
+  "instance_name": "Testing",
+  "status": "ACTIVE",
+  "instance_created": "2019-07-11T06:58:48Z",
+  "vm_state": "active",
+  "volumes": [
+    {
+      "id": "28bfe198-a003-4283-8dca-ab5da4a71b62",
+      "delete_on_termination": false
+    }
+  ],
+  "security_groups": [
+    {
+      "name": "default"
+    }
+  ],
+  "instance_id": "a7e7e8d6-0bf7-4ac9-8170-831b47ee2ba9",
+  "task_id": "f28a4982-9be1-4e50-84e7-6d1a6d3f8a02",
+  "creator_task_id": "d1e1500b-e2be-40aa-9a4b-cc493fa1af30",
+  "keypair_name": "keypair",
+  "instance_isolation": null,
+  "addresses": {
+    "net1": [
+      {
+        "type": "fixed",
+        "addr": "10.0.0.17",
+        "interface_name": "eth0"
+      },
+      {
+        "type": "floating",
+        "addr": "92.38.157.215"
+      }
+    ]
+  },
+  "metadata": {
+    "os_distro": "centos",
+    "task_id": "d1e1500b-e2be-40aa-9a4b-cc493fa1af30"
+  },
+  "flavor": {
+    "flavor_name": "g1s-shared-1-0.5",
+    "disk": 0,
+    "flavor_id": "g1s-shared-1-0.5",
+    "vcpus": 1,
+    "ram": 512
+  },
+  "project_id": 1,
+  "region_id": 1,
+  "region": "RegionOne"
+}
+`
+
+const CreateRequest = `
+{
+  "flavor": "g1-standard-1-2",
+  "names": ["name"],
+  "keypair_name": "keypair",
+  "password": "password",
+  "username": "username",
+  "metadata": {
+    "key": "value"
+  },
+  "security_groups": [
+    {
+      "id": "2bf3a5d7-9072-40aa-8ac0-a64e39427a2c"
+    }
+  ],
+  "interfaces": [
+    {
+      "type": "subnet",
+      "interface_name": "eth0",
+      "network_id": "2bf3a5d7-9072-40aa-8ac0-a64e39427a2c",
+      "subnet_id": "2bf3a5d7-9072-40aa-8ac0-a64e39427a2c",
+      "floating_ip": {
+        "source": "new"
+      },
+      "security_groups": [
+        {
+          "id": "2bf3a5d7-9072-40aa-8ac0-a64e39427a2c"
+        }
+      ]
+    },
+    {
+      "type": "external",
+      "ip_family": "dual"
+    }
+  ],
+  "volumes": [
+    {
+      "boot_index": 0,
+      "name": "name",
+      "size": 10,
+      "source": "image",
+      "image_id": "f01fd9a0-9548-48ba-82dc-a8c8b2d6f2f1",
+      "type_name": "ssd_hiiops",
+      "delete_on_termination": true,
+      "metadata": {
+        "meta1": "value1"
+      }
+    }
+  ]
+}
+`
+
+const ActionRequest = `
+{
+  "action": "reboot_hard"
+}
+`
+
+const TasksResponse = `
+{
+  "tasks": [
+    "50f53a35-42ed-40c4-82b2-5a37fb3e00bc"
+  ]
+}
+`
+
+const MetadataListResponse = `
+{
+  "count": 2,
+  "results": [
+    {
+      "key": "cost-center",
+      "value": "Atlanta",
+      "read_only": false
+    },
+    {
+      "key": "data-center",
+      "value": "A",
+      "read_only": false
+    }
+  ]
+}
+`
+
+const MetadataResponse = `
+{
+  "key": "cost-center",
+  "value": "Atlanta",
+  "read_only": false
+}
+`
+
+var (
+	ip1                 = net.ParseIP("10.0.0.17")
+	ip2                 = net.ParseIP("92.38.157.215")
+	tm, _               = time.Parse(gcorecloud.RFC3339ZZ, "2019-07-11T06:58:48Z")
+	createdTime         = gcorecloud.JSONRFC3339ZZ{Time: tm}
+	instanceID          = "a7e7e8d6-0bf7-4ac9-8170-831b47ee2ba9"
+	instanceName        = "Testing"
+	instanceDescription = "Testing"
+	taskID              = "f28a4982-9be1-4e50-84e7-6d1a6d3f8a02"
+	creatorTaskID       = "d1e1500b-e2be-40aa-9a4b-cc493fa1af30"
+	keypairName         = "keypair"
+	interfaceName       = "eth0"
+
+	Instance1 = instances.Instance{
+		ID:               instanceID,
+		Name:             instanceName,
+		Description:      instanceDescription,
+		CreatedAt:        createdTime,
+		Status:           "ACTIVE",
+		VMState:          "active",
+		TaskState:        nil,
+		AvailabilityZone: instances.DefaultAvailabilityZone,
+		Flavor: flavors.Flavor{
+			FlavorID:   "g1s-shared-1-0.5",
+			FlavorName: "g1s-shared-1-0.5",
+			RAM:        512,
+			VCPUS:      1,
+		},
+		Metadata: map[string]interface{}{
+			"os_distro": "centos",
+			"task_id":   "d1e1500b-e2be-40aa-9a4b-cc493fa1af30",
+		},
+		Volumes: []instances.InstanceVolume{{
+			ID:                  "28bfe198-a003-4283-8dca-ab5da4a71b62",
+			DeleteOnTermination: false,
+		}},
+		Addresses: map[string][]instances.InstanceAddress{
+			"net1": {{
+				Type:          types.AddressTypeFixed,
+				Address:       ip1,
+				InterfaceName: &interfaceName,
+			}, {
+				Type:    types.AddressTypeFloating,
+				Address: ip2,
+			}},
+		},
+		SecurityGroups: []gcorecloud.ItemName{{
+			Name: "default",
+		}},
+		KeypairName:   &keypairName,
+		CreatorTaskID: &creatorTaskID,
+		TaskID:        &taskID,
+		ProjectID:     1,
+		RegionID:      1,
+		Region:        "RegionOne",
+	}
+	ExpectedInstancesSlice = []instances.Instance{Instance1}
+	Tasks1                 = tasks.TaskResults{
+		Tasks: []tasks.TaskID{"50f53a35-42ed-40c4-82b2-5a37fb3e00bc"},
+	}
+	Metadata1 = metadata.Metadata{
+		Key:      "cost-center",
+		Value:    "Atlanta",
+		ReadOnly: false,
+	}
+	Metadata2 = metadata.Metadata{
+		Key:      "data-center",
+		Value:    "A",
+		ReadOnly: false,
+	}
+	ExpectedMetadataList = []metadata.Metadata{Metadata1, Metadata2}
+)
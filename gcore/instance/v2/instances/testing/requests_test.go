@@ -0,0 +1,337 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v2/instances"
+	typesV2 "github.com/G-Core/gcorelabscloud-go/gcore/instance/v2/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/volume/v1/volumes"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+	th "github.com/G-Core/gcorelabscloud-go/testhelper"
+	fake "github.com/G-Core/gcorelabscloud-go/testhelper/client"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func prepareListTestURL() string {
+	return fmt.Sprintf("/v2/instances/%d/%d", fake.ProjectID, fake.RegionID)
+}
+
+func prepareGetTestURL(id string) string {
+	return fmt.Sprintf("/v2/instances/%d/%d/%s", fake.ProjectID, fake.RegionID, id)
+}
+
+func prepareActionTestURL(id string, action string) string {
+	return fmt.Sprintf("/v2/instances/%d/%d/%s/%s", fake.ProjectID, fake.RegionID, id, action)
+}
+
+func writeResponse(w http.ResponseWriter, status int, body string) {
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, err := fmt.Fprint(w, body)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(prepareListTestURL(), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Authorization", fmt.Sprintf("Bearer %s", fake.AccessToken))
+		require.Equal(t, "g1-", r.URL.Query().Get("flavor_prefix"))
+		require.Equal(t, "ACTIVE", r.URL.Query().Get("status"))
+		require.Equal(t, "true", r.URL.Query().Get("include_k8s"))
+		require.Equal(t, `{"env":"prod"}`, r.URL.Query().Get("metadata_kv"))
+		writeResponse(w, http.StatusOK, ListResponse)
+	})
+
+	client := fake.ServiceTokenClient("instances", "v2")
+	count := 0
+
+	opts := instances.ListOpts{
+		FlavorPrefix: "g1-",
+		Status:       "ACTIVE",
+		IncludeK8S:   true,
+		MetadataKV:   map[string]string{"env": "prod"},
+	}
+
+	err := instances.List(client, opts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := instances.ExtractInstances(page)
+		require.NoError(t, err)
+		require.Equal(t, Instance1, actual[0])
+		require.Equal(t, ExpectedInstancesSlice, actual)
+		return true, nil
+	})
+
+	th.AssertNoErr(t, err)
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestListAll(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(prepareListTestURL(), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Authorization", fmt.Sprintf("Bearer %s", fake.AccessToken))
+		writeResponse(w, http.StatusOK, ListResponse)
+	})
+
+	client := fake.ServiceTokenClient("instances", "v2")
+
+	actual, err := instances.ListAll(client, nil)
+	require.NoError(t, err)
+	require.Equal(t, ExpectedInstancesSlice, actual)
+}
+
+func TestListOptsValidation(t *testing.T) {
+	_, err := instances.ListOpts{UUID: "not-uuid"}.ToInstanceListQuery()
+	require.Error(t, err)
+	_, err = instances.ListOpts{Limit: -1}.ToInstanceListQuery()
+	require.Error(t, err)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(prepareGetTestURL(Instance1.ID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Authorization", fmt.Sprintf("Bearer %s", fake.AccessToken))
+		writeResponse(w, http.StatusOK, GetResponse)
+	})
+
+	client := fake.ServiceTokenClient("instances", "v2")
+
+	ct, err := instances.Get(client, Instance1.ID).Extract()
+	require.NoError(t, err)
+	require.Equal(t, Instance1, *ct)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(prepareListTestURL(), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "Authorization", fmt.Sprintf("Bearer %s", fake.AccessToken))
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+		writeResponse(w, http.StatusCreated, TasksResponse)
+	})
+
+	securityGroups := []gcorecloud.ItemID{{ID: "2bf3a5d7-9072-40aa-8ac0-a64e39427a2c"}}
+	options := instances.CreateOpts{
+		Flavor: "g1-standard-1-2",
+		Names:  []string{"name"},
+		Volumes: []instances.CreateVolumeOpts{{
+			Source:              types.Image,
+			BootIndex:           0,
+			Size:                10,
+			TypeName:            volumes.SsdHiIops,
+			Name:                "name",
+			ImageID:             "f01fd9a0-9548-48ba-82dc-a8c8b2d6f2f1",
+			DeleteOnTermination: true,
+			Metadata:            map[string]string{"meta1": "value1"},
+		}},
+		Interfaces: []instances.InterfaceOpts{{
+			Type:           types.SubnetInterfaceType,
+			InterfaceName:  "eth0",
+			NetworkID:      "2bf3a5d7-9072-40aa-8ac0-a64e39427a2c",
+			SubnetID:       "2bf3a5d7-9072-40aa-8ac0-a64e39427a2c",
+			FloatingIP:     &instances.CreateNewInterfaceFloatingIPOpts{Source: types.NewFloatingIP},
+			SecurityGroups: securityGroups,
+		}, {
+			Type:     types.ExternalInterfaceType,
+			IPFamily: typesV2.DualStackIPFamilyType,
+		}},
+		SecurityGroups: securityGroups,
+		Keypair:        "keypair",
+		Password:       "password",
+		Username:       "username",
+		Metadata:       map[string]string{"key": "value"},
+	}
+
+	err := options.Validate()
+	require.NoError(t, err)
+
+	client := fake.ServiceTokenClient("instances", "v2")
+	tasks, err := instances.Create(client, options).Extract()
+	require.NoError(t, err)
+	require.Equal(t, Tasks1, *tasks)
+}
+
+func TestCreateOptsValidation(t *testing.T) {
+	options := instances.CreateOpts{
+		Flavor: "g1-standard-1-2",
+		Names:  []string{"name"},
+		Volumes: []instances.CreateVolumeOpts{{
+			Source: types.Image,
+			Size:   10,
+		}},
+		Interfaces: []instances.InterfaceOpts{{Type: types.SubnetInterfaceType}},
+	}
+	require.Error(t, options.Validate())
+
+	options.Volumes[0].ImageID = "f01fd9a0-9548-48ba-82dc-a8c8b2d6f2f1"
+	options.Interfaces[0] = instances.InterfaceOpts{Type: types.ExternalInterfaceType, IPFamily: "ipv5"}
+	require.Error(t, options.Validate())
+
+	options.Interfaces[0].IPFamily = typesV2.IPv6IPFamilyType
+	require.NoError(t, options.Validate())
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(prepareGetTestURL(instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "Authorization", fmt.Sprintf("Bearer %s", fake.AccessToken))
+		th.TestHeader(t, r, "Accept", "application/json")
+		require.Equal(t, "true", r.URL.Query().Get("delete_floatings"))
+		require.Equal(t, "28bfe198-a003-4283-8dca-ab5da4a71b62", r.URL.Query().Get("volumes"))
+		require.Equal(t, "f32fe70c-f2ce-492e-858a-621bdc234885", r.URL.Query().Get("reserved_fixed_ips"))
+		writeResponse(w, http.StatusOK, TasksResponse)
+	})
+
+	options := instances.DeleteOpts{
+		Volumes:          []string{"28bfe198-a003-4283-8dca-ab5da4a71b62"},
+		DeleteFloatings:  true,
+		ReservedFixedIPs: []string{"f32fe70c-f2ce-492e-858a-621bdc234885"},
+	}
+
+	err := options.Validate()
+	require.NoError(t, err)
+	client := fake.ServiceTokenClient("instances", "v2")
+	tasks, err := instances.Delete(client, instanceID, options).Extract()
+	require.NoError(t, err)
+	require.Equal(t, Tasks1, *tasks)
+}
+
+func TestDeleteOptsValidation(t *testing.T) {
+	options := instances.DeleteOpts{
+		DeleteFloatings: true,
+		FloatingIPs:     []string{"f32fe70c-f2ce-492e-858a-621bdc234885"},
+	}
+	require.Error(t, options.Validate())
+}
+
+func TestAction(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(prepareActionTestURL(instanceID, "action"), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "Authorization", fmt.Sprintf("Bearer %s", fake.AccessToken))
+		th.TestJSONRequest(t, r, ActionRequest)
+		writeResponse(w, http.StatusOK, TasksResponse)
+	})
+
+	client := fake.ServiceTokenClient("instances", "v2")
+	tasks, err := instances.RebootHard(client, instanceID).Extract()
+	require.NoError(t, err)
+	require.Equal(t, Tasks1, *tasks)
+
+	err = instances.Action(client, instanceID, instances.ActionOpts{Action: "powercycle"}).Err
+	require.Error(t, err)
+}
+
+func TestMetadataListAll(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(prepareActionTestURL(instanceID, "metadata"), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Authorization", fmt.Sprintf("Bearer %s", fake.AccessToken))
+		writeResponse(w, http.StatusOK, MetadataListResponse)
+	})
+
+	client := fake.ServiceTokenClient("instances", "v2")
+	actual, err := instances.MetadataListAll(client, instanceID)
+	require.NoError(t, err)
+	require.Equal(t, ExpectedMetadataList, actual)
+}
+
+func TestMetadataGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(prepareActionTestURL(instanceID, "metadata_item"), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Authorization", fmt.Sprintf("Bearer %s", fake.AccessToken))
+		require.Equal(t, Metadata1.Key, r.URL.Query().Get("key"))
+		writeResponse(w, http.StatusOK, MetadataResponse)
+	})
+
+	client := fake.ServiceTokenClient("instances", "v2")
+	actual, err := instances.MetadataGet(client, instanceID, Metadata1.Key).Extract()
+	require.NoError(t, err)
+	require.Equal(t, &Metadata1, actual)
+}
+
+func TestMetadataCreateOrUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(prepareActionTestURL(instanceID, "metadata"), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "Authorization", fmt.Sprintf("Bearer %s", fake.AccessToken))
+		th.TestJSONRequest(t, r, `{"test1": "test1", "test2": "test2"}`)
+		writeResponse(w, http.StatusOK, TasksResponse)
+	})
+
+	client := fake.ServiceTokenClient("instances", "v2")
+	tasks, err := instances.MetadataCreateOrUpdate(client, instanceID, map[string]string{
+		"test1": "test1",
+		"test2": "test2",
+	}).Extract()
+	require.NoError(t, err)
+	require.Equal(t, Tasks1, *tasks)
+}
+
+func TestMetadataReplace(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(prepareActionTestURL(instanceID, "metadata"), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "Authorization", fmt.Sprintf("Bearer %s", fake.AccessToken))
+		th.TestJSONRequest(t, r, `{"test1": "test1"}`)
+		writeResponse(w, http.StatusOK, TasksResponse)
+	})
+
+	client := fake.ServiceTokenClient("instances", "v2")
+	tasks, err := instances.MetadataReplace(client, instanceID, map[string]string{"test1": "test1"}).Extract()
+	require.NoError(t, err)
+	require.Equal(t, Tasks1, *tasks)
+}
+
+func TestMetadataDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(prepareActionTestURL(instanceID, "metadata_item"), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "Authorization", fmt.Sprintf("Bearer %s", fake.AccessToken))
+		require.Equal(t, Metadata1.Key, r.URL.Query().Get("key"))
+		writeResponse(w, http.StatusOK, TasksResponse)
+	})
+
+	client := fake.ServiceTokenClient("instances", "v2")
+	tasks, err := instances.MetadataDelete(client, instanceID, Metadata1.Key).Extract()
+	require.NoError(t, err)
+	require.Equal(t, Tasks1, *tasks)
+}
@@ -0,0 +1,46 @@
+package instances
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/utils/metadata"
+)
+
+func resourceURL(c *gcorecloud.ServiceClient, id string) string {
+	return c.ServiceURL(id)
+}
+
+func rootURL(c *gcorecloud.ServiceClient) string {
+	return c.ServiceURL()
+}
+
+func resourceActionURL(c *gcorecloud.ServiceClient, id string, action string) string {
+	return c.ServiceURL(id, action)
+}
+
+func getURL(c *gcorecloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func deleteURL(c *gcorecloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func listURL(c *gcorecloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func createURL(c *gcorecloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func actionURL(c *gcorecloud.ServiceClient, id string) string {
+	return resourceActionURL(c, id, "action")
+}
+
+func metadataURL(c *gcorecloud.ServiceClient, id string) string {
+	return metadata.MetadataURL(c, id)
+}
+
+func metadataItemURL(c *gcorecloud.ServiceClient, id string, key string) string {
+	return metadata.MetadataItemURL(c, id, key)
+}
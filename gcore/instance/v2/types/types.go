@@ -0,0 +1,139 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type IPFamilyType string
+type InstanceAction string
+
+const (
+	IPv4IPFamilyType         IPFamilyType   = "ipv4"
+	IPv6IPFamilyType         IPFamilyType   = "ipv6"
+	DualStackIPFamilyType    IPFamilyType   = "dual"
+	InstanceActionStart      InstanceAction = "start"
+	InstanceActionStop       InstanceAction = "stop"
+	InstanceActionReboot     InstanceAction = "reboot"
+	InstanceActionRebootHard InstanceAction = "reboot_hard"
+	InstanceActionSuspend    InstanceAction = "suspend"
+	InstanceActionResume     InstanceAction = "resume"
+)
+
+func (f IPFamilyType) IsValid() error {
+	switch f {
+	case IPv4IPFamilyType, IPv6IPFamilyType, DualStackIPFamilyType:
+		return nil
+	}
+	return fmt.Errorf("invalid IPFamilyType type: %v", f)
+}
+
+func (f IPFamilyType) ValidOrNil() (*IPFamilyType, error) {
+	if f.String() == "" {
+		return nil, nil
+	}
+	err := f.IsValid()
+	if err != nil {
+		return &f, err
+	}
+	return &f, nil
+}
+
+func (f IPFamilyType) String() string {
+	return string(f)
+}
+
+func (f IPFamilyType) List() []IPFamilyType {
+	return []IPFamilyType{IPv4IPFamilyType, IPv6IPFamilyType, DualStackIPFamilyType}
+}
+
+func (f IPFamilyType) StringList() []string {
+	var s []string
+	for _, v := range f.List() {
+		s = append(s, v.String())
+	}
+	return s
+}
+
+// UnmarshalJSON - implements Unmarshaler interface for IPFamilyType
+func (f *IPFamilyType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v := IPFamilyType(s)
+	err := v.IsValid()
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// MarshalJSON - implements Marshaler interface for IPFamilyType
+func (f *IPFamilyType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.String())
+}
+
+func (a InstanceAction) IsValid() error {
+	switch a {
+	case InstanceActionStart, InstanceActionStop, InstanceActionReboot, InstanceActionRebootHard,
+		InstanceActionSuspend, InstanceActionResume:
+		return nil
+	}
+	return fmt.Errorf("invalid InstanceAction type: %v", a)
+}
+
+func (a InstanceAction) ValidOrNil() (*InstanceAction, error) {
+	if a.String() == "" {
+		return nil, nil
+	}
+	err := a.IsValid()
+	if err != nil {
+		return &a, err
+	}
+	return &a, nil
+}
+
+func (a InstanceAction) String() string {
+	return string(a)
+}
+
+func (a InstanceAction) List() []InstanceAction {
+	return []InstanceAction{
+		InstanceActionStart,
+		InstanceActionStop,
+		InstanceActionReboot,
+		InstanceActionRebootHard,
+		InstanceActionSuspend,
+		InstanceActionResume,
+	}
+}
+
+func (a InstanceAction) StringList() []string {
+	var s []string
+	for _, v := range a.List() {
+		s = append(s, v.String())
+	}
+	return s
+}
+
+// UnmarshalJSON - implements Unmarshaler interface for InstanceAction
+func (a *InstanceAction) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v := InstanceAction(s)
+	err := v.IsValid()
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// MarshalJSON - implements Marshaler interface for InstanceAction
+func (a *InstanceAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
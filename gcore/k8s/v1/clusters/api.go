@@ -0,0 +1,111 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package clusters
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of clusters operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Certificate(clusterID string) ClusterCertificateCAResult
+	Create(opts CreateOptsBuilder) tasks.Result
+	Delete(clusterID string) tasks.Result
+	Get(id string) GetResult
+	GetConfig(clusterID string) ConfigResult
+	Instances(clusterID string) pagination.Pager
+	InstancesAll(clusterID string) ([]instances.Instance, error)
+	List(opts ListOptsBuilder) pagination.Pager
+	ListAll(opts ListOptsBuilder) ([]ClusterListWithPool, error)
+	Resize(clusterID string, poolID string, opts ResizeOptsBuilder) tasks.Result
+	SignCertificate(clusterID string, opts ClusterSignCertificateOptsBuilder) ClusterCertificateSignResult
+	Upgrade(clusterID string, opts UpgradeOptsBuilder) tasks.Result
+	Versions() pagination.Pager
+	VersionsAll() ([]string, error)
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Certificate calls the package level Certificate function with the service client.
+func (s *Service) Certificate(clusterID string) ClusterCertificateCAResult {
+	return Certificate(s.ServiceClient, clusterID)
+}
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(clusterID string) tasks.Result {
+	return Delete(s.ServiceClient, clusterID)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id string) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// GetConfig calls the package level GetConfig function with the service client.
+func (s *Service) GetConfig(clusterID string) ConfigResult {
+	return GetConfig(s.ServiceClient, clusterID)
+}
+
+// Instances calls the package level Instances function with the service client.
+func (s *Service) Instances(clusterID string) pagination.Pager {
+	return Instances(s.ServiceClient, clusterID)
+}
+
+// InstancesAll calls the package level InstancesAll function with the service client.
+func (s *Service) InstancesAll(clusterID string) ([]instances.Instance, error) {
+	return InstancesAll(s.ServiceClient, clusterID)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(opts ListOptsBuilder) ([]ClusterListWithPool, error) {
+	return ListAll(s.ServiceClient, opts)
+}
+
+// Resize calls the package level Resize function with the service client.
+func (s *Service) Resize(clusterID string, poolID string, opts ResizeOptsBuilder) tasks.Result {
+	return Resize(s.ServiceClient, clusterID, poolID, opts)
+}
+
+// SignCertificate calls the package level SignCertificate function with the service client.
+func (s *Service) SignCertificate(clusterID string, opts ClusterSignCertificateOptsBuilder) ClusterCertificateSignResult {
+	return SignCertificate(s.ServiceClient, clusterID, opts)
+}
+
+// Upgrade calls the package level Upgrade function with the service client.
+func (s *Service) Upgrade(clusterID string, opts UpgradeOptsBuilder) tasks.Result {
+	return Upgrade(s.ServiceClient, clusterID, opts)
+}
+
+// Versions calls the package level Versions function with the service client.
+func (s *Service) Versions() pagination.Pager {
+	return Versions(s.ServiceClient)
+}
+
+// VersionsAll calls the package level VersionsAll function with the service client.
+func (s *Service) VersionsAll() ([]string, error) {
+	return VersionsAll(s.ServiceClient)
+}
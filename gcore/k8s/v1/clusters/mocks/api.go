@@ -0,0 +1,195 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of clusters.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/k8s/v1/clusters"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of clusters.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CertificateFunc     func(clusterID string) clusters.ClusterCertificateCAResult
+	CreateFunc          func(opts clusters.CreateOptsBuilder) tasks.Result
+	DeleteFunc          func(clusterID string) tasks.Result
+	GetFunc             func(id string) clusters.GetResult
+	GetConfigFunc       func(clusterID string) clusters.ConfigResult
+	InstancesFunc       func(clusterID string) pagination.Pager
+	InstancesAllFunc    func(clusterID string) ([]instances.Instance, error)
+	ListFunc            func(opts clusters.ListOptsBuilder) pagination.Pager
+	ListAllFunc         func(opts clusters.ListOptsBuilder) ([]clusters.ClusterListWithPool, error)
+	ResizeFunc          func(clusterID string, poolID string, opts clusters.ResizeOptsBuilder) tasks.Result
+	SignCertificateFunc func(clusterID string, opts clusters.ClusterSignCertificateOptsBuilder) clusters.ClusterCertificateSignResult
+	UpgradeFunc         func(clusterID string, opts clusters.UpgradeOptsBuilder) tasks.Result
+	VersionsFunc        func() pagination.Pager
+	VersionsAllFunc     func() ([]string, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ clusters.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Certificate implements clusters.API.
+func (m *API) Certificate(clusterID string) clusters.ClusterCertificateCAResult {
+	m.record("Certificate", clusterID)
+	if m.CertificateFunc == nil {
+		panic("mocks: clusters.API.CertificateFunc is not set")
+	}
+	return m.CertificateFunc(clusterID)
+}
+
+// Create implements clusters.API.
+func (m *API) Create(opts clusters.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: clusters.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements clusters.API.
+func (m *API) Delete(clusterID string) tasks.Result {
+	m.record("Delete", clusterID)
+	if m.DeleteFunc == nil {
+		panic("mocks: clusters.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(clusterID)
+}
+
+// Get implements clusters.API.
+func (m *API) Get(id string) clusters.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: clusters.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// GetConfig implements clusters.API.
+func (m *API) GetConfig(clusterID string) clusters.ConfigResult {
+	m.record("GetConfig", clusterID)
+	if m.GetConfigFunc == nil {
+		panic("mocks: clusters.API.GetConfigFunc is not set")
+	}
+	return m.GetConfigFunc(clusterID)
+}
+
+// Instances implements clusters.API.
+func (m *API) Instances(clusterID string) pagination.Pager {
+	m.record("Instances", clusterID)
+	if m.InstancesFunc == nil {
+		panic("mocks: clusters.API.InstancesFunc is not set")
+	}
+	return m.InstancesFunc(clusterID)
+}
+
+// InstancesAll implements clusters.API.
+func (m *API) InstancesAll(clusterID string) ([]instances.Instance, error) {
+	m.record("InstancesAll", clusterID)
+	if m.InstancesAllFunc == nil {
+		panic("mocks: clusters.API.InstancesAllFunc is not set")
+	}
+	return m.InstancesAllFunc(clusterID)
+}
+
+// List implements clusters.API.
+func (m *API) List(opts clusters.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: clusters.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements clusters.API.
+func (m *API) ListAll(opts clusters.ListOptsBuilder) ([]clusters.ClusterListWithPool, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: clusters.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// Resize implements clusters.API.
+func (m *API) Resize(clusterID string, poolID string, opts clusters.ResizeOptsBuilder) tasks.Result {
+	m.record("Resize", clusterID, poolID, opts)
+	if m.ResizeFunc == nil {
+		panic("mocks: clusters.API.ResizeFunc is not set")
+	}
+	return m.ResizeFunc(clusterID, poolID, opts)
+}
+
+// SignCertificate implements clusters.API.
+func (m *API) SignCertificate(clusterID string, opts clusters.ClusterSignCertificateOptsBuilder) clusters.ClusterCertificateSignResult {
+	m.record("SignCertificate", clusterID, opts)
+	if m.SignCertificateFunc == nil {
+		panic("mocks: clusters.API.SignCertificateFunc is not set")
+	}
+	return m.SignCertificateFunc(clusterID, opts)
+}
+
+// Upgrade implements clusters.API.
+func (m *API) Upgrade(clusterID string, opts clusters.UpgradeOptsBuilder) tasks.Result {
+	m.record("Upgrade", clusterID, opts)
+	if m.UpgradeFunc == nil {
+		panic("mocks: clusters.API.UpgradeFunc is not set")
+	}
+	return m.UpgradeFunc(clusterID, opts)
+}
+
+// Versions implements clusters.API.
+func (m *API) Versions() pagination.Pager {
+	m.record("Versions")
+	if m.VersionsFunc == nil {
+		panic("mocks: clusters.API.VersionsFunc is not set")
+	}
+	return m.VersionsFunc()
+}
+
+// VersionsAll implements clusters.API.
+func (m *API) VersionsAll() ([]string, error) {
+	m.record("VersionsAll")
+	if m.VersionsAllFunc == nil {
+		panic("mocks: clusters.API.VersionsAllFunc is not set")
+	}
+	return m.VersionsAllFunc()
+}
@@ -0,0 +1,88 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package pools
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/volume/v1/volumes"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of pools operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Create(clusterID string, opts CreateOptsBuilder) tasks.Result
+	Delete(clusterID string, poolID string) tasks.Result
+	Get(clusterID string, id string) GetResult
+	Instances(clusterID string, id string) pagination.Pager
+	InstancesAll(clusterID string, id string) ([]instances.Instance, error)
+	List(clusterID string, opts ListOptsBuilder) pagination.Pager
+	ListAll(clusterID string, opts ListOptsBuilder) ([]ClusterListPool, error)
+	Update(clusterID string, poolID string, opts UpdateOptsBuilder) tasks.Result
+	Volumes(clusterID string, id string) pagination.Pager
+	VolumesAll(clusterID string, id string) ([]volumes.Volume, error)
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(clusterID string, opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, clusterID, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(clusterID string, poolID string) tasks.Result {
+	return Delete(s.ServiceClient, clusterID, poolID)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(clusterID string, id string) GetResult {
+	return Get(s.ServiceClient, clusterID, id)
+}
+
+// Instances calls the package level Instances function with the service client.
+func (s *Service) Instances(clusterID string, id string) pagination.Pager {
+	return Instances(s.ServiceClient, clusterID, id)
+}
+
+// InstancesAll calls the package level InstancesAll function with the service client.
+func (s *Service) InstancesAll(clusterID string, id string) ([]instances.Instance, error) {
+	return InstancesAll(s.ServiceClient, clusterID, id)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(clusterID string, opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, clusterID, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(clusterID string, opts ListOptsBuilder) ([]ClusterListPool, error) {
+	return ListAll(s.ServiceClient, clusterID, opts)
+}
+
+// Update calls the package level Update function with the service client.
+func (s *Service) Update(clusterID string, poolID string, opts UpdateOptsBuilder) tasks.Result {
+	return Update(s.ServiceClient, clusterID, poolID, opts)
+}
+
+// Volumes calls the package level Volumes function with the service client.
+func (s *Service) Volumes(clusterID string, id string) pagination.Pager {
+	return Volumes(s.ServiceClient, clusterID, id)
+}
+
+// VolumesAll calls the package level VolumesAll function with the service client.
+func (s *Service) VolumesAll(clusterID string, id string) ([]volumes.Volume, error) {
+	return VolumesAll(s.ServiceClient, clusterID, id)
+}
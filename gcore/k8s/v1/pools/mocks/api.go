@@ -0,0 +1,156 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of pools.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/k8s/v1/pools"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/volume/v1/volumes"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of pools.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc       func(clusterID string, opts pools.CreateOptsBuilder) tasks.Result
+	DeleteFunc       func(clusterID string, poolID string) tasks.Result
+	GetFunc          func(clusterID string, id string) pools.GetResult
+	InstancesFunc    func(clusterID string, id string) pagination.Pager
+	InstancesAllFunc func(clusterID string, id string) ([]instances.Instance, error)
+	ListFunc         func(clusterID string, opts pools.ListOptsBuilder) pagination.Pager
+	ListAllFunc      func(clusterID string, opts pools.ListOptsBuilder) ([]pools.ClusterListPool, error)
+	UpdateFunc       func(clusterID string, poolID string, opts pools.UpdateOptsBuilder) tasks.Result
+	VolumesFunc      func(clusterID string, id string) pagination.Pager
+	VolumesAllFunc   func(clusterID string, id string) ([]volumes.Volume, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ pools.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements pools.API.
+func (m *API) Create(clusterID string, opts pools.CreateOptsBuilder) tasks.Result {
+	m.record("Create", clusterID, opts)
+	if m.CreateFunc == nil {
+		panic("mocks: pools.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(clusterID, opts)
+}
+
+// Delete implements pools.API.
+func (m *API) Delete(clusterID string, poolID string) tasks.Result {
+	m.record("Delete", clusterID, poolID)
+	if m.DeleteFunc == nil {
+		panic("mocks: pools.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(clusterID, poolID)
+}
+
+// Get implements pools.API.
+func (m *API) Get(clusterID string, id string) pools.GetResult {
+	m.record("Get", clusterID, id)
+	if m.GetFunc == nil {
+		panic("mocks: pools.API.GetFunc is not set")
+	}
+	return m.GetFunc(clusterID, id)
+}
+
+// Instances implements pools.API.
+func (m *API) Instances(clusterID string, id string) pagination.Pager {
+	m.record("Instances", clusterID, id)
+	if m.InstancesFunc == nil {
+		panic("mocks: pools.API.InstancesFunc is not set")
+	}
+	return m.InstancesFunc(clusterID, id)
+}
+
+// InstancesAll implements pools.API.
+func (m *API) InstancesAll(clusterID string, id string) ([]instances.Instance, error) {
+	m.record("InstancesAll", clusterID, id)
+	if m.InstancesAllFunc == nil {
+		panic("mocks: pools.API.InstancesAllFunc is not set")
+	}
+	return m.InstancesAllFunc(clusterID, id)
+}
+
+// List implements pools.API.
+func (m *API) List(clusterID string, opts pools.ListOptsBuilder) pagination.Pager {
+	m.record("List", clusterID, opts)
+	if m.ListFunc == nil {
+		panic("mocks: pools.API.ListFunc is not set")
+	}
+	return m.ListFunc(clusterID, opts)
+}
+
+// ListAll implements pools.API.
+func (m *API) ListAll(clusterID string, opts pools.ListOptsBuilder) ([]pools.ClusterListPool, error) {
+	m.record("ListAll", clusterID, opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: pools.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(clusterID, opts)
+}
+
+// Update implements pools.API.
+func (m *API) Update(clusterID string, poolID string, opts pools.UpdateOptsBuilder) tasks.Result {
+	m.record("Update", clusterID, poolID, opts)
+	if m.UpdateFunc == nil {
+		panic("mocks: pools.API.UpdateFunc is not set")
+	}
+	return m.UpdateFunc(clusterID, poolID, opts)
+}
+
+// Volumes implements pools.API.
+func (m *API) Volumes(clusterID string, id string) pagination.Pager {
+	m.record("Volumes", clusterID, id)
+	if m.VolumesFunc == nil {
+		panic("mocks: pools.API.VolumesFunc is not set")
+	}
+	return m.VolumesFunc(clusterID, id)
+}
+
+// VolumesAll implements pools.API.
+func (m *API) VolumesAll(clusterID string, id string) ([]volumes.Volume, error) {
+	m.record("VolumesAll", clusterID, id)
+	if m.VolumesAllFunc == nil {
+		panic("mocks: pools.API.VolumesAllFunc is not set")
+	}
+	return m.VolumesAllFunc(clusterID, id)
+}
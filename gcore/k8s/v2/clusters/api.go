@@ -0,0 +1,105 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package clusters
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of clusters operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Create(opts CreateOptsBuilder) tasks.Result
+	Delete(clusterName string) tasks.Result
+	Get(clusterName string) GetResult
+	GetCertificate(clusterName string) CertificateResult
+	GetConfig(clusterName string) ConfigResult
+	List() pagination.Pager
+	ListAll() ([]Cluster, error)
+	ListAllIPPools() ([]gcorecloud.CIDR, error)
+	ListInstances(clusterID string) pagination.Pager
+	ListInstancesAll(clusterID string) ([]instances.Instance, error)
+	Upgrade(clusterID string, opts UpgradeOptsBuilder) tasks.Result
+	Versions() pagination.Pager
+	VersionsAll() ([]Version, error)
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(clusterName string) tasks.Result {
+	return Delete(s.ServiceClient, clusterName)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(clusterName string) GetResult {
+	return Get(s.ServiceClient, clusterName)
+}
+
+// GetCertificate calls the package level GetCertificate function with the service client.
+func (s *Service) GetCertificate(clusterName string) CertificateResult {
+	return GetCertificate(s.ServiceClient, clusterName)
+}
+
+// GetConfig calls the package level GetConfig function with the service client.
+func (s *Service) GetConfig(clusterName string) ConfigResult {
+	return GetConfig(s.ServiceClient, clusterName)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List() pagination.Pager {
+	return List(s.ServiceClient)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll() ([]Cluster, error) {
+	return ListAll(s.ServiceClient)
+}
+
+// ListAllIPPools calls the package level ListAllIPPools function with the service client.
+func (s *Service) ListAllIPPools() ([]gcorecloud.CIDR, error) {
+	return ListAllIPPools(s.ServiceClient)
+}
+
+// ListInstances calls the package level ListInstances function with the service client.
+func (s *Service) ListInstances(clusterID string) pagination.Pager {
+	return ListInstances(s.ServiceClient, clusterID)
+}
+
+// ListInstancesAll calls the package level ListInstancesAll function with the service client.
+func (s *Service) ListInstancesAll(clusterID string) ([]instances.Instance, error) {
+	return ListInstancesAll(s.ServiceClient, clusterID)
+}
+
+// Upgrade calls the package level Upgrade function with the service client.
+func (s *Service) Upgrade(clusterID string, opts UpgradeOptsBuilder) tasks.Result {
+	return Upgrade(s.ServiceClient, clusterID, opts)
+}
+
+// Versions calls the package level Versions function with the service client.
+func (s *Service) Versions() pagination.Pager {
+	return Versions(s.ServiceClient)
+}
+
+// VersionsAll calls the package level VersionsAll function with the service client.
+func (s *Service) VersionsAll() ([]Version, error) {
+	return VersionsAll(s.ServiceClient)
+}
@@ -0,0 +1,186 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of clusters.API.
+package mocks
+
+import (
+	"sync"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/k8s/v2/clusters"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of clusters.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc           func(opts clusters.CreateOptsBuilder) tasks.Result
+	DeleteFunc           func(clusterName string) tasks.Result
+	GetFunc              func(clusterName string) clusters.GetResult
+	GetCertificateFunc   func(clusterName string) clusters.CertificateResult
+	GetConfigFunc        func(clusterName string) clusters.ConfigResult
+	ListFunc             func() pagination.Pager
+	ListAllFunc          func() ([]clusters.Cluster, error)
+	ListAllIPPoolsFunc   func() ([]gcorecloud.CIDR, error)
+	ListInstancesFunc    func(clusterID string) pagination.Pager
+	ListInstancesAllFunc func(clusterID string) ([]instances.Instance, error)
+	UpgradeFunc          func(clusterID string, opts clusters.UpgradeOptsBuilder) tasks.Result
+	VersionsFunc         func() pagination.Pager
+	VersionsAllFunc      func() ([]clusters.Version, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ clusters.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements clusters.API.
+func (m *API) Create(opts clusters.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: clusters.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements clusters.API.
+func (m *API) Delete(clusterName string) tasks.Result {
+	m.record("Delete", clusterName)
+	if m.DeleteFunc == nil {
+		panic("mocks: clusters.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(clusterName)
+}
+
+// Get implements clusters.API.
+func (m *API) Get(clusterName string) clusters.GetResult {
+	m.record("Get", clusterName)
+	if m.GetFunc == nil {
+		panic("mocks: clusters.API.GetFunc is not set")
+	}
+	return m.GetFunc(clusterName)
+}
+
+// GetCertificate implements clusters.API.
+func (m *API) GetCertificate(clusterName string) clusters.CertificateResult {
+	m.record("GetCertificate", clusterName)
+	if m.GetCertificateFunc == nil {
+		panic("mocks: clusters.API.GetCertificateFunc is not set")
+	}
+	return m.GetCertificateFunc(clusterName)
+}
+
+// GetConfig implements clusters.API.
+func (m *API) GetConfig(clusterName string) clusters.ConfigResult {
+	m.record("GetConfig", clusterName)
+	if m.GetConfigFunc == nil {
+		panic("mocks: clusters.API.GetConfigFunc is not set")
+	}
+	return m.GetConfigFunc(clusterName)
+}
+
+// List implements clusters.API.
+func (m *API) List() pagination.Pager {
+	m.record("List")
+	if m.ListFunc == nil {
+		panic("mocks: clusters.API.ListFunc is not set")
+	}
+	return m.ListFunc()
+}
+
+// ListAll implements clusters.API.
+func (m *API) ListAll() ([]clusters.Cluster, error) {
+	m.record("ListAll")
+	if m.ListAllFunc == nil {
+		panic("mocks: clusters.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc()
+}
+
+// ListAllIPPools implements clusters.API.
+func (m *API) ListAllIPPools() ([]gcorecloud.CIDR, error) {
+	m.record("ListAllIPPools")
+	if m.ListAllIPPoolsFunc == nil {
+		panic("mocks: clusters.API.ListAllIPPoolsFunc is not set")
+	}
+	return m.ListAllIPPoolsFunc()
+}
+
+// ListInstances implements clusters.API.
+func (m *API) ListInstances(clusterID string) pagination.Pager {
+	m.record("ListInstances", clusterID)
+	if m.ListInstancesFunc == nil {
+		panic("mocks: clusters.API.ListInstancesFunc is not set")
+	}
+	return m.ListInstancesFunc(clusterID)
+}
+
+// ListInstancesAll implements clusters.API.
+func (m *API) ListInstancesAll(clusterID string) ([]instances.Instance, error) {
+	m.record("ListInstancesAll", clusterID)
+	if m.ListInstancesAllFunc == nil {
+		panic("mocks: clusters.API.ListInstancesAllFunc is not set")
+	}
+	return m.ListInstancesAllFunc(clusterID)
+}
+
+// Upgrade implements clusters.API.
+func (m *API) Upgrade(clusterID string, opts clusters.UpgradeOptsBuilder) tasks.Result {
+	m.record("Upgrade", clusterID, opts)
+	if m.UpgradeFunc == nil {
+		panic("mocks: clusters.API.UpgradeFunc is not set")
+	}
+	return m.UpgradeFunc(clusterID, opts)
+}
+
+// Versions implements clusters.API.
+func (m *API) Versions() pagination.Pager {
+	m.record("Versions")
+	if m.VersionsFunc == nil {
+		panic("mocks: clusters.API.VersionsFunc is not set")
+	}
+	return m.VersionsFunc()
+}
+
+// VersionsAll implements clusters.API.
+func (m *API) VersionsAll() ([]clusters.Version, error) {
+	m.record("VersionsAll")
+	if m.VersionsAllFunc == nil {
+		panic("mocks: clusters.API.VersionsAllFunc is not set")
+	}
+	return m.VersionsAllFunc()
+}
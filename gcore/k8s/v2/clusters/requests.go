@@ -69,6 +69,23 @@ func ListAll(c *gcorecloud.ServiceClient) ([]Cluster, error) {
 	return ExtractClusters(page)
 }
 
+// ListAllIPPools returns the pods and services IP pools of all clusters, e.g. to keep them out of new subnets.
+func ListAllIPPools(c *gcorecloud.ServiceClient) ([]gcorecloud.CIDR, error) {
+	all, err := ListAll(c)
+	if err != nil {
+		return nil, err
+	}
+	var pools []gcorecloud.CIDR
+	for _, cluster := range all {
+		for _, pool := range []*gcorecloud.CIDR{cluster.PodsIPPool, cluster.ServicesIPPool} {
+			if pool != nil {
+				pools = append(pools, *pool)
+			}
+		}
+	}
+	return pools, nil
+}
+
 // Create accepts a CreateOpts struct and creates a new cluster using the values provided.
 func Create(c *gcorecloud.ServiceClient, opts CreateOptsBuilder) (r tasks.Result) {
 	b, err := opts.ToClusterCreateMap()
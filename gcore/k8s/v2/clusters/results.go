@@ -57,23 +57,25 @@ type GetResult struct {
 
 // Cluster represents a cluster structure.
 type Cluster struct {
-	ID            string              `json:"id"`
-	Name          string              `json:"name"`
-	KeyPair       string              `json:"keypair"`
-	NodeCount     int                 `json:"node_count"`
-	FlavorID      string              `json:"flavor_id"`
-	Status        string              `json:"status"`
-	Pools         []pools.ClusterPool `json:"pools"`
-	Version       string              `json:"version"`
-	IsPublic      bool                `json:"is_public"`
-	FixedNetwork  string              `json:"fixed_network"`
-	FixedSubnet   string              `json:"fixed_subnet"`
-	CreatedAt     time.Time           `json:"created_at"`
-	CreatorTaskID string              `json:"creator_task_id"`
-	TaskID        string              `json:"task_id"`
-	ProjectID     int                 `json:"project_id"`
-	RegionID      int                 `json:"region_id"`
-	Region        string              `json:"region"`
+	ID             string              `json:"id"`
+	Name           string              `json:"name"`
+	KeyPair        string              `json:"keypair"`
+	NodeCount      int                 `json:"node_count"`
+	FlavorID       string              `json:"flavor_id"`
+	Status         string              `json:"status"`
+	Pools          []pools.ClusterPool `json:"pools"`
+	Version        string              `json:"version"`
+	IsPublic       bool                `json:"is_public"`
+	FixedNetwork   string              `json:"fixed_network"`
+	FixedSubnet    string              `json:"fixed_subnet"`
+	PodsIPPool     *gcorecloud.CIDR    `json:"pods_ip_pool"`
+	ServicesIPPool *gcorecloud.CIDR    `json:"services_ip_pool"`
+	CreatedAt      time.Time           `json:"created_at"`
+	CreatorTaskID  string              `json:"creator_task_id"`
+	TaskID         string              `json:"task_id"`
+	ProjectID      int                 `json:"project_id"`
+	RegionID       int                 `json:"region_id"`
+	Region         string              `json:"region"`
 }
 
 // Certificate represents a cluster CA certificate.
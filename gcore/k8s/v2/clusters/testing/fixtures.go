@@ -49,7 +49,9 @@ const ListResponse = `
       "region_id": 7,
       "id": "b1ba8a5e-62d7-4f06-9b94-eae7762ecacd",
       "region": "ED-10 Preprod",
-      "fixed_subnet": "4e9dcf93-c93a-41c7-9b28-342a912c744f"
+      "fixed_subnet": "4e9dcf93-c93a-41c7-9b28-342a912c744f",
+      "pods_ip_pool": "10.42.0.0/16",
+      "services_ip_pool": null
     }
   ]
 }
@@ -115,7 +117,9 @@ const GetResponse = `
   "region_id": 7,
   "id": "b1ba8a5e-62d7-4f06-9b94-eae7762ecacd",
   "region": "ED-10 Preprod",
-  "fixed_subnet": "4e9dcf93-c93a-41c7-9b28-342a912c744f"
+  "fixed_subnet": "4e9dcf93-c93a-41c7-9b28-342a912c744f",
+  "pods_ip_pool": "10.42.0.0/16",
+  "services_ip_pool": null
 }
 `
 
@@ -324,6 +328,7 @@ var (
 		IsPublic:      false,
 		FixedNetwork:  fixedNetwork,
 		FixedSubnet:   fixedSubnet,
+		PodsIPPool:    &gcorecloud.CIDR{IPNet: *ipPool},
 		CreatedAt:     createdTime,
 		CreatorTaskID: creatorTaskID,
 		ProjectID:     1234,
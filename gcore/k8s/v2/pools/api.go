@@ -0,0 +1,81 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package pools
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of pools operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Create(clusterName string, opts CreateOptsBuilder) tasks.Result
+	Delete(clusterName string, poolName string) tasks.Result
+	Get(clusterName string, poolName string) GetResult
+	List(clusterName string) pagination.Pager
+	ListAll(clusterName string) ([]ClusterPool, error)
+	ListInstances(clusterName string, poolName string) pagination.Pager
+	ListInstancesAll(clusterName string, poolName string) ([]instances.Instance, error)
+	Resize(clusterName string, poolName string, opts ResizeOptsBuilder) tasks.Result
+	Update(clusterName string, poolName string, opts UpdateOptsBuilder) UpdateResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(clusterName string, opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, clusterName, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(clusterName string, poolName string) tasks.Result {
+	return Delete(s.ServiceClient, clusterName, poolName)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(clusterName string, poolName string) GetResult {
+	return Get(s.ServiceClient, clusterName, poolName)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(clusterName string) pagination.Pager {
+	return List(s.ServiceClient, clusterName)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(clusterName string) ([]ClusterPool, error) {
+	return ListAll(s.ServiceClient, clusterName)
+}
+
+// ListInstances calls the package level ListInstances function with the service client.
+func (s *Service) ListInstances(clusterName string, poolName string) pagination.Pager {
+	return ListInstances(s.ServiceClient, clusterName, poolName)
+}
+
+// ListInstancesAll calls the package level ListInstancesAll function with the service client.
+func (s *Service) ListInstancesAll(clusterName string, poolName string) ([]instances.Instance, error) {
+	return ListInstancesAll(s.ServiceClient, clusterName, poolName)
+}
+
+// Resize calls the package level Resize function with the service client.
+func (s *Service) Resize(clusterName string, poolName string, opts ResizeOptsBuilder) tasks.Result {
+	return Resize(s.ServiceClient, clusterName, poolName, opts)
+}
+
+// Update calls the package level Update function with the service client.
+func (s *Service) Update(clusterName string, poolName string, opts UpdateOptsBuilder) UpdateResult {
+	return Update(s.ServiceClient, clusterName, poolName, opts)
+}
@@ -0,0 +1,145 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of pools.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/k8s/v2/pools"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of pools.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc           func(clusterName string, opts pools.CreateOptsBuilder) tasks.Result
+	DeleteFunc           func(clusterName string, poolName string) tasks.Result
+	GetFunc              func(clusterName string, poolName string) pools.GetResult
+	ListFunc             func(clusterName string) pagination.Pager
+	ListAllFunc          func(clusterName string) ([]pools.ClusterPool, error)
+	ListInstancesFunc    func(clusterName string, poolName string) pagination.Pager
+	ListInstancesAllFunc func(clusterName string, poolName string) ([]instances.Instance, error)
+	ResizeFunc           func(clusterName string, poolName string, opts pools.ResizeOptsBuilder) tasks.Result
+	UpdateFunc           func(clusterName string, poolName string, opts pools.UpdateOptsBuilder) pools.UpdateResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ pools.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements pools.API.
+func (m *API) Create(clusterName string, opts pools.CreateOptsBuilder) tasks.Result {
+	m.record("Create", clusterName, opts)
+	if m.CreateFunc == nil {
+		panic("mocks: pools.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(clusterName, opts)
+}
+
+// Delete implements pools.API.
+func (m *API) Delete(clusterName string, poolName string) tasks.Result {
+	m.record("Delete", clusterName, poolName)
+	if m.DeleteFunc == nil {
+		panic("mocks: pools.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(clusterName, poolName)
+}
+
+// Get implements pools.API.
+func (m *API) Get(clusterName string, poolName string) pools.GetResult {
+	m.record("Get", clusterName, poolName)
+	if m.GetFunc == nil {
+		panic("mocks: pools.API.GetFunc is not set")
+	}
+	return m.GetFunc(clusterName, poolName)
+}
+
+// List implements pools.API.
+func (m *API) List(clusterName string) pagination.Pager {
+	m.record("List", clusterName)
+	if m.ListFunc == nil {
+		panic("mocks: pools.API.ListFunc is not set")
+	}
+	return m.ListFunc(clusterName)
+}
+
+// ListAll implements pools.API.
+func (m *API) ListAll(clusterName string) ([]pools.ClusterPool, error) {
+	m.record("ListAll", clusterName)
+	if m.ListAllFunc == nil {
+		panic("mocks: pools.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(clusterName)
+}
+
+// ListInstances implements pools.API.
+func (m *API) ListInstances(clusterName string, poolName string) pagination.Pager {
+	m.record("ListInstances", clusterName, poolName)
+	if m.ListInstancesFunc == nil {
+		panic("mocks: pools.API.ListInstancesFunc is not set")
+	}
+	return m.ListInstancesFunc(clusterName, poolName)
+}
+
+// ListInstancesAll implements pools.API.
+func (m *API) ListInstancesAll(clusterName string, poolName string) ([]instances.Instance, error) {
+	m.record("ListInstancesAll", clusterName, poolName)
+	if m.ListInstancesAllFunc == nil {
+		panic("mocks: pools.API.ListInstancesAllFunc is not set")
+	}
+	return m.ListInstancesAllFunc(clusterName, poolName)
+}
+
+// Resize implements pools.API.
+func (m *API) Resize(clusterName string, poolName string, opts pools.ResizeOptsBuilder) tasks.Result {
+	m.record("Resize", clusterName, poolName, opts)
+	if m.ResizeFunc == nil {
+		panic("mocks: pools.API.ResizeFunc is not set")
+	}
+	return m.ResizeFunc(clusterName, poolName, opts)
+}
+
+// Update implements pools.API.
+func (m *API) Update(clusterName string, poolName string, opts pools.UpdateOptsBuilder) pools.UpdateResult {
+	m.record("Update", clusterName, poolName, opts)
+	if m.UpdateFunc == nil {
+		panic("mocks: pools.API.UpdateFunc is not set")
+	}
+	return m.UpdateFunc(clusterName, poolName, opts)
+}
@@ -0,0 +1,55 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package keypairs
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of keypairs operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Create(opts CreateOptsBuilder) CreateResult
+	Delete(keypairID string) DeleteResult
+	Get(id string) GetResult
+	IDFromName(name string) (string, error)
+	List() pagination.Pager
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) CreateResult {
+	return Create(s.ServiceClient, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(keypairID string) DeleteResult {
+	return Delete(s.ServiceClient, keypairID)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id string) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// IDFromName calls the package level IDFromName function with the service client.
+func (s *Service) IDFromName(name string) (string, error) {
+	return IDFromName(s.ServiceClient, name)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List() pagination.Pager {
+	return List(s.ServiceClient)
+}
@@ -0,0 +1,103 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of keypairs.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/keypair/v1/keypairs"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of keypairs.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc     func(opts keypairs.CreateOptsBuilder) keypairs.CreateResult
+	DeleteFunc     func(keypairID string) keypairs.DeleteResult
+	GetFunc        func(id string) keypairs.GetResult
+	IDFromNameFunc func(name string) (string, error)
+	ListFunc       func() pagination.Pager
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ keypairs.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements keypairs.API.
+func (m *API) Create(opts keypairs.CreateOptsBuilder) keypairs.CreateResult {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: keypairs.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements keypairs.API.
+func (m *API) Delete(keypairID string) keypairs.DeleteResult {
+	m.record("Delete", keypairID)
+	if m.DeleteFunc == nil {
+		panic("mocks: keypairs.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(keypairID)
+}
+
+// Get implements keypairs.API.
+func (m *API) Get(id string) keypairs.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: keypairs.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// IDFromName implements keypairs.API.
+func (m *API) IDFromName(name string) (string, error) {
+	m.record("IDFromName", name)
+	if m.IDFromNameFunc == nil {
+		panic("mocks: keypairs.API.IDFromNameFunc is not set")
+	}
+	return m.IDFromNameFunc(name)
+}
+
+// List implements keypairs.API.
+func (m *API) List() pagination.Pager {
+	m.record("List")
+	if m.ListFunc == nil {
+		panic("mocks: keypairs.API.ListFunc is not set")
+	}
+	return m.ListFunc()
+}
@@ -0,0 +1,55 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package keystones
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of keystones operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Create(opts CreateOptsBuilder) CreateResult
+	Get(id int) GetResult
+	List() pagination.Pager
+	ListAll() ([]Keystone, error)
+	Update(id int, opts UpdateOptsBuilder) UpdateResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) CreateResult {
+	return Create(s.ServiceClient, opts)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id int) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List() pagination.Pager {
+	return List(s.ServiceClient)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll() ([]Keystone, error) {
+	return ListAll(s.ServiceClient)
+}
+
+// Update calls the package level Update function with the service client.
+func (s *Service) Update(id int, opts UpdateOptsBuilder) UpdateResult {
+	return Update(s.ServiceClient, id, opts)
+}
@@ -0,0 +1,103 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of keystones.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/keystone/v1/keystones"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of keystones.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc  func(opts keystones.CreateOptsBuilder) keystones.CreateResult
+	GetFunc     func(id int) keystones.GetResult
+	ListFunc    func() pagination.Pager
+	ListAllFunc func() ([]keystones.Keystone, error)
+	UpdateFunc  func(id int, opts keystones.UpdateOptsBuilder) keystones.UpdateResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ keystones.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements keystones.API.
+func (m *API) Create(opts keystones.CreateOptsBuilder) keystones.CreateResult {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: keystones.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Get implements keystones.API.
+func (m *API) Get(id int) keystones.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: keystones.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// List implements keystones.API.
+func (m *API) List() pagination.Pager {
+	m.record("List")
+	if m.ListFunc == nil {
+		panic("mocks: keystones.API.ListFunc is not set")
+	}
+	return m.ListFunc()
+}
+
+// ListAll implements keystones.API.
+func (m *API) ListAll() ([]keystones.Keystone, error) {
+	m.record("ListAll")
+	if m.ListAllFunc == nil {
+		panic("mocks: keystones.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc()
+}
+
+// Update implements keystones.API.
+func (m *API) Update(id int, opts keystones.UpdateOptsBuilder) keystones.UpdateResult {
+	m.record("Update", id, opts)
+	if m.UpdateFunc == nil {
+		panic("mocks: keystones.API.UpdateFunc is not set")
+	}
+	return m.UpdateFunc(id, opts)
+}
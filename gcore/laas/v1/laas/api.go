@@ -0,0 +1,79 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package laas
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of laas operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	CreateTopic(opts CreateTopicOptsBuilder) TopicResult
+	DeleteTopic(name string) DeleteResult
+	GetStatus() StatusResult
+	ListKafkaHosts() HostsResult
+	ListOpenSearchHosts() HostsResult
+	ListTopic() pagination.Pager
+	ListTopicAll() ([]Topic, error)
+	RegenerateUser() UserResult
+	UpdateStatus(opts UpdateOptsBuilder) StatusResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// CreateTopic calls the package level CreateTopic function with the service client.
+func (s *Service) CreateTopic(opts CreateTopicOptsBuilder) TopicResult {
+	return CreateTopic(s.ServiceClient, opts)
+}
+
+// DeleteTopic calls the package level DeleteTopic function with the service client.
+func (s *Service) DeleteTopic(name string) DeleteResult {
+	return DeleteTopic(s.ServiceClient, name)
+}
+
+// GetStatus calls the package level GetStatus function with the service client.
+func (s *Service) GetStatus() StatusResult {
+	return GetStatus(s.ServiceClient)
+}
+
+// ListKafkaHosts calls the package level ListKafkaHosts function with the service client.
+func (s *Service) ListKafkaHosts() HostsResult {
+	return ListKafkaHosts(s.ServiceClient)
+}
+
+// ListOpenSearchHosts calls the package level ListOpenSearchHosts function with the service client.
+func (s *Service) ListOpenSearchHosts() HostsResult {
+	return ListOpenSearchHosts(s.ServiceClient)
+}
+
+// ListTopic calls the package level ListTopic function with the service client.
+func (s *Service) ListTopic() pagination.Pager {
+	return ListTopic(s.ServiceClient)
+}
+
+// ListTopicAll calls the package level ListTopicAll function with the service client.
+func (s *Service) ListTopicAll() ([]Topic, error) {
+	return ListTopicAll(s.ServiceClient)
+}
+
+// RegenerateUser calls the package level RegenerateUser function with the service client.
+func (s *Service) RegenerateUser() UserResult {
+	return RegenerateUser(s.ServiceClient)
+}
+
+// UpdateStatus calls the package level UpdateStatus function with the service client.
+func (s *Service) UpdateStatus(opts UpdateOptsBuilder) StatusResult {
+	return UpdateStatus(s.ServiceClient, opts)
+}
@@ -0,0 +1,143 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of laas.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/laas/v1/laas"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of laas.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateTopicFunc         func(opts laas.CreateTopicOptsBuilder) laas.TopicResult
+	DeleteTopicFunc         func(name string) laas.DeleteResult
+	GetStatusFunc           func() laas.StatusResult
+	ListKafkaHostsFunc      func() laas.HostsResult
+	ListOpenSearchHostsFunc func() laas.HostsResult
+	ListTopicFunc           func() pagination.Pager
+	ListTopicAllFunc        func() ([]laas.Topic, error)
+	RegenerateUserFunc      func() laas.UserResult
+	UpdateStatusFunc        func(opts laas.UpdateOptsBuilder) laas.StatusResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ laas.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// CreateTopic implements laas.API.
+func (m *API) CreateTopic(opts laas.CreateTopicOptsBuilder) laas.TopicResult {
+	m.record("CreateTopic", opts)
+	if m.CreateTopicFunc == nil {
+		panic("mocks: laas.API.CreateTopicFunc is not set")
+	}
+	return m.CreateTopicFunc(opts)
+}
+
+// DeleteTopic implements laas.API.
+func (m *API) DeleteTopic(name string) laas.DeleteResult {
+	m.record("DeleteTopic", name)
+	if m.DeleteTopicFunc == nil {
+		panic("mocks: laas.API.DeleteTopicFunc is not set")
+	}
+	return m.DeleteTopicFunc(name)
+}
+
+// GetStatus implements laas.API.
+func (m *API) GetStatus() laas.StatusResult {
+	m.record("GetStatus")
+	if m.GetStatusFunc == nil {
+		panic("mocks: laas.API.GetStatusFunc is not set")
+	}
+	return m.GetStatusFunc()
+}
+
+// ListKafkaHosts implements laas.API.
+func (m *API) ListKafkaHosts() laas.HostsResult {
+	m.record("ListKafkaHosts")
+	if m.ListKafkaHostsFunc == nil {
+		panic("mocks: laas.API.ListKafkaHostsFunc is not set")
+	}
+	return m.ListKafkaHostsFunc()
+}
+
+// ListOpenSearchHosts implements laas.API.
+func (m *API) ListOpenSearchHosts() laas.HostsResult {
+	m.record("ListOpenSearchHosts")
+	if m.ListOpenSearchHostsFunc == nil {
+		panic("mocks: laas.API.ListOpenSearchHostsFunc is not set")
+	}
+	return m.ListOpenSearchHostsFunc()
+}
+
+// ListTopic implements laas.API.
+func (m *API) ListTopic() pagination.Pager {
+	m.record("ListTopic")
+	if m.ListTopicFunc == nil {
+		panic("mocks: laas.API.ListTopicFunc is not set")
+	}
+	return m.ListTopicFunc()
+}
+
+// ListTopicAll implements laas.API.
+func (m *API) ListTopicAll() ([]laas.Topic, error) {
+	m.record("ListTopicAll")
+	if m.ListTopicAllFunc == nil {
+		panic("mocks: laas.API.ListTopicAllFunc is not set")
+	}
+	return m.ListTopicAllFunc()
+}
+
+// RegenerateUser implements laas.API.
+func (m *API) RegenerateUser() laas.UserResult {
+	m.record("RegenerateUser")
+	if m.RegenerateUserFunc == nil {
+		panic("mocks: laas.API.RegenerateUserFunc is not set")
+	}
+	return m.RegenerateUserFunc()
+}
+
+// UpdateStatus implements laas.API.
+func (m *API) UpdateStatus(opts laas.UpdateOptsBuilder) laas.StatusResult {
+	m.record("UpdateStatus", opts)
+	if m.UpdateStatusFunc == nil {
+		panic("mocks: laas.API.UpdateStatusFunc is not set")
+	}
+	return m.UpdateStatusFunc(opts)
+}
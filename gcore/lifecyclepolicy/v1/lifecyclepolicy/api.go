@@ -0,0 +1,90 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package lifecyclepolicy
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+)
+
+// API is the set of lifecyclepolicy operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	AddSchedules(id int, opts AddSchedulesOpts) AddSchedulesResult
+	AddVolumes(id int, opts AddVolumesOpts) AddVolumesResult
+	Create(opts CreateOpts) CreateResult
+	Delete(id int) error
+	EstimateCronMaxPolicyUsage(opts EstimateCronOpts) EstimateResult
+	EstimateIntervalMaxPolicyUsage(opts EstimateIntervalOpts) EstimateResult
+	Get(id int, opts GetOpts) GetResult
+	ListAll(opts ListOpts) ListResult
+	RemoveSchedules(id int, opts RemoveSchedulesOpts) RemoveSchedulesResult
+	RemoveVolumes(id int, opts RemoveVolumesOpts) RemoveVolumesResult
+	Update(id int, opts UpdateOpts) UpdateResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// AddSchedules calls the package level AddSchedules function with the service client.
+func (s *Service) AddSchedules(id int, opts AddSchedulesOpts) AddSchedulesResult {
+	return AddSchedules(s.ServiceClient, id, opts)
+}
+
+// AddVolumes calls the package level AddVolumes function with the service client.
+func (s *Service) AddVolumes(id int, opts AddVolumesOpts) AddVolumesResult {
+	return AddVolumes(s.ServiceClient, id, opts)
+}
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOpts) CreateResult {
+	return Create(s.ServiceClient, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(id int) error {
+	return Delete(s.ServiceClient, id)
+}
+
+// EstimateCronMaxPolicyUsage calls the package level EstimateCronMaxPolicyUsage function with the service client.
+func (s *Service) EstimateCronMaxPolicyUsage(opts EstimateCronOpts) EstimateResult {
+	return EstimateCronMaxPolicyUsage(s.ServiceClient, opts)
+}
+
+// EstimateIntervalMaxPolicyUsage calls the package level EstimateIntervalMaxPolicyUsage function with the service client.
+func (s *Service) EstimateIntervalMaxPolicyUsage(opts EstimateIntervalOpts) EstimateResult {
+	return EstimateIntervalMaxPolicyUsage(s.ServiceClient, opts)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id int, opts GetOpts) GetResult {
+	return Get(s.ServiceClient, id, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(opts ListOpts) ListResult {
+	return ListAll(s.ServiceClient, opts)
+}
+
+// RemoveSchedules calls the package level RemoveSchedules function with the service client.
+func (s *Service) RemoveSchedules(id int, opts RemoveSchedulesOpts) RemoveSchedulesResult {
+	return RemoveSchedules(s.ServiceClient, id, opts)
+}
+
+// RemoveVolumes calls the package level RemoveVolumes function with the service client.
+func (s *Service) RemoveVolumes(id int, opts RemoveVolumesOpts) RemoveVolumesResult {
+	return RemoveVolumes(s.ServiceClient, id, opts)
+}
+
+// Update calls the package level Update function with the service client.
+func (s *Service) Update(id int, opts UpdateOpts) UpdateResult {
+	return Update(s.ServiceClient, id, opts)
+}
@@ -0,0 +1,162 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of lifecyclepolicy.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/lifecyclepolicy/v1/lifecyclepolicy"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of lifecyclepolicy.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	AddSchedulesFunc                   func(id int, opts lifecyclepolicy.AddSchedulesOpts) lifecyclepolicy.AddSchedulesResult
+	AddVolumesFunc                     func(id int, opts lifecyclepolicy.AddVolumesOpts) lifecyclepolicy.AddVolumesResult
+	CreateFunc                         func(opts lifecyclepolicy.CreateOpts) lifecyclepolicy.CreateResult
+	DeleteFunc                         func(id int) error
+	EstimateCronMaxPolicyUsageFunc     func(opts lifecyclepolicy.EstimateCronOpts) lifecyclepolicy.EstimateResult
+	EstimateIntervalMaxPolicyUsageFunc func(opts lifecyclepolicy.EstimateIntervalOpts) lifecyclepolicy.EstimateResult
+	GetFunc                            func(id int, opts lifecyclepolicy.GetOpts) lifecyclepolicy.GetResult
+	ListAllFunc                        func(opts lifecyclepolicy.ListOpts) lifecyclepolicy.ListResult
+	RemoveSchedulesFunc                func(id int, opts lifecyclepolicy.RemoveSchedulesOpts) lifecyclepolicy.RemoveSchedulesResult
+	RemoveVolumesFunc                  func(id int, opts lifecyclepolicy.RemoveVolumesOpts) lifecyclepolicy.RemoveVolumesResult
+	UpdateFunc                         func(id int, opts lifecyclepolicy.UpdateOpts) lifecyclepolicy.UpdateResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ lifecyclepolicy.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// AddSchedules implements lifecyclepolicy.API.
+func (m *API) AddSchedules(id int, opts lifecyclepolicy.AddSchedulesOpts) lifecyclepolicy.AddSchedulesResult {
+	m.record("AddSchedules", id, opts)
+	if m.AddSchedulesFunc == nil {
+		panic("mocks: lifecyclepolicy.API.AddSchedulesFunc is not set")
+	}
+	return m.AddSchedulesFunc(id, opts)
+}
+
+// AddVolumes implements lifecyclepolicy.API.
+func (m *API) AddVolumes(id int, opts lifecyclepolicy.AddVolumesOpts) lifecyclepolicy.AddVolumesResult {
+	m.record("AddVolumes", id, opts)
+	if m.AddVolumesFunc == nil {
+		panic("mocks: lifecyclepolicy.API.AddVolumesFunc is not set")
+	}
+	return m.AddVolumesFunc(id, opts)
+}
+
+// Create implements lifecyclepolicy.API.
+func (m *API) Create(opts lifecyclepolicy.CreateOpts) lifecyclepolicy.CreateResult {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: lifecyclepolicy.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements lifecyclepolicy.API.
+func (m *API) Delete(id int) error {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		panic("mocks: lifecyclepolicy.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(id)
+}
+
+// EstimateCronMaxPolicyUsage implements lifecyclepolicy.API.
+func (m *API) EstimateCronMaxPolicyUsage(opts lifecyclepolicy.EstimateCronOpts) lifecyclepolicy.EstimateResult {
+	m.record("EstimateCronMaxPolicyUsage", opts)
+	if m.EstimateCronMaxPolicyUsageFunc == nil {
+		panic("mocks: lifecyclepolicy.API.EstimateCronMaxPolicyUsageFunc is not set")
+	}
+	return m.EstimateCronMaxPolicyUsageFunc(opts)
+}
+
+// EstimateIntervalMaxPolicyUsage implements lifecyclepolicy.API.
+func (m *API) EstimateIntervalMaxPolicyUsage(opts lifecyclepolicy.EstimateIntervalOpts) lifecyclepolicy.EstimateResult {
+	m.record("EstimateIntervalMaxPolicyUsage", opts)
+	if m.EstimateIntervalMaxPolicyUsageFunc == nil {
+		panic("mocks: lifecyclepolicy.API.EstimateIntervalMaxPolicyUsageFunc is not set")
+	}
+	return m.EstimateIntervalMaxPolicyUsageFunc(opts)
+}
+
+// Get implements lifecyclepolicy.API.
+func (m *API) Get(id int, opts lifecyclepolicy.GetOpts) lifecyclepolicy.GetResult {
+	m.record("Get", id, opts)
+	if m.GetFunc == nil {
+		panic("mocks: lifecyclepolicy.API.GetFunc is not set")
+	}
+	return m.GetFunc(id, opts)
+}
+
+// ListAll implements lifecyclepolicy.API.
+func (m *API) ListAll(opts lifecyclepolicy.ListOpts) lifecyclepolicy.ListResult {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: lifecyclepolicy.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// RemoveSchedules implements lifecyclepolicy.API.
+func (m *API) RemoveSchedules(id int, opts lifecyclepolicy.RemoveSchedulesOpts) lifecyclepolicy.RemoveSchedulesResult {
+	m.record("RemoveSchedules", id, opts)
+	if m.RemoveSchedulesFunc == nil {
+		panic("mocks: lifecyclepolicy.API.RemoveSchedulesFunc is not set")
+	}
+	return m.RemoveSchedulesFunc(id, opts)
+}
+
+// RemoveVolumes implements lifecyclepolicy.API.
+func (m *API) RemoveVolumes(id int, opts lifecyclepolicy.RemoveVolumesOpts) lifecyclepolicy.RemoveVolumesResult {
+	m.record("RemoveVolumes", id, opts)
+	if m.RemoveVolumesFunc == nil {
+		panic("mocks: lifecyclepolicy.API.RemoveVolumesFunc is not set")
+	}
+	return m.RemoveVolumesFunc(id, opts)
+}
+
+// Update implements lifecyclepolicy.API.
+func (m *API) Update(id int, opts lifecyclepolicy.UpdateOpts) lifecyclepolicy.UpdateResult {
+	m.record("Update", id, opts)
+	if m.UpdateFunc == nil {
+		panic("mocks: lifecyclepolicy.API.UpdateFunc is not set")
+	}
+	return m.UpdateFunc(id, opts)
+}
@@ -0,0 +1,103 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of limits.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/limit/v2/limits"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of limits.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc  func(opts limits.CreateOptsBuilder) limits.CreateResult
+	DeleteFunc  func(id int) limits.DeleteResult
+	GetFunc     func(id int) limits.GetResult
+	ListFunc    func() pagination.Pager
+	ListAllFunc func() ([]limits.LimitResponse, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ limits.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements limits.API.
+func (m *API) Create(opts limits.CreateOptsBuilder) limits.CreateResult {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: limits.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements limits.API.
+func (m *API) Delete(id int) limits.DeleteResult {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		panic("mocks: limits.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(id)
+}
+
+// Get implements limits.API.
+func (m *API) Get(id int) limits.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: limits.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// List implements limits.API.
+func (m *API) List() pagination.Pager {
+	m.record("List")
+	if m.ListFunc == nil {
+		panic("mocks: limits.API.ListFunc is not set")
+	}
+	return m.ListFunc()
+}
+
+// ListAll implements limits.API.
+func (m *API) ListAll() ([]limits.LimitResponse, error) {
+	m.record("ListAll")
+	if m.ListAllFunc == nil {
+		panic("mocks: limits.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc()
+}
@@ -0,0 +1,98 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package l7policies
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of l7policies operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Create(opts CreateOptsBuilder) tasks.Result
+	CreateRule(policyID string, opts CreateRuleOptsBuilder) tasks.Result
+	Delete(policyID string) tasks.Result
+	DeleteRule(policyID string, ruleID string) tasks.Result
+	Get(policyID string) GetResult
+	GetRule(plid string, rlid string) GetRuleResult
+	List() pagination.Pager
+	ListAll() ([]L7Policy, error)
+	ListAllRule(policyID string) ([]L7Rule, error)
+	ListRule(policyID string) pagination.Pager
+	Replace(policyID string, opts ReplaceOptsBuilder) tasks.Result
+	ReplaceRule(policyID string, ruleID string, opts CreateRuleOptsBuilder) tasks.Result
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, opts)
+}
+
+// CreateRule calls the package level CreateRule function with the service client.
+func (s *Service) CreateRule(policyID string, opts CreateRuleOptsBuilder) tasks.Result {
+	return CreateRule(s.ServiceClient, policyID, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(policyID string) tasks.Result {
+	return Delete(s.ServiceClient, policyID)
+}
+
+// DeleteRule calls the package level DeleteRule function with the service client.
+func (s *Service) DeleteRule(policyID string, ruleID string) tasks.Result {
+	return DeleteRule(s.ServiceClient, policyID, ruleID)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(policyID string) GetResult {
+	return Get(s.ServiceClient, policyID)
+}
+
+// GetRule calls the package level GetRule function with the service client.
+func (s *Service) GetRule(plid string, rlid string) GetRuleResult {
+	return GetRule(s.ServiceClient, plid, rlid)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List() pagination.Pager {
+	return List(s.ServiceClient)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll() ([]L7Policy, error) {
+	return ListAll(s.ServiceClient)
+}
+
+// ListAllRule calls the package level ListAllRule function with the service client.
+func (s *Service) ListAllRule(policyID string) ([]L7Rule, error) {
+	return ListAllRule(s.ServiceClient, policyID)
+}
+
+// ListRule calls the package level ListRule function with the service client.
+func (s *Service) ListRule(policyID string) pagination.Pager {
+	return ListRule(s.ServiceClient, policyID)
+}
+
+// Replace calls the package level Replace function with the service client.
+func (s *Service) Replace(policyID string, opts ReplaceOptsBuilder) tasks.Result {
+	return Replace(s.ServiceClient, policyID, opts)
+}
+
+// ReplaceRule calls the package level ReplaceRule function with the service client.
+func (s *Service) ReplaceRule(policyID string, ruleID string, opts CreateRuleOptsBuilder) tasks.Result {
+	return ReplaceRule(s.ServiceClient, policyID, ruleID, opts)
+}
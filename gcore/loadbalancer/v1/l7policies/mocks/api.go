@@ -0,0 +1,174 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of l7policies.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/loadbalancer/v1/l7policies"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of l7policies.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc      func(opts l7policies.CreateOptsBuilder) tasks.Result
+	CreateRuleFunc  func(policyID string, opts l7policies.CreateRuleOptsBuilder) tasks.Result
+	DeleteFunc      func(policyID string) tasks.Result
+	DeleteRuleFunc  func(policyID string, ruleID string) tasks.Result
+	GetFunc         func(policyID string) l7policies.GetResult
+	GetRuleFunc     func(plid string, rlid string) l7policies.GetRuleResult
+	ListFunc        func() pagination.Pager
+	ListAllFunc     func() ([]l7policies.L7Policy, error)
+	ListAllRuleFunc func(policyID string) ([]l7policies.L7Rule, error)
+	ListRuleFunc    func(policyID string) pagination.Pager
+	ReplaceFunc     func(policyID string, opts l7policies.ReplaceOptsBuilder) tasks.Result
+	ReplaceRuleFunc func(policyID string, ruleID string, opts l7policies.CreateRuleOptsBuilder) tasks.Result
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ l7policies.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements l7policies.API.
+func (m *API) Create(opts l7policies.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: l7policies.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// CreateRule implements l7policies.API.
+func (m *API) CreateRule(policyID string, opts l7policies.CreateRuleOptsBuilder) tasks.Result {
+	m.record("CreateRule", policyID, opts)
+	if m.CreateRuleFunc == nil {
+		panic("mocks: l7policies.API.CreateRuleFunc is not set")
+	}
+	return m.CreateRuleFunc(policyID, opts)
+}
+
+// Delete implements l7policies.API.
+func (m *API) Delete(policyID string) tasks.Result {
+	m.record("Delete", policyID)
+	if m.DeleteFunc == nil {
+		panic("mocks: l7policies.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(policyID)
+}
+
+// DeleteRule implements l7policies.API.
+func (m *API) DeleteRule(policyID string, ruleID string) tasks.Result {
+	m.record("DeleteRule", policyID, ruleID)
+	if m.DeleteRuleFunc == nil {
+		panic("mocks: l7policies.API.DeleteRuleFunc is not set")
+	}
+	return m.DeleteRuleFunc(policyID, ruleID)
+}
+
+// Get implements l7policies.API.
+func (m *API) Get(policyID string) l7policies.GetResult {
+	m.record("Get", policyID)
+	if m.GetFunc == nil {
+		panic("mocks: l7policies.API.GetFunc is not set")
+	}
+	return m.GetFunc(policyID)
+}
+
+// GetRule implements l7policies.API.
+func (m *API) GetRule(plid string, rlid string) l7policies.GetRuleResult {
+	m.record("GetRule", plid, rlid)
+	if m.GetRuleFunc == nil {
+		panic("mocks: l7policies.API.GetRuleFunc is not set")
+	}
+	return m.GetRuleFunc(plid, rlid)
+}
+
+// List implements l7policies.API.
+func (m *API) List() pagination.Pager {
+	m.record("List")
+	if m.ListFunc == nil {
+		panic("mocks: l7policies.API.ListFunc is not set")
+	}
+	return m.ListFunc()
+}
+
+// ListAll implements l7policies.API.
+func (m *API) ListAll() ([]l7policies.L7Policy, error) {
+	m.record("ListAll")
+	if m.ListAllFunc == nil {
+		panic("mocks: l7policies.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc()
+}
+
+// ListAllRule implements l7policies.API.
+func (m *API) ListAllRule(policyID string) ([]l7policies.L7Rule, error) {
+	m.record("ListAllRule", policyID)
+	if m.ListAllRuleFunc == nil {
+		panic("mocks: l7policies.API.ListAllRuleFunc is not set")
+	}
+	return m.ListAllRuleFunc(policyID)
+}
+
+// ListRule implements l7policies.API.
+func (m *API) ListRule(policyID string) pagination.Pager {
+	m.record("ListRule", policyID)
+	if m.ListRuleFunc == nil {
+		panic("mocks: l7policies.API.ListRuleFunc is not set")
+	}
+	return m.ListRuleFunc(policyID)
+}
+
+// Replace implements l7policies.API.
+func (m *API) Replace(policyID string, opts l7policies.ReplaceOptsBuilder) tasks.Result {
+	m.record("Replace", policyID, opts)
+	if m.ReplaceFunc == nil {
+		panic("mocks: l7policies.API.ReplaceFunc is not set")
+	}
+	return m.ReplaceFunc(policyID, opts)
+}
+
+// ReplaceRule implements l7policies.API.
+func (m *API) ReplaceRule(policyID string, ruleID string, opts l7policies.CreateRuleOptsBuilder) tasks.Result {
+	m.record("ReplaceRule", policyID, ruleID, opts)
+	if m.ReplaceRuleFunc == nil {
+		panic("mocks: l7policies.API.ReplaceRuleFunc is not set")
+	}
+	return m.ReplaceRuleFunc(policyID, ruleID, opts)
+}
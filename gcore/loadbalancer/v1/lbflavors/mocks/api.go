@@ -0,0 +1,73 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of lbflavors.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/loadbalancer/v1/lbflavors"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of lbflavors.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	ListFunc    func() pagination.Pager
+	ListAllFunc func() ([]lbflavors.Flavor, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ lbflavors.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// List implements lbflavors.API.
+func (m *API) List() pagination.Pager {
+	m.record("List")
+	if m.ListFunc == nil {
+		panic("mocks: lbflavors.API.ListFunc is not set")
+	}
+	return m.ListFunc()
+}
+
+// ListAll implements lbflavors.API.
+func (m *API) ListAll() ([]lbflavors.Flavor, error) {
+	m.record("ListAll")
+	if m.ListAllFunc == nil {
+		panic("mocks: lbflavors.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc()
+}
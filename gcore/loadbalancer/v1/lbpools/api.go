@@ -0,0 +1,86 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package lbpools
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of lbpools operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Create(opts CreateOptsBuilder) tasks.Result
+	CreateHealthMonitor(lbpoolID string, opts CreateHealthMonitorOptsBuilder) tasks.Result
+	CreateMember(lbpoolID string, opts CreateMemberOptsBuilder) tasks.Result
+	Delete(lbpoolID string) tasks.Result
+	DeleteHealthMonitor(lbpoolID string) DeleteHealthMonitorResult
+	DeleteMember(lbpoolID string, memberID string) tasks.Result
+	Get(id string) GetResult
+	List(opts ListOptsBuilder) pagination.Pager
+	ListAll(opts ListOptsBuilder) ([]Pool, error)
+	Update(lbpoolID string, opts UpdateOptsBuilder) tasks.Result
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, opts)
+}
+
+// CreateHealthMonitor calls the package level CreateHealthMonitor function with the service client.
+func (s *Service) CreateHealthMonitor(lbpoolID string, opts CreateHealthMonitorOptsBuilder) tasks.Result {
+	return CreateHealthMonitor(s.ServiceClient, lbpoolID, opts)
+}
+
+// CreateMember calls the package level CreateMember function with the service client.
+func (s *Service) CreateMember(lbpoolID string, opts CreateMemberOptsBuilder) tasks.Result {
+	return CreateMember(s.ServiceClient, lbpoolID, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(lbpoolID string) tasks.Result {
+	return Delete(s.ServiceClient, lbpoolID)
+}
+
+// DeleteHealthMonitor calls the package level DeleteHealthMonitor function with the service client.
+func (s *Service) DeleteHealthMonitor(lbpoolID string) DeleteHealthMonitorResult {
+	return DeleteHealthMonitor(s.ServiceClient, lbpoolID)
+}
+
+// DeleteMember calls the package level DeleteMember function with the service client.
+func (s *Service) DeleteMember(lbpoolID string, memberID string) tasks.Result {
+	return DeleteMember(s.ServiceClient, lbpoolID, memberID)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id string) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(opts ListOptsBuilder) ([]Pool, error) {
+	return ListAll(s.ServiceClient, opts)
+}
+
+// Update calls the package level Update function with the service client.
+func (s *Service) Update(lbpoolID string, opts UpdateOptsBuilder) tasks.Result {
+	return Update(s.ServiceClient, lbpoolID, opts)
+}
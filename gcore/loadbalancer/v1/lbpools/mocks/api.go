@@ -0,0 +1,154 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of lbpools.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/loadbalancer/v1/lbpools"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of lbpools.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc              func(opts lbpools.CreateOptsBuilder) tasks.Result
+	CreateHealthMonitorFunc func(lbpoolID string, opts lbpools.CreateHealthMonitorOptsBuilder) tasks.Result
+	CreateMemberFunc        func(lbpoolID string, opts lbpools.CreateMemberOptsBuilder) tasks.Result
+	DeleteFunc              func(lbpoolID string) tasks.Result
+	DeleteHealthMonitorFunc func(lbpoolID string) lbpools.DeleteHealthMonitorResult
+	DeleteMemberFunc        func(lbpoolID string, memberID string) tasks.Result
+	GetFunc                 func(id string) lbpools.GetResult
+	ListFunc                func(opts lbpools.ListOptsBuilder) pagination.Pager
+	ListAllFunc             func(opts lbpools.ListOptsBuilder) ([]lbpools.Pool, error)
+	UpdateFunc              func(lbpoolID string, opts lbpools.UpdateOptsBuilder) tasks.Result
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ lbpools.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements lbpools.API.
+func (m *API) Create(opts lbpools.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: lbpools.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// CreateHealthMonitor implements lbpools.API.
+func (m *API) CreateHealthMonitor(lbpoolID string, opts lbpools.CreateHealthMonitorOptsBuilder) tasks.Result {
+	m.record("CreateHealthMonitor", lbpoolID, opts)
+	if m.CreateHealthMonitorFunc == nil {
+		panic("mocks: lbpools.API.CreateHealthMonitorFunc is not set")
+	}
+	return m.CreateHealthMonitorFunc(lbpoolID, opts)
+}
+
+// CreateMember implements lbpools.API.
+func (m *API) CreateMember(lbpoolID string, opts lbpools.CreateMemberOptsBuilder) tasks.Result {
+	m.record("CreateMember", lbpoolID, opts)
+	if m.CreateMemberFunc == nil {
+		panic("mocks: lbpools.API.CreateMemberFunc is not set")
+	}
+	return m.CreateMemberFunc(lbpoolID, opts)
+}
+
+// Delete implements lbpools.API.
+func (m *API) Delete(lbpoolID string) tasks.Result {
+	m.record("Delete", lbpoolID)
+	if m.DeleteFunc == nil {
+		panic("mocks: lbpools.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(lbpoolID)
+}
+
+// DeleteHealthMonitor implements lbpools.API.
+func (m *API) DeleteHealthMonitor(lbpoolID string) lbpools.DeleteHealthMonitorResult {
+	m.record("DeleteHealthMonitor", lbpoolID)
+	if m.DeleteHealthMonitorFunc == nil {
+		panic("mocks: lbpools.API.DeleteHealthMonitorFunc is not set")
+	}
+	return m.DeleteHealthMonitorFunc(lbpoolID)
+}
+
+// DeleteMember implements lbpools.API.
+func (m *API) DeleteMember(lbpoolID string, memberID string) tasks.Result {
+	m.record("DeleteMember", lbpoolID, memberID)
+	if m.DeleteMemberFunc == nil {
+		panic("mocks: lbpools.API.DeleteMemberFunc is not set")
+	}
+	return m.DeleteMemberFunc(lbpoolID, memberID)
+}
+
+// Get implements lbpools.API.
+func (m *API) Get(id string) lbpools.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: lbpools.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// List implements lbpools.API.
+func (m *API) List(opts lbpools.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: lbpools.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements lbpools.API.
+func (m *API) ListAll(opts lbpools.ListOptsBuilder) ([]lbpools.Pool, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: lbpools.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// Update implements lbpools.API.
+func (m *API) Update(lbpoolID string, opts lbpools.UpdateOptsBuilder) tasks.Result {
+	m.record("Update", lbpoolID, opts)
+	if m.UpdateFunc == nil {
+		panic("mocks: lbpools.API.UpdateFunc is not set")
+	}
+	return m.UpdateFunc(lbpoolID, opts)
+}
@@ -0,0 +1,114 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of listeners.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/loadbalancer/v1/listeners"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of listeners.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc  func(opts listeners.CreateOptsBuilder) tasks.Result
+	DeleteFunc  func(listenerID string) tasks.Result
+	GetFunc     func(id string) listeners.GetResult
+	ListFunc    func(opts listeners.ListOptsBuilder) pagination.Pager
+	ListAllFunc func(opts listeners.ListOptsBuilder) ([]listeners.Listener, error)
+	UpdateFunc  func(listenerID string, opts listeners.UpdateOptsBuilder) tasks.Result
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ listeners.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements listeners.API.
+func (m *API) Create(opts listeners.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: listeners.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements listeners.API.
+func (m *API) Delete(listenerID string) tasks.Result {
+	m.record("Delete", listenerID)
+	if m.DeleteFunc == nil {
+		panic("mocks: listeners.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(listenerID)
+}
+
+// Get implements listeners.API.
+func (m *API) Get(id string) listeners.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: listeners.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// List implements listeners.API.
+func (m *API) List(opts listeners.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: listeners.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements listeners.API.
+func (m *API) ListAll(opts listeners.ListOptsBuilder) ([]listeners.Listener, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: listeners.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// Update implements listeners.API.
+func (m *API) Update(listenerID string, opts listeners.UpdateOptsBuilder) tasks.Result {
+	m.record("Update", listenerID, opts)
+	if m.UpdateFunc == nil {
+		panic("mocks: listeners.API.UpdateFunc is not set")
+	}
+	return m.UpdateFunc(listenerID, opts)
+}
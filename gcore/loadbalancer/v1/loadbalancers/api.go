@@ -0,0 +1,87 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package loadbalancers
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/loadbalancer/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of loadbalancers operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Create(opts CreateOptsBuilder) tasks.Result
+	CreateCustomSecurityGroup(loadbalancerID string) CustomSecurityGroupCreateResult
+	Delete(loadbalancerID string) tasks.Result
+	Get(id string) GetResult
+	List(opts ListOptsBuilder) pagination.Pager
+	ListAll(opts ListOptsBuilder) ([]LoadBalancer, error)
+	ListCustomSecurityGroup(loadbalancerID string) CustomSecurityGroupGetResult
+	Update(loadbalancerID string, opts UpdateOptsBuilder) UpdateResult
+	WaitForActive(id string, opts gcorecloud.WaitOpts) (*LoadBalancer, error)
+	WaitForStatus(id string, provisioning types.ProvisioningStatus, operating types.OperatingStatus, opts gcorecloud.WaitOpts) (*LoadBalancer, error)
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, opts)
+}
+
+// CreateCustomSecurityGroup calls the package level CreateCustomSecurityGroup function with the service client.
+func (s *Service) CreateCustomSecurityGroup(loadbalancerID string) CustomSecurityGroupCreateResult {
+	return CreateCustomSecurityGroup(s.ServiceClient, loadbalancerID)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(loadbalancerID string) tasks.Result {
+	return Delete(s.ServiceClient, loadbalancerID)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id string) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(opts ListOptsBuilder) ([]LoadBalancer, error) {
+	return ListAll(s.ServiceClient, opts)
+}
+
+// ListCustomSecurityGroup calls the package level ListCustomSecurityGroup function with the service client.
+func (s *Service) ListCustomSecurityGroup(loadbalancerID string) CustomSecurityGroupGetResult {
+	return ListCustomSecurityGroup(s.ServiceClient, loadbalancerID)
+}
+
+// Update calls the package level Update function with the service client.
+func (s *Service) Update(loadbalancerID string, opts UpdateOptsBuilder) UpdateResult {
+	return Update(s.ServiceClient, loadbalancerID, opts)
+}
+
+// WaitForActive calls the package level WaitForActive function with the service client.
+func (s *Service) WaitForActive(id string, opts gcorecloud.WaitOpts) (*LoadBalancer, error) {
+	return WaitForActive(s.ServiceClient, id, opts)
+}
+
+// WaitForStatus calls the package level WaitForStatus function with the service client.
+func (s *Service) WaitForStatus(id string, provisioning types.ProvisioningStatus, operating types.OperatingStatus, opts gcorecloud.WaitOpts) (*LoadBalancer, error) {
+	return WaitForStatus(s.ServiceClient, id, provisioning, operating, opts)
+}
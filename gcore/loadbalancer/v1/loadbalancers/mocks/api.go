@@ -0,0 +1,156 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of loadbalancers.API.
+package mocks
+
+import (
+	"sync"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/loadbalancer/v1/loadbalancers"
+	"github.com/G-Core/gcorelabscloud-go/gcore/loadbalancer/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of loadbalancers.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc                    func(opts loadbalancers.CreateOptsBuilder) tasks.Result
+	CreateCustomSecurityGroupFunc func(loadbalancerID string) loadbalancers.CustomSecurityGroupCreateResult
+	DeleteFunc                    func(loadbalancerID string) tasks.Result
+	GetFunc                       func(id string) loadbalancers.GetResult
+	ListFunc                      func(opts loadbalancers.ListOptsBuilder) pagination.Pager
+	ListAllFunc                   func(opts loadbalancers.ListOptsBuilder) ([]loadbalancers.LoadBalancer, error)
+	ListCustomSecurityGroupFunc   func(loadbalancerID string) loadbalancers.CustomSecurityGroupGetResult
+	UpdateFunc                    func(loadbalancerID string, opts loadbalancers.UpdateOptsBuilder) loadbalancers.UpdateResult
+	WaitForActiveFunc             func(id string, opts gcorecloud.WaitOpts) (*loadbalancers.LoadBalancer, error)
+	WaitForStatusFunc             func(id string, provisioning types.ProvisioningStatus, operating types.OperatingStatus, opts gcorecloud.WaitOpts) (*loadbalancers.LoadBalancer, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ loadbalancers.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements loadbalancers.API.
+func (m *API) Create(opts loadbalancers.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: loadbalancers.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// CreateCustomSecurityGroup implements loadbalancers.API.
+func (m *API) CreateCustomSecurityGroup(loadbalancerID string) loadbalancers.CustomSecurityGroupCreateResult {
+	m.record("CreateCustomSecurityGroup", loadbalancerID)
+	if m.CreateCustomSecurityGroupFunc == nil {
+		panic("mocks: loadbalancers.API.CreateCustomSecurityGroupFunc is not set")
+	}
+	return m.CreateCustomSecurityGroupFunc(loadbalancerID)
+}
+
+// Delete implements loadbalancers.API.
+func (m *API) Delete(loadbalancerID string) tasks.Result {
+	m.record("Delete", loadbalancerID)
+	if m.DeleteFunc == nil {
+		panic("mocks: loadbalancers.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(loadbalancerID)
+}
+
+// Get implements loadbalancers.API.
+func (m *API) Get(id string) loadbalancers.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: loadbalancers.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// List implements loadbalancers.API.
+func (m *API) List(opts loadbalancers.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: loadbalancers.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements loadbalancers.API.
+func (m *API) ListAll(opts loadbalancers.ListOptsBuilder) ([]loadbalancers.LoadBalancer, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: loadbalancers.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// ListCustomSecurityGroup implements loadbalancers.API.
+func (m *API) ListCustomSecurityGroup(loadbalancerID string) loadbalancers.CustomSecurityGroupGetResult {
+	m.record("ListCustomSecurityGroup", loadbalancerID)
+	if m.ListCustomSecurityGroupFunc == nil {
+		panic("mocks: loadbalancers.API.ListCustomSecurityGroupFunc is not set")
+	}
+	return m.ListCustomSecurityGroupFunc(loadbalancerID)
+}
+
+// Update implements loadbalancers.API.
+func (m *API) Update(loadbalancerID string, opts loadbalancers.UpdateOptsBuilder) loadbalancers.UpdateResult {
+	m.record("Update", loadbalancerID, opts)
+	if m.UpdateFunc == nil {
+		panic("mocks: loadbalancers.API.UpdateFunc is not set")
+	}
+	return m.UpdateFunc(loadbalancerID, opts)
+}
+
+// WaitForActive implements loadbalancers.API.
+func (m *API) WaitForActive(id string, opts gcorecloud.WaitOpts) (*loadbalancers.LoadBalancer, error) {
+	m.record("WaitForActive", id, opts)
+	if m.WaitForActiveFunc == nil {
+		panic("mocks: loadbalancers.API.WaitForActiveFunc is not set")
+	}
+	return m.WaitForActiveFunc(id, opts)
+}
+
+// WaitForStatus implements loadbalancers.API.
+func (m *API) WaitForStatus(id string, provisioning types.ProvisioningStatus, operating types.OperatingStatus, opts gcorecloud.WaitOpts) (*loadbalancers.LoadBalancer, error) {
+	m.record("WaitForStatus", id, provisioning, operating, opts)
+	if m.WaitForStatusFunc == nil {
+		panic("mocks: loadbalancers.API.WaitForStatusFunc is not set")
+	}
+	return m.WaitForStatusFunc(id, provisioning, operating, opts)
+}
@@ -1,6 +1,7 @@
 package loadbalancers
 
 import (
+	"fmt"
 	"net"
 	"net/http"
 
@@ -226,3 +227,36 @@ func ListCustomSecurityGroup(c *gcorecloud.ServiceClient, loadbalancerID string)
 	_, r.Err = c.Get(createCustomSecurityGroupURL(c, loadbalancerID), &r.Body, nil)
 	return
 }
+
+// WaitForStatus polls the loadbalancer until it reaches the provisioning status and, if set, the operating status.
+// It fails fast when either status is ERROR. The wait is cancelled with the context of the client.
+func WaitForStatus(c *gcorecloud.ServiceClient, id string, provisioning types.ProvisioningStatus, operating types.OperatingStatus, opts gcorecloud.WaitOpts) (*LoadBalancer, error) {
+	var lb *LoadBalancer
+	err := c.WaitForResource(id, opts, func(c *gcorecloud.ServiceClient) (bool, error) {
+		current, err := Get(c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		lb = current
+		if lb.ProvisioningStatus == provisioning && (operating == "" || lb.OperationStatus == operating) {
+			return true, nil
+		}
+		if lb.ProvisioningStatus == types.ProvisioningStatusError || lb.OperationStatus == types.OperatingStatusOperatingError {
+			return false, gcorecloud.ErrResourceErrorState
+		}
+		return false, nil
+	})
+	if err != nil {
+		if lb != nil {
+			return lb, fmt.Errorf("waiting for loadbalancer %s status %s/%s, current status %s/%s: %w",
+				id, provisioning, operating, lb.ProvisioningStatus, lb.OperationStatus, err)
+		}
+		return nil, fmt.Errorf("waiting for loadbalancer %s status %s/%s: %w", id, provisioning, operating, err)
+	}
+	return lb, nil
+}
+
+// WaitForActive polls the loadbalancer until its provisioning status is ACTIVE and its operating status is ONLINE.
+func WaitForActive(c *gcorecloud.ServiceClient, id string, opts gcorecloud.WaitOpts) (*LoadBalancer, error) {
+	return WaitForStatus(c, id, types.ProvisioningStatusActive, types.OperatingStatusOnline, opts)
+}
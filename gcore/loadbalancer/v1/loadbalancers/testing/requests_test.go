@@ -1,11 +1,14 @@
 package testing
 
 import (
+	"errors"
 	"fmt"
 	metadataV1Testing "github.com/G-Core/gcorelabscloud-go/gcore/utils/metadata/v1/metadata/testing"
 	"net"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -17,6 +20,8 @@ import (
 
 	"github.com/G-Core/gcorelabscloud-go/pagination"
 	th "github.com/G-Core/gcorelabscloud-go/testhelper"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 )
 
 func prepareListTestURLParams(projectID int, regionID int) string {
@@ -337,3 +342,54 @@ func TestMetadataUpdate(t *testing.T) {
 func TestMetadataDelete(t *testing.T) {
 	metadataV1Testing.BuildTestMetadataDelete("loadbalancers", LoadBalancer1.ID)(t)
 }
+
+func handleLoadBalancerStatuses(t *testing.T, statuses ...[2]string) *int {
+	calls := 0
+	th.Mux.HandleFunc(prepareGetTestURL(LoadBalancer1.ID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Authorization", fmt.Sprintf("Bearer %s", fake.AccessToken))
+
+		status := statuses[len(statuses)-1]
+		if calls < len(statuses) {
+			status = statuses[calls]
+		}
+		calls++
+		response := strings.Replace(GetResponse, `"provisioning_status": "ACTIVE"`, fmt.Sprintf(`"provisioning_status": %q`, status[0]), 1)
+		response = strings.Replace(response, `"operating_status": "ONLINE"`, fmt.Sprintf(`"operating_status": %q`, status[1]), 1)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprint(w, response)
+		if err != nil {
+			log.Error(err)
+		}
+	})
+	return &calls
+}
+
+func TestWaitForActive(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := handleLoadBalancerStatuses(t, [2]string{"PENDING_UPDATE", "ONLINE"}, [2]string{"ACTIVE", "OFFLINE"}, [2]string{"ACTIVE", "ONLINE"})
+
+	client := fake.ServiceTokenClient("loadbalancers", "v1")
+	lb, err := loadbalancers.WaitForActive(client, LoadBalancer1.ID, gcorecloud.WaitOpts{Interval: time.Millisecond})
+
+	require.NoError(t, err)
+	require.Equal(t, types.ProvisioningStatusActive, lb.ProvisioningStatus)
+	require.Equal(t, types.OperatingStatusOnline, lb.OperationStatus)
+	require.Equal(t, 3, *calls)
+}
+
+func TestWaitForStatusErrorState(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := handleLoadBalancerStatuses(t, [2]string{"PENDING_CREATE", "OFFLINE"}, [2]string{"ERROR", "ERROR"}, [2]string{"ACTIVE", "ONLINE"})
+
+	client := fake.ServiceTokenClient("loadbalancers", "v1")
+	_, err := loadbalancers.WaitForStatus(client, LoadBalancer1.ID, types.ProvisioningStatusActive, "", gcorecloud.WaitOpts{Interval: time.Millisecond})
+
+	require.Error(t, err)
+	require.True(t, errors.Is(err, gcorecloud.ErrResourceErrorState))
+	require.Equal(t, 2, *calls)
+}
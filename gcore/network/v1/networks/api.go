@@ -0,0 +1,110 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package networks
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of networks operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Create(opts CreateOptsBuilder) tasks.Result
+	Delete(networkID string) tasks.Result
+	Get(id string) GetResult
+	IDFromName(name string) (string, error)
+	List(opts ListOptsBuilder) pagination.Pager
+	ListAll(opts ListOptsBuilder) ([]Network, error)
+	ListAllInstancePort(networkID string) GetInstancePortResult
+	MetadataCreateOrUpdate(id string, opts map[string]interface{}) MetadataActionResult
+	MetadataDelete(id string, key string) MetadataActionResult
+	MetadataGet(id string, key string) MetadataResult
+	MetadataList(id string) pagination.Pager
+	MetadataListAll(id string) ([]Metadata, error)
+	MetadataReplace(id string, opts map[string]interface{}) MetadataActionResult
+	Update(networkID string, opts UpdateOptsBuilder) UpdateResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(networkID string) tasks.Result {
+	return Delete(s.ServiceClient, networkID)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id string) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// IDFromName calls the package level IDFromName function with the service client.
+func (s *Service) IDFromName(name string) (string, error) {
+	return IDFromName(s.ServiceClient, name)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(opts ListOptsBuilder) ([]Network, error) {
+	return ListAll(s.ServiceClient, opts)
+}
+
+// ListAllInstancePort calls the package level ListAllInstancePort function with the service client.
+func (s *Service) ListAllInstancePort(networkID string) GetInstancePortResult {
+	return ListAllInstancePort(s.ServiceClient, networkID)
+}
+
+// MetadataCreateOrUpdate calls the package level MetadataCreateOrUpdate function with the service client.
+func (s *Service) MetadataCreateOrUpdate(id string, opts map[string]interface{}) MetadataActionResult {
+	return MetadataCreateOrUpdate(s.ServiceClient, id, opts)
+}
+
+// MetadataDelete calls the package level MetadataDelete function with the service client.
+func (s *Service) MetadataDelete(id string, key string) MetadataActionResult {
+	return MetadataDelete(s.ServiceClient, id, key)
+}
+
+// MetadataGet calls the package level MetadataGet function with the service client.
+func (s *Service) MetadataGet(id string, key string) MetadataResult {
+	return MetadataGet(s.ServiceClient, id, key)
+}
+
+// MetadataList calls the package level MetadataList function with the service client.
+func (s *Service) MetadataList(id string) pagination.Pager {
+	return MetadataList(s.ServiceClient, id)
+}
+
+// MetadataListAll calls the package level MetadataListAll function with the service client.
+func (s *Service) MetadataListAll(id string) ([]Metadata, error) {
+	return MetadataListAll(s.ServiceClient, id)
+}
+
+// MetadataReplace calls the package level MetadataReplace function with the service client.
+func (s *Service) MetadataReplace(id string, opts map[string]interface{}) MetadataActionResult {
+	return MetadataReplace(s.ServiceClient, id, opts)
+}
+
+// Update calls the package level Update function with the service client.
+func (s *Service) Update(networkID string, opts UpdateOptsBuilder) UpdateResult {
+	return Update(s.ServiceClient, networkID, opts)
+}
@@ -0,0 +1,194 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of networks.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/network/v1/networks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of networks.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc                 func(opts networks.CreateOptsBuilder) tasks.Result
+	DeleteFunc                 func(networkID string) tasks.Result
+	GetFunc                    func(id string) networks.GetResult
+	IDFromNameFunc             func(name string) (string, error)
+	ListFunc                   func(opts networks.ListOptsBuilder) pagination.Pager
+	ListAllFunc                func(opts networks.ListOptsBuilder) ([]networks.Network, error)
+	ListAllInstancePortFunc    func(networkID string) networks.GetInstancePortResult
+	MetadataCreateOrUpdateFunc func(id string, opts map[string]interface{}) networks.MetadataActionResult
+	MetadataDeleteFunc         func(id string, key string) networks.MetadataActionResult
+	MetadataGetFunc            func(id string, key string) networks.MetadataResult
+	MetadataListFunc           func(id string) pagination.Pager
+	MetadataListAllFunc        func(id string) ([]networks.Metadata, error)
+	MetadataReplaceFunc        func(id string, opts map[string]interface{}) networks.MetadataActionResult
+	UpdateFunc                 func(networkID string, opts networks.UpdateOptsBuilder) networks.UpdateResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ networks.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements networks.API.
+func (m *API) Create(opts networks.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: networks.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements networks.API.
+func (m *API) Delete(networkID string) tasks.Result {
+	m.record("Delete", networkID)
+	if m.DeleteFunc == nil {
+		panic("mocks: networks.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(networkID)
+}
+
+// Get implements networks.API.
+func (m *API) Get(id string) networks.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: networks.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// IDFromName implements networks.API.
+func (m *API) IDFromName(name string) (string, error) {
+	m.record("IDFromName", name)
+	if m.IDFromNameFunc == nil {
+		panic("mocks: networks.API.IDFromNameFunc is not set")
+	}
+	return m.IDFromNameFunc(name)
+}
+
+// List implements networks.API.
+func (m *API) List(opts networks.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: networks.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements networks.API.
+func (m *API) ListAll(opts networks.ListOptsBuilder) ([]networks.Network, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: networks.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// ListAllInstancePort implements networks.API.
+func (m *API) ListAllInstancePort(networkID string) networks.GetInstancePortResult {
+	m.record("ListAllInstancePort", networkID)
+	if m.ListAllInstancePortFunc == nil {
+		panic("mocks: networks.API.ListAllInstancePortFunc is not set")
+	}
+	return m.ListAllInstancePortFunc(networkID)
+}
+
+// MetadataCreateOrUpdate implements networks.API.
+func (m *API) MetadataCreateOrUpdate(id string, opts map[string]interface{}) networks.MetadataActionResult {
+	m.record("MetadataCreateOrUpdate", id, opts)
+	if m.MetadataCreateOrUpdateFunc == nil {
+		panic("mocks: networks.API.MetadataCreateOrUpdateFunc is not set")
+	}
+	return m.MetadataCreateOrUpdateFunc(id, opts)
+}
+
+// MetadataDelete implements networks.API.
+func (m *API) MetadataDelete(id string, key string) networks.MetadataActionResult {
+	m.record("MetadataDelete", id, key)
+	if m.MetadataDeleteFunc == nil {
+		panic("mocks: networks.API.MetadataDeleteFunc is not set")
+	}
+	return m.MetadataDeleteFunc(id, key)
+}
+
+// MetadataGet implements networks.API.
+func (m *API) MetadataGet(id string, key string) networks.MetadataResult {
+	m.record("MetadataGet", id, key)
+	if m.MetadataGetFunc == nil {
+		panic("mocks: networks.API.MetadataGetFunc is not set")
+	}
+	return m.MetadataGetFunc(id, key)
+}
+
+// MetadataList implements networks.API.
+func (m *API) MetadataList(id string) pagination.Pager {
+	m.record("MetadataList", id)
+	if m.MetadataListFunc == nil {
+		panic("mocks: networks.API.MetadataListFunc is not set")
+	}
+	return m.MetadataListFunc(id)
+}
+
+// MetadataListAll implements networks.API.
+func (m *API) MetadataListAll(id string) ([]networks.Metadata, error) {
+	m.record("MetadataListAll", id)
+	if m.MetadataListAllFunc == nil {
+		panic("mocks: networks.API.MetadataListAllFunc is not set")
+	}
+	return m.MetadataListAllFunc(id)
+}
+
+// MetadataReplace implements networks.API.
+func (m *API) MetadataReplace(id string, opts map[string]interface{}) networks.MetadataActionResult {
+	m.record("MetadataReplace", id, opts)
+	if m.MetadataReplaceFunc == nil {
+		panic("mocks: networks.API.MetadataReplaceFunc is not set")
+	}
+	return m.MetadataReplaceFunc(id, opts)
+}
+
+// Update implements networks.API.
+func (m *API) Update(networkID string, opts networks.UpdateOptsBuilder) networks.UpdateResult {
+	m.record("Update", networkID, opts)
+	if m.UpdateFunc == nil {
+		panic("mocks: networks.API.UpdateFunc is not set")
+	}
+	return m.UpdateFunc(networkID, opts)
+}
@@ -0,0 +1,62 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of topology.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/network/v1/topology"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of topology.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	BuildFunc func(opts topology.Opts) (*topology.Graph, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ topology.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Build implements topology.API.
+func (m *API) Build(opts topology.Opts) (*topology.Graph, error) {
+	m.record("Build", opts)
+	if m.BuildFunc == nil {
+		panic("mocks: topology.API.BuildFunc is not set")
+	}
+	return m.BuildFunc(opts)
+}
@@ -0,0 +1,287 @@
+package topology
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/floatingip/v1/floatingips"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/network/v1/networks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/reservedfixedip/v1/reservedfixedips"
+	"github.com/G-Core/gcorelabscloud-go/gcore/router/v1/routers"
+	"github.com/G-Core/gcorelabscloud-go/gcore/subnet/v1/subnets"
+	"github.com/G-Core/gcorelabscloud-go/gcore/utils/metadata"
+)
+
+// Opts selects the networks of the graph. All the networks are selected when it is empty.
+type Opts struct {
+	// NetworkIDs selects the networks by ID.
+	NetworkIDs []string
+	// Metadata selects the networks having all the key-value pairs.
+	Metadata map[string]string
+}
+
+// selected reports whether the network is selected by the options.
+func (opts Opts) selected(network networks.Network) bool {
+	if len(opts.NetworkIDs) > 0 {
+		found := false
+		for _, id := range opts.NetworkIDs {
+			if id == network.ID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for key, value := range opts.Metadata {
+		if !hasMetadata(network.Metadata, key, value) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasMetadata(md []metadata.Metadata, key, value string) bool {
+	for _, m := range md {
+		if m.Key == key && m.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (opts Opts) filtered() bool {
+	return len(opts.NetworkIDs) > 0 || len(opts.Metadata) > 0
+}
+
+// builder accumulates the graph of the selected networks.
+type builder struct {
+	client *gcorecloud.ServiceClient
+	opts   Opts
+	graph  *Graph
+	// networks keeps the selected networks by ID.
+	networks map[string]bool
+	// cidrs keeps the CIDRs of the subnets of the graph by node ID.
+	cidrs map[string]net.IPNet
+}
+
+// Build returns the graph of the networks of the client project and region, with their subnets, the routers
+// attached to them, the ports of the instances and baremetal servers, the VIPs and the floating IPs. When networks are
+// selected, only the resources connected to them are part of the graph, as well as the external networks of their
+// routers.
+func Build(client *gcorecloud.ServiceClient, opts Opts) (*Graph, error) {
+	b := &builder{client: client, opts: opts, graph: NewGraph(), networks: map[string]bool{}, cidrs: map[string]net.IPNet{}}
+	for _, step := range []func() error{b.addNetworks, b.addSubnets, b.addRouters, b.addInstances, b.addVIPs, b.addFloatingIPs} {
+		if err := step(); err != nil {
+			return nil, err
+		}
+	}
+	return b.graph, nil
+}
+
+func (b *builder) addNetworks() error {
+	all, err := networks.ListAll(b.client.ForService("networks"), nil)
+	if err != nil {
+		return fmt.Errorf("listing networks: %w", err)
+	}
+	for _, n := range all {
+		if !b.opts.selected(n) {
+			continue
+		}
+		b.networks[n.ID] = true
+		b.graph.AddNode(NetworkNode, n.ID, n.Name, map[string]string{
+			"type":     n.Type,
+			"external": strconv.FormatBool(n.External),
+		})
+	}
+	return nil
+}
+
+func (b *builder) addSubnets() error {
+	all, err := subnets.ListAll(b.client.ForService("subnets"), nil)
+	if err != nil {
+		return fmt.Errorf("listing subnets: %w", err)
+	}
+	for _, s := range all {
+		if !b.networks[s.NetworkID] {
+			continue
+		}
+		attributes := map[string]string{"cidr": s.CIDR.String()}
+		if s.GatewayIP != nil {
+			attributes["gateway_ip"] = s.GatewayIP.String()
+		}
+		node := b.graph.AddNode(SubnetNode, s.ID, s.Name, attributes)
+		b.graph.AddEdge(NodeID(NetworkNode, s.NetworkID), node.ID, "")
+		b.cidrs[node.ID] = s.CIDR.IPNet
+	}
+	return nil
+}
+
+// attach links a port or VIP with its subnets, or with its network when it has no address.
+func (b *builder) attach(nodeID, networkID string, assignments []instances.PortIP) {
+	linked := false
+	for _, a := range assignments {
+		if _, ok := b.graph.Node(SubnetNode, a.SubnetID); ok {
+			b.graph.AddEdge(NodeID(SubnetNode, a.SubnetID), nodeID, a.IPAddress.String())
+			linked = true
+		}
+	}
+	if !linked {
+		b.graph.AddEdge(NodeID(NetworkNode, networkID), nodeID, "")
+	}
+}
+
+func (b *builder) addRouters() error {
+	all, err := routers.ListAll(b.client.ForService("routers"), nil)
+	if err != nil {
+		return fmt.Errorf("listing routers: %w", err)
+	}
+	for _, r := range all {
+		var links []Edge
+		for _, iface := range r.Interfaces {
+			for _, a := range iface.IPAssignments {
+				if _, ok := b.graph.Node(SubnetNode, a.SubnetID); ok {
+					links = append(links, Edge{To: NodeID(SubnetNode, a.SubnetID), Label: a.IPAddress.String()})
+				}
+			}
+		}
+		// Routers without interface in the selected networks are skipped.
+		if len(links) == 0 && b.opts.filtered() {
+			continue
+		}
+		gatewayID := r.ExternalGatewayInfo.NetworkID
+		node := b.graph.AddNode(RouterNode, r.ID, r.Name, map[string]string{"status": r.Status})
+		for _, link := range links {
+			b.graph.AddEdge(node.ID, link.To, link.Label)
+		}
+		if gatewayID == "" {
+			continue
+		}
+		if _, ok := b.graph.Node(NetworkNode, gatewayID); !ok {
+			b.graph.AddNode(NetworkNode, gatewayID, "", map[string]string{"external": "true"})
+		}
+		label := "gateway"
+		for _, ip := range r.ExternalGatewayInfo.ExternalFixedIPs {
+			label += " " + ip.IPAddress
+		}
+		b.graph.AddEdge(node.ID, NodeID(NetworkNode, gatewayID), label)
+	}
+	return nil
+}
+
+func (b *builder) addPort(instanceNodeID, networkID, portID string, mac gcorecloud.MAC, assignments []instances.PortIP) string {
+	node := b.graph.AddNode(PortNode, portID, "", map[string]string{
+		"network_id":  networkID,
+		"mac_address": mac.String(),
+	})
+	b.graph.AddEdge(instanceNodeID, node.ID, "")
+	b.attach(node.ID, networkID, assignments)
+	return node.ID
+}
+
+func (b *builder) addInstances() error {
+	client := b.client.ForService("instances")
+	all, err := instances.ListAll(client, instances.ListOpts{IncludeBaremetal: true})
+	if err != nil {
+		return fmt.Errorf("listing instances: %w", err)
+	}
+	for _, instance := range all {
+		interfaces, err := instances.ListInterfacesAll(client, instance.ID)
+		if err != nil {
+			return fmt.Errorf("listing interfaces of instance %s: %w", instance.ID, err)
+		}
+		instanceNodeID := NodeID(InstanceNode, instance.ID)
+		for _, iface := range interfaces {
+			if b.networks[iface.NetworkID] {
+				b.graph.AddNode(InstanceNode, instance.ID, instance.Name, map[string]string{
+					"status": instance.Status,
+					"flavor": instance.Flavor.FlavorName,
+				})
+				portNodeID := b.addPort(instanceNodeID, iface.NetworkID, iface.PortID, iface.MacAddress, iface.IPAssignments)
+				for _, sub := range iface.SubPorts {
+					if b.networks[sub.NetworkID] {
+						subNodeID := b.addPort(instanceNodeID, sub.NetworkID, sub.PortID, sub.MacAddress, sub.IPAssignments)
+						b.graph.AddEdge(portNodeID, subNodeID, fmt.Sprintf("%s %d", sub.SegmentationType, sub.SegmentationID))
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (b *builder) addVIPs() error {
+	client := b.client.ForService("reserved_fixed_ips")
+	all, err := reservedfixedips.ListAll(client, nil)
+	if err != nil {
+		return fmt.Errorf("listing reserved fixed IPs: %w", err)
+	}
+	for _, ip := range all {
+		if !ip.IsVip || !b.networks[ip.NetworkID] {
+			continue
+		}
+		node := b.graph.AddNode(VIPNode, ip.PortID, ip.Name, map[string]string{
+			"ip_address": ip.FixedIPAddress.String(),
+			"status":     ip.Status,
+		})
+		b.attach(node.ID, ip.NetworkID, []instances.PortIP{{IPAddress: ip.FixedIPAddress, SubnetID: ip.SubnetID}})
+		devices, err := reservedfixedips.ListAllConnectedDevice(client, ip.PortID)
+		if err != nil {
+			return fmt.Errorf("listing devices of VIP %s: %w", ip.PortID, err)
+		}
+		for _, device := range devices {
+			if port, ok := b.graph.Node(PortNode, device.PortID); ok {
+				b.graph.AddEdge(node.ID, port.ID, "shared")
+			}
+		}
+	}
+	return nil
+}
+
+func (b *builder) addFloatingIPs() error {
+	all, err := floatingips.ListAll(b.client.ForService("floatingips"), nil)
+	if err != nil {
+		return fmt.Errorf("listing floating IPs: %w", err)
+	}
+	for _, fip := range all {
+		target := b.floatingIPTarget(fip)
+		if target == "" && b.opts.filtered() {
+			continue
+		}
+		node := b.graph.AddNode(FloatingIPNode, fip.ID, "", map[string]string{
+			"ip_address": fip.FloatingIPAddress.String(),
+			"status":     fip.Status,
+		})
+		if target != "" {
+			b.graph.AddEdge(node.ID, target, fip.FixedIPAddress.String())
+		}
+	}
+	return nil
+}
+
+// floatingIPTarget returns the ID of the node the floating IP is linked to: the port or the VIP it is assigned to,
+// else the subnet of its fixed IP, e.g. for a reserved fixed IP or a load balancer port. It is empty when none of them
+// is part of the graph.
+func (b *builder) floatingIPTarget(fip floatingips.FloatingIPDetail) string {
+	if fip.PortID == "" {
+		return ""
+	}
+	for _, kind := range []NodeKind{PortNode, VIPNode} {
+		if node, ok := b.graph.Node(kind, fip.PortID); ok {
+			return node.ID
+		}
+	}
+	if fip.FixedIPAddress == nil {
+		return ""
+	}
+	for _, node := range b.graph.Nodes {
+		if cidr, ok := b.cidrs[node.ID]; ok && cidr.Contains(fip.FixedIPAddress) {
+			return node.ID
+		}
+	}
+	return ""
+}
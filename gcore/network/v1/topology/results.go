@@ -0,0 +1,196 @@
+package topology
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Node is a resource of the graph. Its ID is the resource kind and ID joined with a colon, e.g. "subnet:<id>".
+type Node struct {
+	ID         string            `json:"id"`
+	Kind       NodeKind          `json:"kind"`
+	ResourceID string            `json:"resource_id"`
+	Name       string            `json:"name,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// Edge links two nodes, from the resource providing the connectivity to the one using it.
+type Edge struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Label string `json:"label,omitempty"`
+}
+
+// Graph is the topology of the networks of a project in a region.
+type Graph struct {
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+
+	index map[string]int
+	edges map[Edge]bool
+}
+
+// NodeID returns the ID of the node of a resource.
+func NodeID(kind NodeKind, resourceID string) string {
+	return fmt.Sprintf("%s:%s", kind, resourceID)
+}
+
+// NewGraph returns an empty graph.
+func NewGraph() *Graph {
+	return &Graph{Nodes: []Node{}, Edges: []Edge{}, index: map[string]int{}, edges: map[Edge]bool{}}
+}
+
+// AddNode adds the node of a resource unless it is in the graph, and returns it.
+func (g *Graph) AddNode(kind NodeKind, resourceID, name string, attributes map[string]string) *Node {
+	id := NodeID(kind, resourceID)
+	if i, ok := g.index[id]; ok {
+		return &g.Nodes[i]
+	}
+	g.index[id] = len(g.Nodes)
+	g.Nodes = append(g.Nodes, Node{ID: id, Kind: kind, ResourceID: resourceID, Name: name, Attributes: attributes})
+	return &g.Nodes[len(g.Nodes)-1]
+}
+
+// Node returns the node of a resource.
+func (g *Graph) Node(kind NodeKind, resourceID string) (*Node, bool) {
+	i, ok := g.index[NodeID(kind, resourceID)]
+	if !ok {
+		return nil, false
+	}
+	return &g.Nodes[i], true
+}
+
+// AddEdge links two nodes of the graph. Duplicate edges are ignored.
+func (g *Graph) AddEdge(from, to, label string) {
+	e := Edge{From: from, To: to, Label: label}
+	if g.edges[e] {
+		return
+	}
+	g.edges[e] = true
+	g.Edges = append(g.Edges, e)
+}
+
+// Label returns the text shown for the node: its kind, its name or ID and its address, if any.
+func (n Node) Label() string {
+	name := n.Name
+	if name == "" {
+		name = n.ResourceID
+	}
+	parts := []string{n.Kind.String(), name}
+	for _, key := range []string{"cidr", "ip_address"} {
+		if v := n.Attributes[key]; v != "" {
+			parts = append(parts, v)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+var dotShapes = map[NodeKind]string{
+	NetworkNode:    "folder",
+	SubnetNode:     "box",
+	RouterNode:     "diamond",
+	PortNode:       "ellipse",
+	InstanceNode:   "component",
+	VIPNode:        "doubleoctagon",
+	FloatingIPNode: "cds",
+}
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + strings.ReplaceAll(s, "\n", `\n`) + `"`
+}
+
+// DOT renders the graph in the Graphviz DOT language.
+func (g *Graph) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph topology {\n\trankdir=LR;\n")
+	for _, n := range g.Nodes {
+		fmt.Fprintf(&b, "\t%s [label=%s, shape=%s];\n", dotQuote(n.ID), dotQuote(n.Label()), dotShapes[n.Kind])
+	}
+	for _, e := range g.Edges {
+		if e.Label == "" {
+			fmt.Fprintf(&b, "\t%s -> %s;\n", dotQuote(e.From), dotQuote(e.To))
+			continue
+		}
+		fmt.Fprintf(&b, "\t%s -> %s [label=%s];\n", dotQuote(e.From), dotQuote(e.To), dotQuote(e.Label))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+var mermaidShapes = map[NodeKind][2]string{
+	NetworkNode:    {"[[", "]]"},
+	SubnetNode:     {"[", "]"},
+	RouterNode:     {"{{", "}}"},
+	PortNode:       {"([", "])"},
+	InstanceNode:   {"[/", "/]"},
+	VIPNode:        {"((", "))"},
+	FloatingIPNode: {">", "]"},
+}
+
+func mermaidEscape(s string) string {
+	s = strings.ReplaceAll(s, `"`, "#quot;")
+	s = strings.ReplaceAll(s, "|", "#124;")
+	return strings.ReplaceAll(s, "\n", "<br/>")
+}
+
+// Mermaid renders the graph as a Mermaid flowchart. The nodes are numbered in the order of the graph, as Mermaid
+// identifiers can't hold the characters of the node IDs.
+func (g *Graph) Mermaid() string {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	ids := make(map[string]string, len(g.Nodes))
+	for i, n := range g.Nodes {
+		ids[n.ID] = fmt.Sprintf("n%d", i)
+		shape := mermaidShapes[n.Kind]
+		fmt.Fprintf(&b, "\t%s%s\"%s\"%s\n", ids[n.ID], shape[0], mermaidEscape(n.Label()), shape[1])
+	}
+	for _, e := range g.Edges {
+		if e.Label == "" {
+			fmt.Fprintf(&b, "\t%s --> %s\n", ids[e.From], ids[e.To])
+			continue
+		}
+		fmt.Fprintf(&b, "\t%s -->|\"%s\"| %s\n", ids[e.From], mermaidEscape(e.Label), ids[e.To])
+	}
+	return b.String()
+}
+
+// JSON renders the graph as a JSON document holding the nodes and the edges.
+func (g *Graph) JSON() ([]byte, error) {
+	return json.MarshalIndent(g, "", "  ")
+}
+
+// Render renders the graph in the format.
+func (g *Graph) Render(format Format) (string, error) {
+	switch format {
+	case DOTFormat:
+		return g.DOT(), nil
+	case MermaidFormat:
+		return g.Mermaid(), nil
+	case JSONFormat:
+		data, err := g.JSON()
+		if err != nil {
+			return "", err
+		}
+		return string(data) + "\n", nil
+	}
+	return "", format.IsValid()
+}
+
+// Neighbours returns the IDs of the nodes linked to the node, sorted.
+func (g *Graph) Neighbours(id string) []string {
+	var result []string
+	for _, e := range g.Edges {
+		switch id {
+		case e.From:
+			result = append(result, e.To)
+		case e.To:
+			result = append(result, e.From)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
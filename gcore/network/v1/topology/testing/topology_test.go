@@ -0,0 +1,283 @@
+package testing
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/baremetal/v1/bminstances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/floatingip/v1/floatingips"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/network/v1/networks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/network/v1/topology"
+	"github.com/G-Core/gcorelabscloud-go/gcore/reservedfixedip/v1/reservedfixedips"
+	"github.com/G-Core/gcorelabscloud-go/gcore/router/v1/routers"
+	routertypes "github.com/G-Core/gcorelabscloud-go/gcore/router/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/subnet/v1/subnets"
+	"github.com/G-Core/gcorelabscloud-go/testhelper/fakecloud"
+	"github.com/stretchr/testify/require"
+)
+
+// fixture holds the resources of the project built by newFixture.
+type fixture struct {
+	networkID, otherNetworkID string
+	subnetID, otherSubnetID   string
+	routerID                  string
+	instanceID, portID        string
+	vipID                     string
+	floatingIPID              string
+}
+
+// newFixture creates two networks with a subnet, a router attached to the first one, an instance with a floating
+// IP and a VIP shared with the instance in the first network, and an instance in the other network.
+func newFixture(t *testing.T, server *fakecloud.Server) fixture {
+	var f fixture
+	var err error
+	networkClient := server.ServiceClient("networks", "v1")
+	subnetClient := server.ServiceClient("subnets", "v1")
+	instanceClient := server.ServiceClient("instances", "v1")
+
+	task := server.FinishedTask(t, networks.Create(networkClient, networks.CreateOpts{
+		Name:     "backend",
+		Metadata: map[string]string{"env": "prod"},
+	}))
+	f.networkID, err = networks.ExtractNetworkIDFromTask(task)
+	require.NoError(t, err)
+	task = server.FinishedTask(t, networks.Create(networkClient, networks.CreateOpts{
+		Name:     "sandbox",
+		Metadata: map[string]string{"env": "dev"},
+	}))
+	f.otherNetworkID, err = networks.ExtractNetworkIDFromTask(task)
+	require.NoError(t, err)
+
+	for _, s := range []struct {
+		networkID, cidr string
+		id              *string
+	}{{f.networkID, "192.168.10.0/24", &f.subnetID}, {f.otherNetworkID, "10.0.0.0/24", &f.otherSubnetID}} {
+		cidr, err := gcorecloud.ParseCIDRString(s.cidr)
+		require.NoError(t, err)
+		task = server.FinishedTask(t, subnets.Create(subnetClient, subnets.CreateOpts{
+			Name:      "subnet-" + s.cidr,
+			CIDR:      *cidr,
+			NetworkID: s.networkID,
+		}))
+		*s.id, err = subnets.ExtractSubnetIDFromTask(task)
+		require.NoError(t, err)
+	}
+
+	task = server.FinishedTask(t, routers.Create(server.ServiceClient("routers", "v1"), routers.CreateOpts{
+		Name:                "router",
+		ExternalGatewayInfo: routers.GatewayInfo{Type: routertypes.DefaultGateway},
+		Interfaces:          []routers.Interface{{Type: routertypes.SubnetInterfaceType, SubnetID: f.subnetID}},
+	}))
+	f.routerID, err = routers.ExtractRouterIDFromTask(task)
+	require.NoError(t, err)
+
+	for _, s := range []struct {
+		name, subnetID string
+		id             *string
+	}{{"web", f.subnetID, &f.instanceID}, {"scratch", f.otherSubnetID, nil}} {
+		task = server.FinishedTask(t, instances.Create(instanceClient, instances.CreateOpts{
+			Flavor:  "g1-standard-1-2",
+			Names:   []string{s.name},
+			Volumes: []instances.CreateVolumeOpts{{Source: types.NewVolume, Size: 10}},
+			Interfaces: []instances.InterfaceInstanceCreateOpts{{
+				InterfaceOpts: instances.InterfaceOpts{Type: types.SubnetInterfaceType, SubnetID: s.subnetID},
+			}},
+		}))
+		if s.id != nil {
+			*s.id, err = instances.ExtractInstanceIDFromTask(task)
+			require.NoError(t, err)
+		}
+	}
+	interfaces, err := instances.ListInterfacesAll(instanceClient, f.instanceID)
+	require.NoError(t, err)
+	f.portID = interfaces[0].PortID
+
+	fipClient := server.ServiceClient("floatingips", "v1")
+	task = server.FinishedTask(t, floatingips.Create(fipClient, floatingips.CreateOpts{PortID: f.portID}))
+	f.floatingIPID, err = floatingips.ExtractFloatingIPIDFromTask(task)
+	require.NoError(t, err)
+
+	rfipClient := server.ServiceClient("reserved_fixed_ips", "v1")
+	task = server.FinishedTask(t, reservedfixedips.Create(rfipClient, reservedfixedips.CreateOpts{
+		Type:     reservedfixedips.Subnet,
+		SubnetID: f.subnetID,
+		IsVip:    true,
+	}))
+	f.vipID, err = reservedfixedips.ExtractReservedFixedIPIDFromTask(task)
+	require.NoError(t, err)
+	_, err = reservedfixedips.AddPortsToShareVIP(rfipClient, f.vipID, reservedfixedips.PortsToShareVIPOpts{
+		PortIDs: []string{f.portID},
+	}).Extract()
+	require.NoError(t, err)
+	return f
+}
+
+func TestBuild(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	f := newFixture(t, server)
+
+	graph, err := topology.Build(server.ServiceClient("networks", "v1"), topology.Opts{})
+	require.NoError(t, err)
+
+	count := map[topology.NodeKind]int{}
+	for _, n := range graph.Nodes {
+		count[n.Kind]++
+	}
+	// The external network of the router gateway is part of the graph.
+	require.Equal(t, map[topology.NodeKind]int{
+		topology.NetworkNode:    3,
+		topology.SubnetNode:     2,
+		topology.RouterNode:     1,
+		topology.PortNode:       2,
+		topology.InstanceNode:   2,
+		topology.VIPNode:        1,
+		topology.FloatingIPNode: 1,
+	}, count)
+
+	subnet, ok := graph.Node(topology.SubnetNode, f.subnetID)
+	require.True(t, ok)
+	require.Equal(t, "192.168.10.0/24", subnet.Attributes["cidr"])
+	require.Equal(t, []string{
+		topology.NodeID(topology.NetworkNode, f.networkID),
+		topology.NodeID(topology.PortNode, f.portID),
+		topology.NodeID(topology.RouterNode, f.routerID),
+		topology.NodeID(topology.VIPNode, f.vipID),
+	}, graph.Neighbours(subnet.ID))
+
+	port := topology.NodeID(topology.PortNode, f.portID)
+	require.Contains(t, graph.Edges, topology.Edge{From: topology.NodeID(topology.InstanceNode, f.instanceID), To: port})
+	require.Contains(t, graph.Edges, topology.Edge{From: topology.NodeID(topology.VIPNode, f.vipID), To: port, Label: "shared"})
+	require.Contains(t, graph.Neighbours(port), topology.NodeID(topology.FloatingIPNode, f.floatingIPID))
+	require.Contains(t, graph.Edges, topology.Edge{
+		From:  topology.NodeID(topology.RouterNode, f.routerID),
+		To:    subnet.ID,
+		Label: "192.168.10.1",
+	})
+}
+
+func TestBuildFiltered(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	f := newFixture(t, server)
+	client := server.ServiceClient("networks", "v1")
+
+	for _, opts := range []topology.Opts{
+		{NetworkIDs: []string{f.otherNetworkID}},
+		{Metadata: map[string]string{"env": "dev"}},
+	} {
+		graph, err := topology.Build(client, opts)
+		require.NoError(t, err)
+		var kinds []string
+		for _, n := range graph.Nodes {
+			kinds = append(kinds, n.Kind.String())
+		}
+		// The router and the VIP are only linked to the other network, the floating IP to a port of it.
+		require.Equal(t, []string{"network", "subnet", "instance", "port"}, kinds)
+		_, ok := graph.Node(topology.SubnetNode, f.otherSubnetID)
+		require.True(t, ok)
+	}
+
+	graph, err := topology.Build(client, topology.Opts{NetworkIDs: []string{f.networkID}})
+	require.NoError(t, err)
+	_, ok := graph.Node(topology.RouterNode, f.routerID)
+	require.True(t, ok)
+	_, ok = graph.Node(topology.SubnetNode, f.otherSubnetID)
+	require.False(t, ok)
+
+	graph, err = topology.Build(client, topology.Opts{Metadata: map[string]string{"env": "staging"}})
+	require.NoError(t, err)
+	require.Empty(t, graph.Nodes)
+}
+
+func TestBuildBaremetalAndReservedFixedIP(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	f := newFixture(t, server)
+
+	task := server.FinishedTask(t, bminstances.Create(server.ServiceClient("bminstances", "v1"), bminstances.CreateOpts{
+		Flavor:     "bm1-infrastructure-small",
+		Names:      []string{"bm-1"},
+		ImageID:    "1b4ab5cf-7e3c-4f3f-9b0e-6a2d0c5e8f11",
+		Interfaces: []bminstances.InterfaceOpts{{Type: types.SubnetInterfaceType, NetworkID: f.otherNetworkID, SubnetID: f.otherSubnetID}},
+	}))
+	baremetalID, err := instances.ExtractInstanceIDFromTask(task)
+	require.NoError(t, err)
+
+	// The floating IP of a reserved fixed IP, which is not drawn, is linked to the subnet of its fixed IP.
+	task = server.FinishedTask(t, reservedfixedips.Create(server.ServiceClient("reserved_fixed_ips", "v1"), reservedfixedips.CreateOpts{
+		Type:     reservedfixedips.Subnet,
+		SubnetID: f.otherSubnetID,
+	}))
+	reservedID, err := reservedfixedips.ExtractReservedFixedIPIDFromTask(task)
+	require.NoError(t, err)
+	task = server.FinishedTask(t, floatingips.Create(server.ServiceClient("floatingips", "v1"), floatingips.CreateOpts{PortID: reservedID}))
+	floatingIPID, err := floatingips.ExtractFloatingIPIDFromTask(task)
+	require.NoError(t, err)
+
+	graph, err := topology.Build(server.ServiceClient("networks", "v1"), topology.Opts{NetworkIDs: []string{f.otherNetworkID}})
+	require.NoError(t, err)
+	baremetal, ok := graph.Node(topology.InstanceNode, baremetalID)
+	require.True(t, ok)
+	require.Equal(t, "bm-1", baremetal.Name)
+	ports := graph.Neighbours(baremetal.ID)
+	require.Len(t, ports, 1)
+	require.Contains(t, graph.Neighbours(ports[0]), topology.NodeID(topology.SubnetNode, f.otherSubnetID))
+
+	fip, ok := graph.Node(topology.FloatingIPNode, floatingIPID)
+	require.True(t, ok)
+	require.Equal(t, []string{topology.NodeID(topology.SubnetNode, f.otherSubnetID)}, graph.Neighbours(fip.ID))
+	_, ok = graph.Node(topology.FloatingIPNode, f.floatingIPID)
+	require.False(t, ok)
+}
+
+func TestRender(t *testing.T) {
+	graph := topology.NewGraph()
+	network := graph.AddNode(topology.NetworkNode, "n1", `my "net"`, nil)
+	subnet := graph.AddNode(topology.SubnetNode, "s1", "", map[string]string{"cidr": "10.0.0.0/24"})
+	graph.AddEdge(network.ID, subnet.ID, "")
+	graph.AddEdge(network.ID, subnet.ID, "")
+	router := graph.AddNode(topology.RouterNode, "r1", "router", nil)
+	graph.AddEdge(router.ID, subnet.ID, "10.0.0.1")
+	require.Len(t, graph.Edges, 2)
+
+	dot, err := graph.Render(topology.DOTFormat)
+	require.NoError(t, err)
+	require.Equal(t, strings.Join([]string{
+		"digraph topology {",
+		"\trankdir=LR;",
+		`	"network:n1" [label="network\nmy \"net\"", shape=folder];`,
+		`	"subnet:s1" [label="subnet\ns1\n10.0.0.0/24", shape=box];`,
+		`	"router:r1" [label="router\nrouter", shape=diamond];`,
+		`	"network:n1" -> "subnet:s1";`,
+		`	"router:r1" -> "subnet:s1" [label="10.0.0.1"];`,
+		"}",
+		"",
+	}, "\n"), dot)
+
+	mermaid, err := graph.Render(topology.MermaidFormat)
+	require.NoError(t, err)
+	require.Equal(t, strings.Join([]string{
+		"flowchart LR",
+		`	n0[["network<br/>my #quot;net#quot;"]]`,
+		`	n1["subnet<br/>s1<br/>10.0.0.0/24"]`,
+		`	n2{{"router<br/>router"}}`,
+		"	n0 --> n1",
+		`	n2 -->|"10.0.0.1"| n1`,
+		"",
+	}, "\n"), mermaid)
+
+	data, err := graph.Render(topology.JSONFormat)
+	require.NoError(t, err)
+	var decoded topology.Graph
+	require.NoError(t, json.Unmarshal([]byte(data), &decoded))
+	require.Equal(t, graph.Nodes, decoded.Nodes)
+	require.Equal(t, graph.Edges, decoded.Edges)
+
+	_, err = graph.Render("svg")
+	require.Error(t, err)
+}
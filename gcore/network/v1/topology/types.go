@@ -0,0 +1,136 @@
+package topology
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NodeKind is the kind of resource a node of the graph stands for.
+type NodeKind string
+
+// Format is a rendering of the graph.
+type Format string
+
+const (
+	NetworkNode    NodeKind = "network"
+	SubnetNode     NodeKind = "subnet"
+	RouterNode     NodeKind = "router"
+	PortNode       NodeKind = "port"
+	InstanceNode   NodeKind = "instance"
+	VIPNode        NodeKind = "vip"
+	FloatingIPNode NodeKind = "floatingip"
+
+	DOTFormat     Format = "dot"
+	MermaidFormat Format = "mermaid"
+	JSONFormat    Format = "json"
+)
+
+func (k NodeKind) IsValid() error {
+	switch k {
+	case NetworkNode, SubnetNode, RouterNode, PortNode, InstanceNode, VIPNode, FloatingIPNode:
+		return nil
+	}
+	return fmt.Errorf("invalid NodeKind type: %v", k)
+}
+
+func (k NodeKind) ValidOrNil() (*NodeKind, error) {
+	if k.String() == "" {
+		return nil, nil
+	}
+	err := k.IsValid()
+	if err != nil {
+		return &k, err
+	}
+	return &k, nil
+}
+
+func (k NodeKind) String() string {
+	return string(k)
+}
+
+func (k NodeKind) List() []NodeKind {
+	return []NodeKind{NetworkNode, SubnetNode, RouterNode, PortNode, InstanceNode, VIPNode, FloatingIPNode}
+}
+
+func (k NodeKind) StringList() []string {
+	var s []string
+	for _, v := range k.List() {
+		s = append(s, v.String())
+	}
+	return s
+}
+
+// UnmarshalJSON - implements Unmarshaler interface for NodeKind
+func (k *NodeKind) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v := NodeKind(s)
+	err := v.IsValid()
+	if err != nil {
+		return err
+	}
+	*k = v
+	return nil
+}
+
+// MarshalJSON - implements Marshaler interface for NodeKind
+func (k *NodeKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(k.String())
+}
+
+func (f Format) IsValid() error {
+	switch f {
+	case DOTFormat, MermaidFormat, JSONFormat:
+		return nil
+	}
+	return fmt.Errorf("invalid Format type: %v", f)
+}
+
+func (f Format) ValidOrNil() (*Format, error) {
+	if f.String() == "" {
+		return nil, nil
+	}
+	err := f.IsValid()
+	if err != nil {
+		return &f, err
+	}
+	return &f, nil
+}
+
+func (f Format) String() string {
+	return string(f)
+}
+
+func (f Format) List() []Format {
+	return []Format{DOTFormat, MermaidFormat, JSONFormat}
+}
+
+func (f Format) StringList() []string {
+	var s []string
+	for _, v := range f.List() {
+		s = append(s, v.String())
+	}
+	return s
+}
+
+// UnmarshalJSON - implements Unmarshaler interface for Format
+func (f *Format) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v := Format(s)
+	err := v.IsValid()
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// MarshalJSON - implements Marshaler interface for Format
+func (f *Format) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.String())
+}
@@ -0,0 +1,92 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package ports
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/reservedfixedip/v1/reservedfixedips"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of ports operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	AllowAddressPairs(portID string, opts AllowAddressPairsOptsBuilder) AssignResult
+	AssignSecurityGroups(portID string, names ...string) error
+	DisablePortSecurity(portID string) UpdateResult
+	EnablePortSecurity(portID string) UpdateResult
+	Get(portID string) GetResult
+	List(opts ListOptsBuilder) pagination.Pager
+	ListAddressPairs(portID string) ([]reservedfixedips.AllowedAddressPairs, error)
+	ListAll(opts ListOptsBuilder) ([]Port, error)
+	MergeAddressPairs(portID string, pairs ...reservedfixedips.AllowedAddressPairs) (*InstancePort, error)
+	RemoveAddressPairs(portID string, pairs ...reservedfixedips.AllowedAddressPairs) (*InstancePort, error)
+	UnassignSecurityGroups(portID string, names ...string) error
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// AllowAddressPairs calls the package level AllowAddressPairs function with the service client.
+func (s *Service) AllowAddressPairs(portID string, opts AllowAddressPairsOptsBuilder) AssignResult {
+	return AllowAddressPairs(s.ServiceClient, portID, opts)
+}
+
+// AssignSecurityGroups calls the package level AssignSecurityGroups function with the service client.
+func (s *Service) AssignSecurityGroups(portID string, names ...string) error {
+	return AssignSecurityGroups(s.ServiceClient, portID, names...)
+}
+
+// DisablePortSecurity calls the package level DisablePortSecurity function with the service client.
+func (s *Service) DisablePortSecurity(portID string) UpdateResult {
+	return DisablePortSecurity(s.ServiceClient, portID)
+}
+
+// EnablePortSecurity calls the package level EnablePortSecurity function with the service client.
+func (s *Service) EnablePortSecurity(portID string) UpdateResult {
+	return EnablePortSecurity(s.ServiceClient, portID)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(portID string) GetResult {
+	return Get(s.ServiceClient, portID)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, opts)
+}
+
+// ListAddressPairs calls the package level ListAddressPairs function with the service client.
+func (s *Service) ListAddressPairs(portID string) ([]reservedfixedips.AllowedAddressPairs, error) {
+	return ListAddressPairs(s.ServiceClient, portID)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(opts ListOptsBuilder) ([]Port, error) {
+	return ListAll(s.ServiceClient, opts)
+}
+
+// MergeAddressPairs calls the package level MergeAddressPairs function with the service client.
+func (s *Service) MergeAddressPairs(portID string, pairs ...reservedfixedips.AllowedAddressPairs) (*InstancePort, error) {
+	return MergeAddressPairs(s.ServiceClient, portID, pairs...)
+}
+
+// RemoveAddressPairs calls the package level RemoveAddressPairs function with the service client.
+func (s *Service) RemoveAddressPairs(portID string, pairs ...reservedfixedips.AllowedAddressPairs) (*InstancePort, error) {
+	return RemoveAddressPairs(s.ServiceClient, portID, pairs...)
+}
+
+// UnassignSecurityGroups calls the package level UnassignSecurityGroups function with the service client.
+func (s *Service) UnassignSecurityGroups(portID string, names ...string) error {
+	return UnassignSecurityGroups(s.ServiceClient, portID, names...)
+}
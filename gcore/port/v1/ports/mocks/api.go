@@ -0,0 +1,164 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of ports.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/port/v1/ports"
+	"github.com/G-Core/gcorelabscloud-go/gcore/reservedfixedip/v1/reservedfixedips"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of ports.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	AllowAddressPairsFunc      func(portID string, opts ports.AllowAddressPairsOptsBuilder) ports.AssignResult
+	AssignSecurityGroupsFunc   func(portID string, names ...string) error
+	DisablePortSecurityFunc    func(portID string) ports.UpdateResult
+	EnablePortSecurityFunc     func(portID string) ports.UpdateResult
+	GetFunc                    func(portID string) ports.GetResult
+	ListFunc                   func(opts ports.ListOptsBuilder) pagination.Pager
+	ListAddressPairsFunc       func(portID string) ([]reservedfixedips.AllowedAddressPairs, error)
+	ListAllFunc                func(opts ports.ListOptsBuilder) ([]ports.Port, error)
+	MergeAddressPairsFunc      func(portID string, pairs ...reservedfixedips.AllowedAddressPairs) (*ports.InstancePort, error)
+	RemoveAddressPairsFunc     func(portID string, pairs ...reservedfixedips.AllowedAddressPairs) (*ports.InstancePort, error)
+	UnassignSecurityGroupsFunc func(portID string, names ...string) error
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ ports.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// AllowAddressPairs implements ports.API.
+func (m *API) AllowAddressPairs(portID string, opts ports.AllowAddressPairsOptsBuilder) ports.AssignResult {
+	m.record("AllowAddressPairs", portID, opts)
+	if m.AllowAddressPairsFunc == nil {
+		panic("mocks: ports.API.AllowAddressPairsFunc is not set")
+	}
+	return m.AllowAddressPairsFunc(portID, opts)
+}
+
+// AssignSecurityGroups implements ports.API.
+func (m *API) AssignSecurityGroups(portID string, names ...string) error {
+	m.record("AssignSecurityGroups", portID, names)
+	if m.AssignSecurityGroupsFunc == nil {
+		panic("mocks: ports.API.AssignSecurityGroupsFunc is not set")
+	}
+	return m.AssignSecurityGroupsFunc(portID, names...)
+}
+
+// DisablePortSecurity implements ports.API.
+func (m *API) DisablePortSecurity(portID string) ports.UpdateResult {
+	m.record("DisablePortSecurity", portID)
+	if m.DisablePortSecurityFunc == nil {
+		panic("mocks: ports.API.DisablePortSecurityFunc is not set")
+	}
+	return m.DisablePortSecurityFunc(portID)
+}
+
+// EnablePortSecurity implements ports.API.
+func (m *API) EnablePortSecurity(portID string) ports.UpdateResult {
+	m.record("EnablePortSecurity", portID)
+	if m.EnablePortSecurityFunc == nil {
+		panic("mocks: ports.API.EnablePortSecurityFunc is not set")
+	}
+	return m.EnablePortSecurityFunc(portID)
+}
+
+// Get implements ports.API.
+func (m *API) Get(portID string) ports.GetResult {
+	m.record("Get", portID)
+	if m.GetFunc == nil {
+		panic("mocks: ports.API.GetFunc is not set")
+	}
+	return m.GetFunc(portID)
+}
+
+// List implements ports.API.
+func (m *API) List(opts ports.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: ports.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAddressPairs implements ports.API.
+func (m *API) ListAddressPairs(portID string) ([]reservedfixedips.AllowedAddressPairs, error) {
+	m.record("ListAddressPairs", portID)
+	if m.ListAddressPairsFunc == nil {
+		panic("mocks: ports.API.ListAddressPairsFunc is not set")
+	}
+	return m.ListAddressPairsFunc(portID)
+}
+
+// ListAll implements ports.API.
+func (m *API) ListAll(opts ports.ListOptsBuilder) ([]ports.Port, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: ports.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// MergeAddressPairs implements ports.API.
+func (m *API) MergeAddressPairs(portID string, pairs ...reservedfixedips.AllowedAddressPairs) (*ports.InstancePort, error) {
+	m.record("MergeAddressPairs", portID, pairs)
+	if m.MergeAddressPairsFunc == nil {
+		panic("mocks: ports.API.MergeAddressPairsFunc is not set")
+	}
+	return m.MergeAddressPairsFunc(portID, pairs...)
+}
+
+// RemoveAddressPairs implements ports.API.
+func (m *API) RemoveAddressPairs(portID string, pairs ...reservedfixedips.AllowedAddressPairs) (*ports.InstancePort, error) {
+	m.record("RemoveAddressPairs", portID, pairs)
+	if m.RemoveAddressPairsFunc == nil {
+		panic("mocks: ports.API.RemoveAddressPairsFunc is not set")
+	}
+	return m.RemoveAddressPairsFunc(portID, pairs...)
+}
+
+// UnassignSecurityGroups implements ports.API.
+func (m *API) UnassignSecurityGroups(portID string, names ...string) error {
+	m.record("UnassignSecurityGroups", portID, names)
+	if m.UnassignSecurityGroupsFunc == nil {
+		panic("mocks: ports.API.UnassignSecurityGroupsFunc is not set")
+	}
+	return m.UnassignSecurityGroupsFunc(portID, names...)
+}
@@ -1,12 +1,38 @@
 package ports
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
 
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
 	"github.com/G-Core/gcorelabscloud-go/gcore/reservedfixedip/v1/reservedfixedips"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
 )
 
+// ListOptsBuilder allows extensions to add additional parameters to the List request.
+type ListOptsBuilder interface {
+	ToPortListQuery() (string, error)
+}
+
+// ListOpts allows the filtering of the List API response.
+type ListOpts struct {
+	NetworkID  string `q:"network_id"`
+	DeviceID   string `q:"device_id"`
+	MacAddress string `q:"mac_address"`
+	IPAddress  string `q:"ip_address"`
+}
+
+// ToPortListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToPortListQuery() (string, error) {
+	q, err := gcorecloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
 // AllowAddressPairsOptsBuilder allows extensions to add additional parameters to the AllowAddressPairs request.
 type AllowAddressPairsOptsBuilder interface {
 	ToAllowAddressPairsMap() (map[string]interface{}, error)
@@ -52,3 +78,150 @@ func AllowAddressPairs(c *gcorecloud.ServiceClient, portID string, opts AllowAdd
 	_, r.Err = c.Put(assignAllowedAddressPairsURL(c, portID), b, &r.Body, &gcorecloud.RequestOpts{OkCodes: []int{http.StatusOK}})
 	return
 }
+
+// List retrieves list of instance ports.
+func List(c *gcorecloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToPortListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return PortPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListAll returns all instance ports.
+func ListAll(c *gcorecloud.ServiceClient, opts ListOptsBuilder) ([]Port, error) {
+	page, err := List(c, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractPorts(page)
+}
+
+// Get retrieves a specific instance port based on its unique ID.
+func Get(c *gcorecloud.ServiceClient, portID string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, portID), &r.Body, nil)
+	return
+}
+
+// sameAddressPair reports whether the pair matches the wanted one. A wanted pair without MAC address matches the
+// pairs of the IP address whatever their MAC address.
+func sameAddressPair(pair, wanted reservedfixedips.AllowedAddressPairs) bool {
+	if pair.IPAddress != wanted.IPAddress {
+		return false
+	}
+	return wanted.MacAddress == "" || strings.EqualFold(pair.MacAddress, wanted.MacAddress)
+}
+
+// ListAddressPairs returns the allowed address pairs of an instance port.
+func ListAddressPairs(c *gcorecloud.ServiceClient, portID string) ([]reservedfixedips.AllowedAddressPairs, error) {
+	port, err := Get(c, portID).Extract()
+	if err != nil {
+		return nil, err
+	}
+	return port.AllowedAddressPairs, nil
+}
+
+// MergeAddressPairs adds the address pairs missing from the allowed address pairs of an instance port, keeping the
+// others. A pair without MAC address is not missing if the port allows its IP address with any MAC address.
+func MergeAddressPairs(c *gcorecloud.ServiceClient, portID string, pairs ...reservedfixedips.AllowedAddressPairs) (*InstancePort, error) {
+	port, err := Get(c, portID).Extract()
+	if err != nil {
+		return nil, err
+	}
+	merged := append([]reservedfixedips.AllowedAddressPairs{}, port.AllowedAddressPairs...)
+	for _, pair := range pairs {
+		if pair.IPAddress == "" {
+			return nil, fmt.Errorf("IP address of address pair is required")
+		}
+		found := false
+		for _, current := range merged {
+			if sameAddressPair(current, pair) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, pair)
+		}
+	}
+	if len(merged) == len(port.AllowedAddressPairs) {
+		return port.instancePort(), nil
+	}
+	return AllowAddressPairs(c, portID, AllowAddressPairsOpts{AllowedAddressPairs: merged}).Extract()
+}
+
+// RemoveAddressPairs removes address pairs from the allowed address pairs of an instance port. A pair without MAC
+// address removes all the pairs of its IP address. Pairs the port does not allow are ignored.
+func RemoveAddressPairs(c *gcorecloud.ServiceClient, portID string, pairs ...reservedfixedips.AllowedAddressPairs) (*InstancePort, error) {
+	port, err := Get(c, portID).Extract()
+	if err != nil {
+		return nil, err
+	}
+	kept := []reservedfixedips.AllowedAddressPairs{}
+	for _, current := range port.AllowedAddressPairs {
+		removed := false
+		for _, pair := range pairs {
+			if sameAddressPair(current, pair) {
+				removed = true
+				break
+			}
+		}
+		if !removed {
+			kept = append(kept, current)
+		}
+	}
+	if len(kept) == len(port.AllowedAddressPairs) {
+		return port.instancePort(), nil
+	}
+	return AllowAddressPairs(c, portID, AllowAddressPairsOpts{AllowedAddressPairs: kept}).Extract()
+}
+
+// securityGroupOpts returns the options of the instance security group actions for the port.
+func securityGroupOpts(portID string, names []string) (instances.SecurityGroupOpts, error) {
+	if len(names) == 0 {
+		return instances.SecurityGroupOpts{}, fmt.Errorf("at least one security group name is required")
+	}
+	return instances.SecurityGroupOpts{
+		PortsSecurityGroupNames: []instances.PortSecurityGroupNames{{PortID: &portID, SecurityGroupNames: names}},
+	}, nil
+}
+
+// AssignSecurityGroups adds security groups by name to an instance port, the other ports of the instance are left
+// as is.
+func AssignSecurityGroups(c *gcorecloud.ServiceClient, portID string, names ...string) error {
+	opts, err := securityGroupOpts(portID, names)
+	if err != nil {
+		return err
+	}
+	port, err := Get(c, portID).Extract()
+	if err != nil {
+		return err
+	}
+	if port.InstanceID == "" {
+		return fmt.Errorf("port %s is not attached to an instance", portID)
+	}
+	return instances.AssignSecurityGroup(c.ForService("instances"), port.InstanceID, opts).ExtractErr()
+}
+
+// UnassignSecurityGroups removes security groups by name from an instance port, the other ports of the instance are
+// left as is.
+func UnassignSecurityGroups(c *gcorecloud.ServiceClient, portID string, names ...string) error {
+	opts, err := securityGroupOpts(portID, names)
+	if err != nil {
+		return err
+	}
+	port, err := Get(c, portID).Extract()
+	if err != nil {
+		return err
+	}
+	if port.InstanceID == "" {
+		return fmt.Errorf("port %s is not attached to an instance", portID)
+	}
+	return instances.UnAssignSecurityGroup(c.ForService("instances"), port.InstanceID, opts).ExtractErr()
+}
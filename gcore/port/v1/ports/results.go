@@ -4,6 +4,7 @@ import (
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
 	"github.com/G-Core/gcorelabscloud-go/gcore/reservedfixedip/v1/reservedfixedips"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
 )
 
 type commonResult struct {
@@ -52,3 +53,80 @@ type InstancePort struct {
 	InstanceID          string                                 `json:"instance_id"`
 	PortID              string                                 `json:"port_id"`
 }
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Port.
+type GetResult struct {
+	gcorecloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a port resource.
+func (r GetResult) Extract() (*Port, error) {
+	var s Port
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r GetResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoStructPtr(v, "")
+}
+
+// Port represents an instance port.
+type Port struct {
+	PortID              string                                 `json:"port_id"`
+	NetworkID           string                                 `json:"network_id"`
+	InstanceID          string                                 `json:"instance_id"`
+	InstanceName        string                                 `json:"instance_name"`
+	MacAddress          gcorecloud.MAC                         `json:"mac_address"`
+	IPAssignments       []instances.PortIP                     `json:"ip_assignments"`
+	PortSecurityEnabled bool                                   `json:"port_security_enabled"`
+	AllowedAddressPairs []reservedfixedips.AllowedAddressPairs `json:"allowed_address_pairs"`
+	SecurityGroups      []gcorecloud.ItemName                  `json:"security_groups"`
+}
+
+func (p Port) instancePort() *InstancePort {
+	return &InstancePort{
+		NetworkID:           p.NetworkID,
+		AllowedAddressPairs: p.AllowedAddressPairs,
+		InstanceID:          p.InstanceID,
+		PortID:              p.PortID,
+	}
+}
+
+// PortPage is the page returned by a pager when traversing over a
+// collection of ports.
+type PortPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of ports has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r PortPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gcorecloud.Link `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gcorecloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a PortPage struct is empty.
+func (r PortPage) IsEmpty() (bool, error) {
+	is, err := ExtractPorts(r)
+	return len(is) == 0, err
+}
+
+// ExtractPorts accepts a Page struct, specifically a PortPage struct,
+// and extracts the elements into a slice of Port structs.
+func ExtractPorts(r pagination.Page) ([]Port, error) {
+	var s []Port
+	err := ExtractPortInto(r, &s)
+	return s, err
+}
+
+func ExtractPortInto(r pagination.Page, v interface{}) error {
+	return r.(PortPage).Result.ExtractIntoSlicePtr(v, "results")
+}
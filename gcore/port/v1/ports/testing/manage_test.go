@@ -0,0 +1,188 @@
+package testing
+
+import (
+	"testing"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/network/v1/networks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/port/v1/ports"
+	"github.com/G-Core/gcorelabscloud-go/gcore/reservedfixedip/v1/reservedfixedips"
+	"github.com/G-Core/gcorelabscloud-go/gcore/securitygroup/v1/securitygroups"
+	"github.com/G-Core/gcorelabscloud-go/gcore/subnet/v1/subnets"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/testhelper/fakecloud"
+	"github.com/stretchr/testify/require"
+)
+
+func createInstance(t *testing.T, server *fakecloud.Server, interfaces int) string {
+	var opts []instances.InterfaceInstanceCreateOpts
+	for i := 0; i < interfaces; i++ {
+		opts = append(opts, instances.InterfaceInstanceCreateOpts{InterfaceOpts: instances.InterfaceOpts{Type: types.ExternalInterfaceType}})
+	}
+	results, err := instances.Create(server.ServiceClient("instances", "v1"), instances.CreateOpts{
+		Flavor:     "g1-standard-1-2",
+		Names:      []string{"web"},
+		Volumes:    []instances.CreateVolumeOpts{{Source: types.NewVolume, Size: 10}},
+		Interfaces: opts,
+	}).Extract()
+	require.NoError(t, err)
+	task, err := tasks.Get(server.ServiceClient("tasks", "v1"), string(results.Tasks[0])).Extract()
+	require.NoError(t, err)
+	id, err := instances.ExtractInstanceIDFromTask(task)
+	require.NoError(t, err)
+	return id
+}
+
+func securityGroupNames(port *ports.Port) []string {
+	var names []string
+	for _, sg := range port.SecurityGroups {
+		names = append(names, sg.Name)
+	}
+	return names
+}
+
+func TestListGet(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	client := server.ServiceClient("ports", "v1")
+
+	first := createInstance(t, server, 2)
+	second := createInstance(t, server, 1)
+
+	all, err := ports.ListAll(client, nil)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	require.Equal(t, first, all[0].InstanceID)
+	require.Equal(t, "web", all[0].InstanceName)
+
+	byDevice, err := ports.ListAll(client, ports.ListOpts{DeviceID: second})
+	require.NoError(t, err)
+	require.Len(t, byDevice, 1)
+	require.Equal(t, all[2].PortID, byDevice[0].PortID)
+
+	byMAC, err := ports.ListAll(client, ports.ListOpts{MacAddress: all[1].MacAddress.String()})
+	require.NoError(t, err)
+	require.Len(t, byMAC, 1)
+	require.Equal(t, all[1].PortID, byMAC[0].PortID)
+
+	byIP, err := ports.ListAll(client, ports.ListOpts{IPAddress: all[0].IPAssignments[0].IPAddress.String()})
+	require.NoError(t, err)
+	require.Len(t, byIP, 1)
+	require.Equal(t, all[0].PortID, byIP[0].PortID)
+
+	byNetwork, err := ports.ListAll(client, ports.ListOpts{NetworkID: "unknown"})
+	require.NoError(t, err)
+	require.Empty(t, byNetwork)
+
+	port, err := ports.Get(client, all[1].PortID).Extract()
+	require.NoError(t, err)
+	require.Equal(t, all[1], *port)
+	require.True(t, port.PortSecurityEnabled)
+	require.Equal(t, []string{"default"}, securityGroupNames(port))
+
+	_, err = ports.Get(client, "unknown").Extract()
+	require.IsType(t, gcorecloud.ErrDefault404{}, err)
+}
+
+func TestAddressPairs(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	client := server.ServiceClient("ports", "v1")
+
+	createInstance(t, server, 1)
+	all, err := ports.ListAll(client, nil)
+	require.NoError(t, err)
+	portID := all[0].PortID
+
+	vip := reservedfixedips.AllowedAddressPairs{IPAddress: "192.168.0.10", MacAddress: "fa:16:3e:00:00:01"}
+	other := reservedfixedips.AllowedAddressPairs{IPAddress: "192.168.0.10", MacAddress: "fa:16:3e:00:00:02"}
+	subnet := reservedfixedips.AllowedAddressPairs{IPAddress: "10.0.0.0/24"}
+
+	result, err := ports.MergeAddressPairs(client, portID, vip, subnet)
+	require.NoError(t, err)
+	require.Equal(t, []reservedfixedips.AllowedAddressPairs{vip, subnet}, result.AllowedAddressPairs)
+
+	// A pair without MAC address is already allowed by the pair of its IP address.
+	result, err = ports.MergeAddressPairs(client, portID, reservedfixedips.AllowedAddressPairs{IPAddress: vip.IPAddress})
+	require.NoError(t, err)
+	require.Equal(t, []reservedfixedips.AllowedAddressPairs{vip, subnet}, result.AllowedAddressPairs)
+
+	result, err = ports.MergeAddressPairs(client, portID, other, vip)
+	require.NoError(t, err)
+	require.Equal(t, []reservedfixedips.AllowedAddressPairs{vip, subnet, other}, result.AllowedAddressPairs)
+
+	pairs, err := ports.ListAddressPairs(client, portID)
+	require.NoError(t, err)
+	require.Equal(t, result.AllowedAddressPairs, pairs)
+
+	// A pair without MAC address removes every pair of its IP address.
+	result, err = ports.RemoveAddressPairs(client, portID, reservedfixedips.AllowedAddressPairs{IPAddress: vip.IPAddress})
+	require.NoError(t, err)
+	require.Equal(t, []reservedfixedips.AllowedAddressPairs{subnet}, result.AllowedAddressPairs)
+
+	result, err = ports.RemoveAddressPairs(client, portID, vip)
+	require.NoError(t, err)
+	require.Equal(t, portID, result.PortID)
+	require.Equal(t, []reservedfixedips.AllowedAddressPairs{subnet}, result.AllowedAddressPairs)
+
+	_, err = ports.MergeAddressPairs(client, portID, reservedfixedips.AllowedAddressPairs{MacAddress: vip.MacAddress})
+	require.Error(t, err)
+}
+
+func TestSecurityGroups(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	client := server.ServiceClient("ports", "v1")
+
+	_, err := securitygroups.Create(server.ServiceClient("securitygroups", "v1"), securitygroups.CreateOpts{
+		SecurityGroup: securitygroups.CreateSecurityGroupOpts{
+			Name:               "web",
+			SecurityGroupRules: []securitygroups.CreateSecurityGroupRuleOpts{},
+		},
+	}).Extract()
+	require.NoError(t, err)
+	instanceID := createInstance(t, server, 2)
+	all, err := ports.ListAll(client, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, ports.AssignSecurityGroups(client, all[0].PortID, "web"))
+	port, err := ports.Get(client, all[0].PortID).Extract()
+	require.NoError(t, err)
+	require.Equal(t, []string{"default", "web"}, securityGroupNames(port))
+	port, err = ports.Get(client, all[1].PortID).Extract()
+	require.NoError(t, err)
+	require.Equal(t, []string{"default"}, securityGroupNames(port))
+
+	require.NoError(t, ports.UnassignSecurityGroups(client, all[0].PortID, "default"))
+	instancePorts, err := instances.ListPortsAll(server.ServiceClient("instances", "v1"), instanceID)
+	require.NoError(t, err)
+	require.Equal(t, "web", instancePorts[0].SecurityGroups[0].Name)
+	require.Len(t, instancePorts[0].SecurityGroups, 1)
+
+	require.Error(t, ports.AssignSecurityGroups(client, all[0].PortID, "unknown"))
+	require.Error(t, ports.AssignSecurityGroups(client, all[0].PortID))
+
+	// The DHCP port of a subnet belongs to no instance.
+	networkID := server.CreatedID(t, networks.Create(server.ServiceClient("networks", "v1"), networks.CreateOpts{Name: "network"}),
+		networks.ExtractNetworkIDFromTask)
+	server.FinishedTask(t, subnets.Create(server.ServiceClient("subnets", "v1"), subnets.CreateOpts{
+		Name:      "subnet",
+		CIDR:      cidr(t, "192.168.10.0/24"),
+		NetworkID: networkID,
+	}))
+	dhcp, err := ports.ListAll(client, ports.ListOpts{NetworkID: networkID})
+	require.NoError(t, err)
+	require.Len(t, dhcp, 1)
+	require.Empty(t, dhcp[0].InstanceID)
+	require.EqualError(t, ports.AssignSecurityGroups(client, dhcp[0].PortID, "web"),
+		"port "+dhcp[0].PortID+" is not attached to an instance")
+	require.Error(t, ports.UnassignSecurityGroups(client, dhcp[0].PortID, "default"))
+}
+
+func cidr(t *testing.T, s string) gcorecloud.CIDR {
+	parsed, err := gcorecloud.ParseCIDRString(s)
+	require.NoError(t, err)
+	return *parsed
+}
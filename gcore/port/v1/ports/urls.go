@@ -2,6 +2,22 @@ package ports
 
 import gcorecloud "github.com/G-Core/gcorelabscloud-go"
 
+func rootURL(c *gcorecloud.ServiceClient) string {
+	return c.ServiceURL()
+}
+
+func resourceURL(c *gcorecloud.ServiceClient, id string) string {
+	return c.ServiceURL(id)
+}
+
+func listURL(c *gcorecloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getURL(c *gcorecloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
 func resourceActionURL(c *gcorecloud.ServiceClient, id string, action string) string {
 	return c.ServiceURL(id, action)
 }
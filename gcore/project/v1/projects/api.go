@@ -0,0 +1,62 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package projects
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of projects operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Create(opts CreateOptsBuilder) CreateResult
+	Delete(id int) tasks.Result
+	Get(id int) GetResult
+	List() pagination.Pager
+	ListAll() ([]Project, error)
+	Update(id int, opts UpdateOptsBuilder) UpdateResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) CreateResult {
+	return Create(s.ServiceClient, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(id int) tasks.Result {
+	return Delete(s.ServiceClient, id)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id int) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List() pagination.Pager {
+	return List(s.ServiceClient)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll() ([]Project, error) {
+	return ListAll(s.ServiceClient)
+}
+
+// Update calls the package level Update function with the service client.
+func (s *Service) Update(id int, opts UpdateOptsBuilder) UpdateResult {
+	return Update(s.ServiceClient, id, opts)
+}
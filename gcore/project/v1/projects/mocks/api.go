@@ -0,0 +1,114 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of projects.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/project/v1/projects"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of projects.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc  func(opts projects.CreateOptsBuilder) projects.CreateResult
+	DeleteFunc  func(id int) tasks.Result
+	GetFunc     func(id int) projects.GetResult
+	ListFunc    func() pagination.Pager
+	ListAllFunc func() ([]projects.Project, error)
+	UpdateFunc  func(id int, opts projects.UpdateOptsBuilder) projects.UpdateResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ projects.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements projects.API.
+func (m *API) Create(opts projects.CreateOptsBuilder) projects.CreateResult {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: projects.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements projects.API.
+func (m *API) Delete(id int) tasks.Result {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		panic("mocks: projects.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(id)
+}
+
+// Get implements projects.API.
+func (m *API) Get(id int) projects.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: projects.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// List implements projects.API.
+func (m *API) List() pagination.Pager {
+	m.record("List")
+	if m.ListFunc == nil {
+		panic("mocks: projects.API.ListFunc is not set")
+	}
+	return m.ListFunc()
+}
+
+// ListAll implements projects.API.
+func (m *API) ListAll() ([]projects.Project, error) {
+	m.record("ListAll")
+	if m.ListAllFunc == nil {
+		panic("mocks: projects.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc()
+}
+
+// Update implements projects.API.
+func (m *API) Update(id int, opts projects.UpdateOptsBuilder) projects.UpdateResult {
+	m.record("Update", id, opts)
+	if m.UpdateFunc == nil {
+		panic("mocks: projects.API.UpdateFunc is not set")
+	}
+	return m.UpdateFunc(id, opts)
+}
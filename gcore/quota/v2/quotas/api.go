@@ -0,0 +1,42 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package quotas
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+)
+
+// API is the set of quotas operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	ListCombined(opts ListCombinedOptsBuilder) CombinedResult
+	ListGlobal(clientID int) CommonResult
+	ListRegional(clientID int, regionID int) CommonResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// ListCombined calls the package level ListCombined function with the service client.
+func (s *Service) ListCombined(opts ListCombinedOptsBuilder) CombinedResult {
+	return ListCombined(s.ServiceClient, opts)
+}
+
+// ListGlobal calls the package level ListGlobal function with the service client.
+func (s *Service) ListGlobal(clientID int) CommonResult {
+	return ListGlobal(s.ServiceClient, clientID)
+}
+
+// ListRegional calls the package level ListRegional function with the service client.
+func (s *Service) ListRegional(clientID int, regionID int) CommonResult {
+	return ListRegional(s.ServiceClient, clientID, regionID)
+}
@@ -0,0 +1,82 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of quotas.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/quota/v2/quotas"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of quotas.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	ListCombinedFunc func(opts quotas.ListCombinedOptsBuilder) quotas.CombinedResult
+	ListGlobalFunc   func(clientID int) quotas.CommonResult
+	ListRegionalFunc func(clientID int, regionID int) quotas.CommonResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ quotas.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// ListCombined implements quotas.API.
+func (m *API) ListCombined(opts quotas.ListCombinedOptsBuilder) quotas.CombinedResult {
+	m.record("ListCombined", opts)
+	if m.ListCombinedFunc == nil {
+		panic("mocks: quotas.API.ListCombinedFunc is not set")
+	}
+	return m.ListCombinedFunc(opts)
+}
+
+// ListGlobal implements quotas.API.
+func (m *API) ListGlobal(clientID int) quotas.CommonResult {
+	m.record("ListGlobal", clientID)
+	if m.ListGlobalFunc == nil {
+		panic("mocks: quotas.API.ListGlobalFunc is not set")
+	}
+	return m.ListGlobalFunc(clientID)
+}
+
+// ListRegional implements quotas.API.
+func (m *API) ListRegional(clientID int, regionID int) quotas.CommonResult {
+	m.record("ListRegional", clientID, regionID)
+	if m.ListRegionalFunc == nil {
+		panic("mocks: quotas.API.ListRegionalFunc is not set")
+	}
+	return m.ListRegionalFunc(clientID, regionID)
+}
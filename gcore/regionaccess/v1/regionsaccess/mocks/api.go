@@ -0,0 +1,93 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of regionsaccess.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/regionaccess/v1/regionsaccess"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of regionsaccess.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc  func(opts regionsaccess.CreateOptsBuilder) regionsaccess.CreateResult
+	DeleteFunc  func(resellerID int) regionsaccess.DeleteResult
+	ListFunc    func(opts regionsaccess.ListOptsBuilder) pagination.Pager
+	ListAllFunc func(opts regionsaccess.ListOptsBuilder) ([]regionsaccess.RegionAccess, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ regionsaccess.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements regionsaccess.API.
+func (m *API) Create(opts regionsaccess.CreateOptsBuilder) regionsaccess.CreateResult {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: regionsaccess.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements regionsaccess.API.
+func (m *API) Delete(resellerID int) regionsaccess.DeleteResult {
+	m.record("Delete", resellerID)
+	if m.DeleteFunc == nil {
+		panic("mocks: regionsaccess.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(resellerID)
+}
+
+// List implements regionsaccess.API.
+func (m *API) List(opts regionsaccess.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: regionsaccess.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements regionsaccess.API.
+func (m *API) ListAll(opts regionsaccess.ListOptsBuilder) ([]regionsaccess.RegionAccess, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: regionsaccess.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
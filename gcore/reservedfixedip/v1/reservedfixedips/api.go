@@ -0,0 +1,98 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package reservedfixedips
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of reservedfixedips operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	AddPortsToShareVIP(id string, opts PortsToShareVIPOptsBuilder) SliceResult
+	Create(opts CreateOptsBuilder) tasks.Result
+	Delete(id string) tasks.Result
+	Get(id string) GetResult
+	List(opts ListOptsBuilder) pagination.Pager
+	ListAll(opts ListOptsBuilder) ([]ReservedFixedIP, error)
+	ListAllAvailableDevice(id string) ([]Device, error)
+	ListAllConnectedDevice(id string) ([]Device, error)
+	ListAvailableDevice(id string) pagination.Pager
+	ListConnectedDevice(id string) pagination.Pager
+	ReplacePortsToShareVIP(id string, opts PortsToShareVIPOptsBuilder) SliceResult
+	SwitchVIP(id string, opts SwitchVIPOptsBuilder) GetResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// AddPortsToShareVIP calls the package level AddPortsToShareVIP function with the service client.
+func (s *Service) AddPortsToShareVIP(id string, opts PortsToShareVIPOptsBuilder) SliceResult {
+	return AddPortsToShareVIP(s.ServiceClient, id, opts)
+}
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(id string) tasks.Result {
+	return Delete(s.ServiceClient, id)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id string) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(opts ListOptsBuilder) ([]ReservedFixedIP, error) {
+	return ListAll(s.ServiceClient, opts)
+}
+
+// ListAllAvailableDevice calls the package level ListAllAvailableDevice function with the service client.
+func (s *Service) ListAllAvailableDevice(id string) ([]Device, error) {
+	return ListAllAvailableDevice(s.ServiceClient, id)
+}
+
+// ListAllConnectedDevice calls the package level ListAllConnectedDevice function with the service client.
+func (s *Service) ListAllConnectedDevice(id string) ([]Device, error) {
+	return ListAllConnectedDevice(s.ServiceClient, id)
+}
+
+// ListAvailableDevice calls the package level ListAvailableDevice function with the service client.
+func (s *Service) ListAvailableDevice(id string) pagination.Pager {
+	return ListAvailableDevice(s.ServiceClient, id)
+}
+
+// ListConnectedDevice calls the package level ListConnectedDevice function with the service client.
+func (s *Service) ListConnectedDevice(id string) pagination.Pager {
+	return ListConnectedDevice(s.ServiceClient, id)
+}
+
+// ReplacePortsToShareVIP calls the package level ReplacePortsToShareVIP function with the service client.
+func (s *Service) ReplacePortsToShareVIP(id string, opts PortsToShareVIPOptsBuilder) SliceResult {
+	return ReplacePortsToShareVIP(s.ServiceClient, id, opts)
+}
+
+// SwitchVIP calls the package level SwitchVIP function with the service client.
+func (s *Service) SwitchVIP(id string, opts SwitchVIPOptsBuilder) GetResult {
+	return SwitchVIP(s.ServiceClient, id, opts)
+}
@@ -0,0 +1,174 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of reservedfixedips.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/reservedfixedip/v1/reservedfixedips"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of reservedfixedips.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	AddPortsToShareVIPFunc     func(id string, opts reservedfixedips.PortsToShareVIPOptsBuilder) reservedfixedips.SliceResult
+	CreateFunc                 func(opts reservedfixedips.CreateOptsBuilder) tasks.Result
+	DeleteFunc                 func(id string) tasks.Result
+	GetFunc                    func(id string) reservedfixedips.GetResult
+	ListFunc                   func(opts reservedfixedips.ListOptsBuilder) pagination.Pager
+	ListAllFunc                func(opts reservedfixedips.ListOptsBuilder) ([]reservedfixedips.ReservedFixedIP, error)
+	ListAllAvailableDeviceFunc func(id string) ([]reservedfixedips.Device, error)
+	ListAllConnectedDeviceFunc func(id string) ([]reservedfixedips.Device, error)
+	ListAvailableDeviceFunc    func(id string) pagination.Pager
+	ListConnectedDeviceFunc    func(id string) pagination.Pager
+	ReplacePortsToShareVIPFunc func(id string, opts reservedfixedips.PortsToShareVIPOptsBuilder) reservedfixedips.SliceResult
+	SwitchVIPFunc              func(id string, opts reservedfixedips.SwitchVIPOptsBuilder) reservedfixedips.GetResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ reservedfixedips.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// AddPortsToShareVIP implements reservedfixedips.API.
+func (m *API) AddPortsToShareVIP(id string, opts reservedfixedips.PortsToShareVIPOptsBuilder) reservedfixedips.SliceResult {
+	m.record("AddPortsToShareVIP", id, opts)
+	if m.AddPortsToShareVIPFunc == nil {
+		panic("mocks: reservedfixedips.API.AddPortsToShareVIPFunc is not set")
+	}
+	return m.AddPortsToShareVIPFunc(id, opts)
+}
+
+// Create implements reservedfixedips.API.
+func (m *API) Create(opts reservedfixedips.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: reservedfixedips.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements reservedfixedips.API.
+func (m *API) Delete(id string) tasks.Result {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		panic("mocks: reservedfixedips.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(id)
+}
+
+// Get implements reservedfixedips.API.
+func (m *API) Get(id string) reservedfixedips.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: reservedfixedips.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// List implements reservedfixedips.API.
+func (m *API) List(opts reservedfixedips.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: reservedfixedips.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements reservedfixedips.API.
+func (m *API) ListAll(opts reservedfixedips.ListOptsBuilder) ([]reservedfixedips.ReservedFixedIP, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: reservedfixedips.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// ListAllAvailableDevice implements reservedfixedips.API.
+func (m *API) ListAllAvailableDevice(id string) ([]reservedfixedips.Device, error) {
+	m.record("ListAllAvailableDevice", id)
+	if m.ListAllAvailableDeviceFunc == nil {
+		panic("mocks: reservedfixedips.API.ListAllAvailableDeviceFunc is not set")
+	}
+	return m.ListAllAvailableDeviceFunc(id)
+}
+
+// ListAllConnectedDevice implements reservedfixedips.API.
+func (m *API) ListAllConnectedDevice(id string) ([]reservedfixedips.Device, error) {
+	m.record("ListAllConnectedDevice", id)
+	if m.ListAllConnectedDeviceFunc == nil {
+		panic("mocks: reservedfixedips.API.ListAllConnectedDeviceFunc is not set")
+	}
+	return m.ListAllConnectedDeviceFunc(id)
+}
+
+// ListAvailableDevice implements reservedfixedips.API.
+func (m *API) ListAvailableDevice(id string) pagination.Pager {
+	m.record("ListAvailableDevice", id)
+	if m.ListAvailableDeviceFunc == nil {
+		panic("mocks: reservedfixedips.API.ListAvailableDeviceFunc is not set")
+	}
+	return m.ListAvailableDeviceFunc(id)
+}
+
+// ListConnectedDevice implements reservedfixedips.API.
+func (m *API) ListConnectedDevice(id string) pagination.Pager {
+	m.record("ListConnectedDevice", id)
+	if m.ListConnectedDeviceFunc == nil {
+		panic("mocks: reservedfixedips.API.ListConnectedDeviceFunc is not set")
+	}
+	return m.ListConnectedDeviceFunc(id)
+}
+
+// ReplacePortsToShareVIP implements reservedfixedips.API.
+func (m *API) ReplacePortsToShareVIP(id string, opts reservedfixedips.PortsToShareVIPOptsBuilder) reservedfixedips.SliceResult {
+	m.record("ReplacePortsToShareVIP", id, opts)
+	if m.ReplacePortsToShareVIPFunc == nil {
+		panic("mocks: reservedfixedips.API.ReplacePortsToShareVIPFunc is not set")
+	}
+	return m.ReplacePortsToShareVIPFunc(id, opts)
+}
+
+// SwitchVIP implements reservedfixedips.API.
+func (m *API) SwitchVIP(id string, opts reservedfixedips.SwitchVIPOptsBuilder) reservedfixedips.GetResult {
+	m.record("SwitchVIP", id, opts)
+	if m.SwitchVIPFunc == nil {
+		panic("mocks: reservedfixedips.API.SwitchVIPFunc is not set")
+	}
+	return m.SwitchVIPFunc(id, opts)
+}
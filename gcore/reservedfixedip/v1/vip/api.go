@@ -0,0 +1,42 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package vip
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+)
+
+// API is the set of vip operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Create(opts CreateOpts) (*Status, error)
+	Failover(vipID string, toPortID string) (*Status, error)
+	GetStatus(vipID string) (*Status, error)
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOpts) (*Status, error) {
+	return Create(s.ServiceClient, opts)
+}
+
+// Failover calls the package level Failover function with the service client.
+func (s *Service) Failover(vipID string, toPortID string) (*Status, error) {
+	return Failover(s.ServiceClient, vipID, toPortID)
+}
+
+// GetStatus calls the package level GetStatus function with the service client.
+func (s *Service) GetStatus(vipID string) (*Status, error) {
+	return GetStatus(s.ServiceClient, vipID)
+}
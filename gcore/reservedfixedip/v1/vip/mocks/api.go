@@ -0,0 +1,82 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of vip.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/reservedfixedip/v1/vip"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of vip.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc    func(opts vip.CreateOpts) (*vip.Status, error)
+	FailoverFunc  func(vipID string, toPortID string) (*vip.Status, error)
+	GetStatusFunc func(vipID string) (*vip.Status, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ vip.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements vip.API.
+func (m *API) Create(opts vip.CreateOpts) (*vip.Status, error) {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: vip.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Failover implements vip.API.
+func (m *API) Failover(vipID string, toPortID string) (*vip.Status, error) {
+	m.record("Failover", vipID, toPortID)
+	if m.FailoverFunc == nil {
+		panic("mocks: vip.API.FailoverFunc is not set")
+	}
+	return m.FailoverFunc(vipID, toPortID)
+}
+
+// GetStatus implements vip.API.
+func (m *API) GetStatus(vipID string) (*vip.Status, error) {
+	m.record("GetStatus", vipID)
+	if m.GetStatusFunc == nil {
+		panic("mocks: vip.API.GetStatusFunc is not set")
+	}
+	return m.GetStatusFunc(vipID)
+}
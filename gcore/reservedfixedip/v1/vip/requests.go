@@ -0,0 +1,158 @@
+package vip
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/floatingip/v1/floatingips"
+	"github.com/G-Core/gcorelabscloud-go/gcore/reservedfixedip/v1/reservedfixedips"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+)
+
+// ErrFailoverNotApplied is returned by Failover when the VIP is not held by the requested port after the update.
+var ErrFailoverNotApplied = errors.New("failover not applied")
+
+// CreateOpts represents options of Create.
+type CreateOpts struct {
+	// SubnetID is the subnet to reserve the VIP in. When empty, the VIP is reserved in a subnet of NetworkID.
+	SubnetID  string
+	NetworkID string
+	// IPAddress is the address to reserve in NetworkID.
+	IPAddress net.IP
+	// PortIDs are the instance ports sharing the VIP, the first one holds it.
+	PortIDs []string
+	Wait    gcorecloud.WaitOpts
+}
+
+// Validate checks the location of the VIP and the ports are set.
+func (opts CreateOpts) Validate() error {
+	if opts.SubnetID == "" && opts.NetworkID == "" {
+		return fmt.Errorf("subnet or network ID is required")
+	}
+	if opts.IPAddress != nil && opts.NetworkID == "" {
+		return fmt.Errorf("network ID is required with an IP address")
+	}
+	if len(opts.PortIDs) == 0 {
+		return fmt.Errorf("at least one port is required")
+	}
+	return nil
+}
+
+// reservationOpts returns the options of the VIP reserved fixed IP.
+func (opts CreateOpts) reservationOpts() reservedfixedips.CreateOpts {
+	reservation := reservedfixedips.CreateOpts{IsVip: true, NetworkID: opts.NetworkID}
+	switch {
+	case opts.SubnetID != "":
+		reservation.Type = reservedfixedips.Subnet
+		reservation.SubnetID = opts.SubnetID
+		reservation.NetworkID = ""
+	case opts.IPAddress != nil:
+		reservation.Type = reservedfixedips.IPAddress
+		reservation.IPAddress = opts.IPAddress
+	default:
+		reservation.Type = reservedfixedips.AnySubnet
+	}
+	return reservation
+}
+
+// Create reserves a VIP, waits for it and shares it with the ports.
+func Create(c *gcorecloud.ServiceClient, opts CreateOpts) (*Status, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	results, err := reservedfixedips.Create(c, opts.reservationOpts()).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("reserving VIP: %w", err)
+	}
+	if len(results.Tasks) == 0 {
+		return nil, fmt.Errorf("no task returned")
+	}
+	task, err := tasks.WaitForTask(c.ForService("tasks"), string(results.Tasks[0]), opts.Wait)
+	if err != nil {
+		return nil, err
+	}
+	vipID, err := reservedfixedips.ExtractReservedFixedIPIDFromTask(task)
+	if err != nil {
+		return nil, err
+	}
+	share := reservedfixedips.PortsToShareVIPOpts{PortIDs: opts.PortIDs}
+	if err := reservedfixedips.ReplacePortsToShareVIP(c, vipID, share).Err; err != nil {
+		return nil, fmt.Errorf("sharing VIP %s with ports: %w", vipID, err)
+	}
+	return GetStatus(c, vipID)
+}
+
+// Failover moves the VIP to a port sharing it: the port is put first in the shared ports, the order of the others
+// is kept. The shared ports are read back to verify the port holds the VIP.
+func Failover(c *gcorecloud.ServiceClient, vipID, toPortID string) (*Status, error) {
+	devices, err := reservedfixedips.ListAllConnectedDevice(c, vipID)
+	if err != nil {
+		return nil, fmt.Errorf("listing ports sharing VIP %s: %w", vipID, err)
+	}
+	portIDs := []string{toPortID}
+	shared := false
+	for _, d := range devices {
+		if d.PortID == toPortID {
+			shared = true
+			continue
+		}
+		portIDs = append(portIDs, d.PortID)
+	}
+	if !shared {
+		return nil, fmt.Errorf("port %s does not share VIP %s", toPortID, vipID)
+	}
+
+	if devices[0].PortID != toPortID {
+		share := reservedfixedips.PortsToShareVIPOpts{PortIDs: portIDs}
+		if err := reservedfixedips.ReplacePortsToShareVIP(c, vipID, share).Err; err != nil {
+			return nil, fmt.Errorf("reordering ports sharing VIP %s: %w", vipID, err)
+		}
+	}
+
+	status, err := GetStatus(c, vipID)
+	if err != nil {
+		return nil, err
+	}
+	if status.Holder == nil || status.Holder.PortID != toPortID {
+		return status, fmt.Errorf("%w: port %s does not hold VIP %s", ErrFailoverNotApplied, toPortID, vipID)
+	}
+	if len(status.Candidates) != len(portIDs)-1 {
+		return status, fmt.Errorf("%w: VIP %s is shared by %d ports instead of %d",
+			ErrFailoverNotApplied, vipID, len(status.Candidates)+1, len(portIDs))
+	}
+	return status, nil
+}
+
+// GetStatus returns the port holding the VIP, the other ports sharing it and the floating IP assigned to it.
+func GetStatus(c *gcorecloud.ServiceClient, vipID string) (*Status, error) {
+	reservation, err := reservedfixedips.Get(c, vipID).Extract()
+	if err != nil {
+		return nil, err
+	}
+	if !reservation.IsVip {
+		return nil, fmt.Errorf("reserved fixed IP %s is not a VIP", vipID)
+	}
+	devices, err := reservedfixedips.ListAllConnectedDevice(c, vipID)
+	if err != nil {
+		return nil, fmt.Errorf("listing ports sharing VIP %s: %w", vipID, err)
+	}
+	status := &Status{VIP: *reservation, Candidates: []reservedfixedips.Device{}}
+	if len(devices) > 0 {
+		status.Holder = &devices[0]
+		status.Candidates = devices[1:]
+	}
+
+	fips, err := floatingips.ListAll(c.ForService("floatingips"), nil)
+	if err != nil {
+		return nil, fmt.Errorf("listing floating IPs: %w", err)
+	}
+	for i := range fips {
+		if fips[i].PortID == vipID {
+			status.FloatingIP = &fips[i]
+			break
+		}
+	}
+	return status, nil
+}
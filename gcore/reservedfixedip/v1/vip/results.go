@@ -0,0 +1,17 @@
+package vip
+
+import (
+	"github.com/G-Core/gcorelabscloud-go/gcore/floatingip/v1/floatingips"
+	"github.com/G-Core/gcorelabscloud-go/gcore/reservedfixedip/v1/reservedfixedips"
+)
+
+// Status describes who holds a VIP.
+type Status struct {
+	VIP reservedfixedips.ReservedFixedIP `json:"vip"`
+	// Holder is the port holding the VIP, the first of the ports sharing it. It is nil when no port shares the VIP.
+	Holder *reservedfixedips.Device `json:"holder"`
+	// Candidates are the other ports sharing the VIP, in failover order.
+	Candidates []reservedfixedips.Device `json:"candidates"`
+	// FloatingIP is the floating IP assigned to the VIP, if any.
+	FloatingIP *floatingips.FloatingIPDetail `json:"floating_ip"`
+}
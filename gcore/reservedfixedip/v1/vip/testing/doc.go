@@ -0,0 +1,2 @@
+// vip unit tests
+package testing
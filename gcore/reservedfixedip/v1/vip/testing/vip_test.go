@@ -0,0 +1,126 @@
+package testing
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/floatingip/v1/floatingips"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/network/v1/networks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/reservedfixedip/v1/reservedfixedips"
+	"github.com/G-Core/gcorelabscloud-go/gcore/reservedfixedip/v1/vip"
+	"github.com/G-Core/gcorelabscloud-go/gcore/subnet/v1/subnets"
+	th "github.com/G-Core/gcorelabscloud-go/testhelper"
+	fake "github.com/G-Core/gcorelabscloud-go/testhelper/client"
+	"github.com/G-Core/gcorelabscloud-go/testhelper/fakecloud"
+	"github.com/stretchr/testify/require"
+)
+
+var fastWait = gcorecloud.WaitOpts{Timeout: 5 * time.Second, Interval: 10 * time.Millisecond}
+
+// instancePorts creates instances in the subnet and returns their ports.
+func instancePorts(t *testing.T, server *fakecloud.Server, subnetID string, count int) []string {
+	client := server.ServiceClient("instances", "v1")
+	var ports []string
+	for i := 0; i < count; i++ {
+		instanceID := server.CreatedID(t, instances.Create(client, instances.CreateOpts{
+			Flavor:  "g1-standard-1-2",
+			Names:   []string{"node"},
+			Volumes: []instances.CreateVolumeOpts{{Source: types.NewVolume, Size: 10}},
+			Interfaces: []instances.InterfaceInstanceCreateOpts{{
+				InterfaceOpts: instances.InterfaceOpts{Type: types.SubnetInterfaceType, SubnetID: subnetID},
+			}},
+		}), instances.ExtractInstanceIDFromTask)
+		interfaces, err := instances.ListInterfacesAll(client, instanceID)
+		require.NoError(t, err)
+		ports = append(ports, interfaces[0].PortID)
+	}
+	return ports
+}
+
+func TestCreateFailoverStatus(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	client := server.ServiceClient("reserved_fixed_ips", "v1")
+
+	networkID := server.CreatedID(t, networks.Create(server.ServiceClient("networks", "v1"), networks.CreateOpts{Name: "network"}),
+		networks.ExtractNetworkIDFromTask)
+	cidr, err := gcorecloud.ParseCIDRString("192.168.0.0/24")
+	require.NoError(t, err)
+	subnetID := server.CreatedID(t, subnets.Create(server.ServiceClient("subnets", "v1"), subnets.CreateOpts{
+		Name:      "subnet",
+		CIDR:      *cidr,
+		NetworkID: networkID,
+	}), subnets.ExtractSubnetIDFromTask)
+	ports := instancePorts(t, server, subnetID, 3)
+
+	_, err = vip.Create(client, vip.CreateOpts{SubnetID: subnetID, Wait: fastWait})
+	require.Error(t, err)
+
+	status, err := vip.Create(client, vip.CreateOpts{SubnetID: subnetID, PortIDs: ports, Wait: fastWait})
+	require.NoError(t, err)
+	require.True(t, status.VIP.IsVip)
+	require.Equal(t, subnetID, status.VIP.SubnetID)
+	require.Equal(t, ports[0], status.Holder.PortID)
+	require.Len(t, status.Candidates, 2)
+	require.Nil(t, status.FloatingIP)
+	vipID := status.VIP.PortID
+
+	fipID := server.CreatedID(t, floatingips.Create(server.ServiceClient("floatingips", "v1"), floatingips.CreateOpts{PortID: vipID}),
+		floatingips.ExtractFloatingIPIDFromTask)
+
+	status, err = vip.Failover(client, vipID, ports[2])
+	require.NoError(t, err)
+	require.Equal(t, ports[2], status.Holder.PortID)
+	require.Equal(t, ports[0], status.Candidates[0].PortID)
+	require.Equal(t, ports[1], status.Candidates[1].PortID)
+	require.Equal(t, fipID, status.FloatingIP.ID)
+	require.Equal(t, status.VIP.FixedIPAddress.String(), status.FloatingIP.FixedIPAddress.String())
+
+	status, err = vip.Failover(client, vipID, ports[2])
+	require.NoError(t, err)
+	require.Equal(t, ports[2], status.Holder.PortID)
+
+	_, err = vip.Failover(client, vipID, "unknown")
+	require.Error(t, err)
+
+	reservation := server.CreatedID(t, reservedfixedips.Create(client, reservedfixedips.CreateOpts{
+		Type:     reservedfixedips.Subnet,
+		SubnetID: subnetID,
+	}), reservedfixedips.ExtractReservedFixedIPIDFromTask)
+	_, err = vip.GetStatus(client, reservation)
+	require.Error(t, err)
+}
+
+func TestFailoverNotApplied(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	// The shared ports keep their order whatever is sent.
+	vipID := "e78cf2a7-1f9e-4d2c-8c2b-a41d1ca7e4f1"
+	first := "2b1a8f43-5d0e-4c7e-9a55-3c1f6b0d9e21"
+	second := "9c4d2e17-8b3a-4f6d-a0e2-7d5c1b8f3a64"
+	th.Mux.HandleFunc(fmt.Sprintf("/v1/reserved_fixed_ips/%d/%d/%s", fake.ProjectID, fake.RegionID, vipID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"port_id": "%s", "is_vip": true}`, vipID)
+	})
+	th.Mux.HandleFunc(fmt.Sprintf("/v1/reserved_fixed_ips/%d/%d/%s/connected_devices", fake.ProjectID, fake.RegionID, vipID), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"count": 2, "results": [{"port_id": "%s"}, {"port_id": "%s"}]}`, first, second)
+	})
+	th.Mux.HandleFunc(fmt.Sprintf("/v1/floatingips/%d/%d", fake.ProjectID, fake.RegionID), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"count": 0, "results": []}`)
+	})
+
+	client := fake.ServiceTokenClient("reserved_fixed_ips", "v1")
+	status, err := vip.Failover(client, vipID, second)
+	require.True(t, errors.Is(err, vip.ErrFailoverNotApplied))
+	require.Equal(t, first, status.Holder.PortID)
+}
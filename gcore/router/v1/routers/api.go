@@ -0,0 +1,99 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package routers
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/subnet/v1/subnets"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of routers operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	AddRoutes(routerID string, routes ...subnets.HostRoute) (*Router, error)
+	Attach(routerID string, subnetID string) GetResult
+	Create(opts CreateOptsBuilder) tasks.Result
+	Delete(routerID string) tasks.Result
+	Detach(routerID string, subnetID string) GetResult
+	Get(id string) GetResult
+	List(opts ListOptsBuilder) pagination.Pager
+	ListAll(opts ListOptsBuilder) ([]Router, error)
+	PlanSyncInterfaces(routerID string, subnetIDs []string) (*InterfaceSyncReport, error)
+	RemoveRoutes(routerID string, routes ...subnets.HostRoute) (*Router, error)
+	SyncInterfaces(routerID string, subnetIDs []string) (*InterfaceSyncReport, error)
+	Update(routerID string, opts UpdateOptsBuilder) UpdateResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// AddRoutes calls the package level AddRoutes function with the service client.
+func (s *Service) AddRoutes(routerID string, routes ...subnets.HostRoute) (*Router, error) {
+	return AddRoutes(s.ServiceClient, routerID, routes...)
+}
+
+// Attach calls the package level Attach function with the service client.
+func (s *Service) Attach(routerID string, subnetID string) GetResult {
+	return Attach(s.ServiceClient, routerID, subnetID)
+}
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(routerID string) tasks.Result {
+	return Delete(s.ServiceClient, routerID)
+}
+
+// Detach calls the package level Detach function with the service client.
+func (s *Service) Detach(routerID string, subnetID string) GetResult {
+	return Detach(s.ServiceClient, routerID, subnetID)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id string) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(opts ListOptsBuilder) ([]Router, error) {
+	return ListAll(s.ServiceClient, opts)
+}
+
+// PlanSyncInterfaces calls the package level PlanSyncInterfaces function with the service client.
+func (s *Service) PlanSyncInterfaces(routerID string, subnetIDs []string) (*InterfaceSyncReport, error) {
+	return PlanSyncInterfaces(s.ServiceClient, routerID, subnetIDs)
+}
+
+// RemoveRoutes calls the package level RemoveRoutes function with the service client.
+func (s *Service) RemoveRoutes(routerID string, routes ...subnets.HostRoute) (*Router, error) {
+	return RemoveRoutes(s.ServiceClient, routerID, routes...)
+}
+
+// SyncInterfaces calls the package level SyncInterfaces function with the service client.
+func (s *Service) SyncInterfaces(routerID string, subnetIDs []string) (*InterfaceSyncReport, error) {
+	return SyncInterfaces(s.ServiceClient, routerID, subnetIDs)
+}
+
+// Update calls the package level Update function with the service client.
+func (s *Service) Update(routerID string, opts UpdateOptsBuilder) UpdateResult {
+	return Update(s.ServiceClient, routerID, opts)
+}
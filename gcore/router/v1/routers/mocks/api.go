@@ -0,0 +1,175 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of routers.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/router/v1/routers"
+	"github.com/G-Core/gcorelabscloud-go/gcore/subnet/v1/subnets"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of routers.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	AddRoutesFunc          func(routerID string, routes ...subnets.HostRoute) (*routers.Router, error)
+	AttachFunc             func(routerID string, subnetID string) routers.GetResult
+	CreateFunc             func(opts routers.CreateOptsBuilder) tasks.Result
+	DeleteFunc             func(routerID string) tasks.Result
+	DetachFunc             func(routerID string, subnetID string) routers.GetResult
+	GetFunc                func(id string) routers.GetResult
+	ListFunc               func(opts routers.ListOptsBuilder) pagination.Pager
+	ListAllFunc            func(opts routers.ListOptsBuilder) ([]routers.Router, error)
+	PlanSyncInterfacesFunc func(routerID string, subnetIDs []string) (*routers.InterfaceSyncReport, error)
+	RemoveRoutesFunc       func(routerID string, routes ...subnets.HostRoute) (*routers.Router, error)
+	SyncInterfacesFunc     func(routerID string, subnetIDs []string) (*routers.InterfaceSyncReport, error)
+	UpdateFunc             func(routerID string, opts routers.UpdateOptsBuilder) routers.UpdateResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ routers.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// AddRoutes implements routers.API.
+func (m *API) AddRoutes(routerID string, routes ...subnets.HostRoute) (*routers.Router, error) {
+	m.record("AddRoutes", routerID, routes)
+	if m.AddRoutesFunc == nil {
+		panic("mocks: routers.API.AddRoutesFunc is not set")
+	}
+	return m.AddRoutesFunc(routerID, routes...)
+}
+
+// Attach implements routers.API.
+func (m *API) Attach(routerID string, subnetID string) routers.GetResult {
+	m.record("Attach", routerID, subnetID)
+	if m.AttachFunc == nil {
+		panic("mocks: routers.API.AttachFunc is not set")
+	}
+	return m.AttachFunc(routerID, subnetID)
+}
+
+// Create implements routers.API.
+func (m *API) Create(opts routers.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: routers.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements routers.API.
+func (m *API) Delete(routerID string) tasks.Result {
+	m.record("Delete", routerID)
+	if m.DeleteFunc == nil {
+		panic("mocks: routers.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(routerID)
+}
+
+// Detach implements routers.API.
+func (m *API) Detach(routerID string, subnetID string) routers.GetResult {
+	m.record("Detach", routerID, subnetID)
+	if m.DetachFunc == nil {
+		panic("mocks: routers.API.DetachFunc is not set")
+	}
+	return m.DetachFunc(routerID, subnetID)
+}
+
+// Get implements routers.API.
+func (m *API) Get(id string) routers.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: routers.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// List implements routers.API.
+func (m *API) List(opts routers.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: routers.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements routers.API.
+func (m *API) ListAll(opts routers.ListOptsBuilder) ([]routers.Router, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: routers.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// PlanSyncInterfaces implements routers.API.
+func (m *API) PlanSyncInterfaces(routerID string, subnetIDs []string) (*routers.InterfaceSyncReport, error) {
+	m.record("PlanSyncInterfaces", routerID, subnetIDs)
+	if m.PlanSyncInterfacesFunc == nil {
+		panic("mocks: routers.API.PlanSyncInterfacesFunc is not set")
+	}
+	return m.PlanSyncInterfacesFunc(routerID, subnetIDs)
+}
+
+// RemoveRoutes implements routers.API.
+func (m *API) RemoveRoutes(routerID string, routes ...subnets.HostRoute) (*routers.Router, error) {
+	m.record("RemoveRoutes", routerID, routes)
+	if m.RemoveRoutesFunc == nil {
+		panic("mocks: routers.API.RemoveRoutesFunc is not set")
+	}
+	return m.RemoveRoutesFunc(routerID, routes...)
+}
+
+// SyncInterfaces implements routers.API.
+func (m *API) SyncInterfaces(routerID string, subnetIDs []string) (*routers.InterfaceSyncReport, error) {
+	m.record("SyncInterfaces", routerID, subnetIDs)
+	if m.SyncInterfacesFunc == nil {
+		panic("mocks: routers.API.SyncInterfacesFunc is not set")
+	}
+	return m.SyncInterfacesFunc(routerID, subnetIDs)
+}
+
+// Update implements routers.API.
+func (m *API) Update(routerID string, opts routers.UpdateOptsBuilder) routers.UpdateResult {
+	m.record("Update", routerID, opts)
+	if m.UpdateFunc == nil {
+		panic("mocks: routers.API.UpdateFunc is not set")
+	}
+	return m.UpdateFunc(routerID, opts)
+}
@@ -1,6 +1,10 @@
 package routers
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/gcore/router/v1/types"
 	"github.com/G-Core/gcorelabscloud-go/gcore/subnet/v1/subnets"
@@ -221,3 +225,216 @@ func Detach(c *gcorecloud.ServiceClient, routerID string, subnetID string) (r Ge
 	_, r.Err = c.Post(detachURL(c, routerID), body, &r.Body, nil)
 	return
 }
+
+// ErrRoutesConflict is returned by AddRoutes and RemoveRoutes when the routes of the router keep changing
+// concurrently, or the change is not found after the update.
+var ErrRoutesConflict = errors.New("routes of the router changed concurrently")
+
+// routeUpdateAttempts is the count of read-modify-write attempts of a route update.
+const routeUpdateAttempts = 3
+
+func routeKey(route subnets.HostRoute) string {
+	return route.Destination.String() + " " + route.NextHop.String()
+}
+
+func sameRoutes(a, b []subnets.HostRoute) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if routeKey(a[i]) != routeKey(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkRoutes compares the routes read after an update with the change from before to wanted. It reports whether the
+// change is applied, that is the added routes are present and the removed ones absent, and returns the other routes
+// of before which are missing.
+func checkRoutes(after, wanted, before []subnets.HostRoute) (bool, []string) {
+	present := make(map[string]bool, len(after))
+	for _, route := range after {
+		present[routeKey(route)] = true
+	}
+	previous := make(map[string]bool, len(before))
+	for _, route := range before {
+		previous[routeKey(route)] = true
+	}
+	kept := make(map[string]bool, len(wanted))
+	applied := true
+	var dropped []string
+	for _, route := range wanted {
+		key := routeKey(route)
+		kept[key] = true
+		switch {
+		case present[key]:
+		case previous[key]:
+			dropped = append(dropped, key)
+		default:
+			applied = false
+		}
+	}
+	for _, route := range before {
+		if !kept[routeKey(route)] && present[routeKey(route)] {
+			applied = false
+		}
+	}
+	return applied, dropped
+}
+
+// updateRoutes replaces the routes of the router with the routes returned by modify. The routes are read again
+// before the update: when they changed since modify was called, the update is attempted again with the current
+// routes, up to routeUpdateAttempts times in all. They are read after the update as well: the update is attempted
+// again when the change is missing, and ErrRoutesConflict is returned when other routes of the router are missing. The
+// check is best-effort, the API has no conditional update: a route added by another client between the last read and
+// the update is lost unnoticed.
+func updateRoutes(c *gcorecloud.ServiceClient, routerID string, modify func([]subnets.HostRoute) ([]subnets.HostRoute, error)) (*Router, error) {
+	for attempt := 0; attempt < routeUpdateAttempts; attempt++ {
+		router, err := Get(c, routerID).Extract()
+		if err != nil {
+			return nil, err
+		}
+		routes, err := modify(append([]subnets.HostRoute{}, router.Routes...))
+		if err != nil {
+			return nil, err
+		}
+		if sameRoutes(routes, router.Routes) {
+			return router, nil
+		}
+		current, err := Get(c, routerID).Extract()
+		if err != nil {
+			return nil, err
+		}
+		if !sameRoutes(current.Routes, router.Routes) {
+			continue
+		}
+		if _, err := Update(c, routerID, UpdateOpts{Routes: routes}).Extract(); err != nil {
+			return nil, err
+		}
+		updated, err := Get(c, routerID).Extract()
+		if err != nil {
+			return nil, err
+		}
+		applied, dropped := checkRoutes(updated.Routes, routes, router.Routes)
+		if len(dropped) > 0 {
+			return nil, fmt.Errorf("%w: routes %s are missing after the update", ErrRoutesConflict, strings.Join(dropped, ", "))
+		}
+		if applied {
+			return updated, nil
+		}
+	}
+	return nil, ErrRoutesConflict
+}
+
+// AddRoutes adds the host routes to the router, keeping its other routes. Routes already present are skipped; a
+// route to a destination already routed via another next hop is an error.
+func AddRoutes(c *gcorecloud.ServiceClient, routerID string, routes ...subnets.HostRoute) (*Router, error) {
+	return updateRoutes(c, routerID, func(current []subnets.HostRoute) ([]subnets.HostRoute, error) {
+		for _, route := range routes {
+			found := false
+			for _, existing := range current {
+				if existing.Destination.String() != route.Destination.String() {
+					continue
+				}
+				if !existing.NextHop.Equal(route.NextHop) {
+					return nil, fmt.Errorf("route to %s already exists via %s", route.Destination.String(), existing.NextHop)
+				}
+				found = true
+			}
+			if !found {
+				current = append(current, route)
+			}
+		}
+		return current, nil
+	})
+}
+
+// RemoveRoutes removes the host routes from the router, keeping its other routes. Routes not present are skipped.
+func RemoveRoutes(c *gcorecloud.ServiceClient, routerID string, routes ...subnets.HostRoute) (*Router, error) {
+	removed := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		removed[routeKey(route)] = true
+	}
+	return updateRoutes(c, routerID, func(current []subnets.HostRoute) ([]subnets.HostRoute, error) {
+		kept := make([]subnets.HostRoute, 0, len(current))
+		for _, route := range current {
+			if !removed[routeKey(route)] {
+				kept = append(kept, route)
+			}
+		}
+		return kept, nil
+	})
+}
+
+// attachedSubnets returns the IDs of the subnets the router has an interface in, in interface order.
+func attachedSubnets(router *Router) []string {
+	var result []string
+	seen := map[string]bool{}
+	for _, iface := range router.Interfaces {
+		for _, a := range iface.IPAssignments {
+			if a.SubnetID != "" && !seen[a.SubnetID] {
+				seen[a.SubnetID] = true
+				result = append(result, a.SubnetID)
+			}
+		}
+	}
+	return result
+}
+
+// PlanSyncInterfaces returns the subnets to attach to and to detach from the router so it has an interface in
+// exactly the given subnets, without applying the changes.
+func PlanSyncInterfaces(c *gcorecloud.ServiceClient, routerID string, subnetIDs []string) (*InterfaceSyncReport, error) {
+	router, err := Get(c, routerID).Extract()
+	if err != nil {
+		return nil, err
+	}
+	current := attachedSubnets(router)
+	attached := make(map[string]bool, len(current))
+	for _, id := range current {
+		attached[id] = true
+	}
+	wanted := make(map[string]bool, len(subnetIDs))
+
+	report := &InterfaceSyncReport{RouterID: routerID, Attach: []string{}, Detach: []string{}, Unchanged: []string{}}
+	for _, id := range subnetIDs {
+		if wanted[id] {
+			continue
+		}
+		wanted[id] = true
+		if attached[id] {
+			report.Unchanged = append(report.Unchanged, id)
+		} else {
+			report.Attach = append(report.Attach, id)
+		}
+	}
+	for _, id := range current {
+		if !wanted[id] {
+			report.Detach = append(report.Detach, id)
+		}
+	}
+	return report, nil
+}
+
+// SyncInterfaces attaches and detaches subnets so the router has an interface in exactly the given subnets, see
+// PlanSyncInterfaces. The subnets are attached before the others are detached. On failure, the report tells the
+// changes already applied.
+func SyncInterfaces(c *gcorecloud.ServiceClient, routerID string, subnetIDs []string) (*InterfaceSyncReport, error) {
+	report, err := PlanSyncInterfaces(c, routerID, subnetIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range report.Attach {
+		if err := Attach(c, routerID, id).Err; err != nil {
+			return report, fmt.Errorf("attaching subnet %s to router %s: %w", id, routerID, err)
+		}
+		report.Attached = append(report.Attached, id)
+	}
+	for _, id := range report.Detach {
+		if err := Detach(c, routerID, id).Err; err != nil {
+			return report, fmt.Errorf("detaching subnet %s from router %s: %w", id, routerID, err)
+		}
+		report.Detached = append(report.Detached, id)
+	}
+	return report, nil
+}
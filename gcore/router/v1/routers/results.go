@@ -123,3 +123,19 @@ func ExtractRouterIDFromTask(task *tasks.Task) (string, error) {
 	}
 	return result.Routers[0], nil
 }
+
+// InterfaceSyncReport describes the changes converging the interfaces of a router to a set of subnets.
+type InterfaceSyncReport struct {
+	RouterID string `json:"router_id"`
+	// Attach and Detach are the subnets to attach and to detach, Attached and Detached the ones done.
+	Attach    []string `json:"attach"`
+	Detach    []string `json:"detach"`
+	Unchanged []string `json:"unchanged"`
+	Attached  []string `json:"attached,omitempty"`
+	Detached  []string `json:"detached,omitempty"`
+}
+
+// HasChanges reports whether the interfaces of the router differ from the desired ones.
+func (r InterfaceSyncReport) HasChanges() bool {
+	return len(r.Attach) > 0 || len(r.Detach) > 0
+}
@@ -0,0 +1,166 @@
+package testing
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/network/v1/networks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/router/v1/routers"
+	"github.com/G-Core/gcorelabscloud-go/gcore/router/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/subnet/v1/subnets"
+	th "github.com/G-Core/gcorelabscloud-go/testhelper"
+	fake "github.com/G-Core/gcorelabscloud-go/testhelper/client"
+	"github.com/G-Core/gcorelabscloud-go/testhelper/fakecloud"
+	"github.com/stretchr/testify/require"
+)
+
+func hostRoute(t *testing.T, destination, nexthop string) subnets.HostRoute {
+	cidr, err := gcorecloud.ParseCIDRString(destination)
+	require.NoError(t, err)
+	return subnets.HostRoute{Destination: *cidr, NextHop: net.ParseIP(nexthop)}
+}
+
+func TestAddRemoveRoutes(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	client := server.ServiceClient("routers", "v1")
+
+	first := hostRoute(t, "10.10.0.0/16", "192.168.0.10")
+	routerID := server.CreatedID(t, routers.Create(client, routers.CreateOpts{
+		Name:   "router",
+		Routes: []subnets.HostRoute{first},
+	}), routers.ExtractRouterIDFromTask)
+
+	second := hostRoute(t, "10.20.0.0/16", "192.168.0.20")
+	router, err := routers.AddRoutes(client, routerID, second, first)
+	require.NoError(t, err)
+	require.Len(t, router.Routes, 2)
+	require.Equal(t, second.Destination.String(), router.Routes[1].Destination.String())
+
+	_, err = routers.AddRoutes(client, routerID, hostRoute(t, "10.20.0.0/16", "192.168.0.30"))
+	require.Error(t, err)
+
+	router, err = routers.RemoveRoutes(client, routerID, first, hostRoute(t, "10.30.0.0/16", "192.168.0.30"))
+	require.NoError(t, err)
+	require.Len(t, router.Routes, 1)
+	require.Equal(t, second.NextHop.String(), router.Routes[0].NextHop.String())
+}
+
+func TestAddRoutesConflict(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	// Every read returns other routes, as if another client kept updating them.
+	reads := 0
+	th.Mux.HandleFunc(prepareGetTestURL(Router1.ID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		reads++
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"id": "%s", "routes": [{"destination": "10.0.%d.0/24", "nexthop": "192.168.0.1"}]}`, Router1.ID, reads)
+	})
+
+	client := fake.ServiceTokenClient("routers", "v1")
+	_, err := routers.AddRoutes(client, Router1.ID, hostRoute(t, "10.20.0.0/16", "192.168.0.20"))
+	require.True(t, errors.Is(err, routers.ErrRoutesConflict))
+	require.Equal(t, 6, reads)
+}
+
+// serveRoutes serves a router whose routes are replaced by update on every PATCH request, and counts the updates.
+func serveRoutes(routes string, update func() string) *int {
+	updates := 0
+	th.Mux.HandleFunc(prepareGetTestURL(Router1.ID), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			updates++
+			routes = update()
+		}
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"id": "%s", "routes": %s}`, Router1.ID, routes)
+	})
+	return &updates
+}
+
+func TestAddRoutesNotApplied(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	// The updates are accepted but the routes stay the same.
+	initial := `[{"destination": "10.10.0.0/16", "nexthop": "192.168.0.10"}]`
+	updates := serveRoutes(initial, func() string { return initial })
+
+	client := fake.ServiceTokenClient("routers", "v1")
+	_, err := routers.AddRoutes(client, Router1.ID, hostRoute(t, "10.20.0.0/16", "192.168.0.20"))
+	require.True(t, errors.Is(err, routers.ErrRoutesConflict))
+	require.Equal(t, 3, *updates)
+}
+
+func TestRemoveRoutesDropped(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	// The update removes the other route of the router as well.
+	updates := serveRoutes(`[
+		{"destination": "10.10.0.0/16", "nexthop": "192.168.0.10"},
+		{"destination": "10.20.0.0/16", "nexthop": "192.168.0.20"}
+	]`, func() string { return `[]` })
+
+	client := fake.ServiceTokenClient("routers", "v1")
+	_, err := routers.RemoveRoutes(client, Router1.ID, hostRoute(t, "10.20.0.0/16", "192.168.0.20"))
+	require.True(t, errors.Is(err, routers.ErrRoutesConflict))
+	require.Contains(t, err.Error(), "10.10.0.0/16 192.168.0.10")
+	require.Equal(t, 1, *updates)
+}
+
+func TestSyncInterfaces(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	client := server.ServiceClient("routers", "v1")
+
+	networkID := server.CreatedID(t, networks.Create(server.ServiceClient("networks", "v1"), networks.CreateOpts{Name: "network"}),
+		networks.ExtractNetworkIDFromTask)
+	var subnetIDs []string
+	for i := 0; i < 3; i++ {
+		cidr, err := gcorecloud.ParseCIDRString(fmt.Sprintf("192.168.%d.0/24", i))
+		require.NoError(t, err)
+		subnetIDs = append(subnetIDs, server.CreatedID(t, subnets.Create(server.ServiceClient("subnets", "v1"), subnets.CreateOpts{
+			Name:      fmt.Sprintf("subnet-%d", i),
+			CIDR:      *cidr,
+			NetworkID: networkID,
+		}), subnets.ExtractSubnetIDFromTask))
+	}
+	routerID := server.CreatedID(t, routers.Create(client, routers.CreateOpts{
+		Name: "router",
+		Interfaces: []routers.Interface{
+			{Type: types.SubnetInterfaceType, SubnetID: subnetIDs[0]},
+			{Type: types.SubnetInterfaceType, SubnetID: subnetIDs[1]},
+		},
+	}), routers.ExtractRouterIDFromTask)
+
+	desired := []string{subnetIDs[1], subnetIDs[2]}
+	plan, err := routers.PlanSyncInterfaces(client, routerID, desired)
+	require.NoError(t, err)
+	require.Equal(t, []string{subnetIDs[2]}, plan.Attach)
+	require.Equal(t, []string{subnetIDs[0]}, plan.Detach)
+	require.Equal(t, []string{subnetIDs[1]}, plan.Unchanged)
+	require.Empty(t, plan.Attached)
+
+	report, err := routers.SyncInterfaces(client, routerID, desired)
+	require.NoError(t, err)
+	require.Equal(t, plan.Attach, report.Attached)
+	require.Equal(t, plan.Detach, report.Detached)
+
+	router, err := routers.Get(client, routerID).Extract()
+	require.NoError(t, err)
+	require.Len(t, router.Interfaces, 2)
+	require.Equal(t, subnetIDs[1], router.Interfaces[0].IPAssignments[0].SubnetID)
+	require.Equal(t, subnetIDs[2], router.Interfaces[1].IPAssignments[0].SubnetID)
+
+	report, err = routers.SyncInterfaces(client, routerID, desired)
+	require.NoError(t, err)
+	require.False(t, report.HasChanges())
+}
@@ -0,0 +1,36 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package schedules
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+)
+
+// API is the set of schedules operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Get(scheduleID string) GetResult
+	Update(scheduleID string, opts UpdateOptsBuilder) GetResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(scheduleID string) GetResult {
+	return Get(s.ServiceClient, scheduleID)
+}
+
+// Update calls the package level Update function with the service client.
+func (s *Service) Update(scheduleID string, opts UpdateOptsBuilder) GetResult {
+	return Update(s.ServiceClient, scheduleID, opts)
+}
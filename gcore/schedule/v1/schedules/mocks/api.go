@@ -0,0 +1,72 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of schedules.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/schedule/v1/schedules"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of schedules.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	GetFunc    func(scheduleID string) schedules.GetResult
+	UpdateFunc func(scheduleID string, opts schedules.UpdateOptsBuilder) schedules.GetResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ schedules.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Get implements schedules.API.
+func (m *API) Get(scheduleID string) schedules.GetResult {
+	m.record("Get", scheduleID)
+	if m.GetFunc == nil {
+		panic("mocks: schedules.API.GetFunc is not set")
+	}
+	return m.GetFunc(scheduleID)
+}
+
+// Update implements schedules.API.
+func (m *API) Update(scheduleID string, opts schedules.UpdateOptsBuilder) schedules.GetResult {
+	m.record("Update", scheduleID, opts)
+	if m.UpdateFunc == nil {
+		panic("mocks: schedules.API.UpdateFunc is not set")
+	}
+	return m.UpdateFunc(scheduleID, opts)
+}
@@ -0,0 +1,104 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of secrets.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/secret/v1/secrets"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of secrets.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc  func(opts secrets.CreateOptsBuilder) tasks.Result
+	DeleteFunc  func(securityGroupID string) tasks.Result
+	GetFunc     func(id string) secrets.GetResult
+	ListFunc    func() pagination.Pager
+	ListAllFunc func() ([]secrets.Secret, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ secrets.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements secrets.API.
+func (m *API) Create(opts secrets.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: secrets.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements secrets.API.
+func (m *API) Delete(securityGroupID string) tasks.Result {
+	m.record("Delete", securityGroupID)
+	if m.DeleteFunc == nil {
+		panic("mocks: secrets.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(securityGroupID)
+}
+
+// Get implements secrets.API.
+func (m *API) Get(id string) secrets.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: secrets.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// List implements secrets.API.
+func (m *API) List() pagination.Pager {
+	m.record("List")
+	if m.ListFunc == nil {
+		panic("mocks: secrets.API.ListFunc is not set")
+	}
+	return m.ListFunc()
+}
+
+// ListAll implements secrets.API.
+func (m *API) ListAll() ([]secrets.Secret, error) {
+	m.record("ListAll")
+	if m.ListAllFunc == nil {
+		panic("mocks: secrets.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc()
+}
@@ -0,0 +1,37 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package securitygrouprules
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/securitygroup/v1/securitygroups"
+)
+
+// API is the set of securitygrouprules operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Delete(securityGroupID string) DeleteResult
+	Replace(ruleID string, opts securitygroups.CreateRuleOptsBuilder) securitygroups.CreateRuleResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(securityGroupID string) DeleteResult {
+	return Delete(s.ServiceClient, securityGroupID)
+}
+
+// Replace calls the package level Replace function with the service client.
+func (s *Service) Replace(ruleID string, opts securitygroups.CreateRuleOptsBuilder) securitygroups.CreateRuleResult {
+	return Replace(s.ServiceClient, ruleID, opts)
+}
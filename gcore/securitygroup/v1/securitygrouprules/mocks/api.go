@@ -0,0 +1,73 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of securitygrouprules.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/securitygroup/v1/securitygrouprules"
+	"github.com/G-Core/gcorelabscloud-go/gcore/securitygroup/v1/securitygroups"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of securitygrouprules.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	DeleteFunc  func(securityGroupID string) securitygrouprules.DeleteResult
+	ReplaceFunc func(ruleID string, opts securitygroups.CreateRuleOptsBuilder) securitygroups.CreateRuleResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ securitygrouprules.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Delete implements securitygrouprules.API.
+func (m *API) Delete(securityGroupID string) securitygrouprules.DeleteResult {
+	m.record("Delete", securityGroupID)
+	if m.DeleteFunc == nil {
+		panic("mocks: securitygrouprules.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(securityGroupID)
+}
+
+// Replace implements securitygrouprules.API.
+func (m *API) Replace(ruleID string, opts securitygroups.CreateRuleOptsBuilder) securitygroups.CreateRuleResult {
+	m.record("Replace", ruleID, opts)
+	if m.ReplaceFunc == nil {
+		panic("mocks: securitygrouprules.API.ReplaceFunc is not set")
+	}
+	return m.ReplaceFunc(ruleID, opts)
+}
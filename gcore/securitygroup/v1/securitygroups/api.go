@@ -0,0 +1,158 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package securitygroups
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of securitygroups operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	AddRule(securityGroupID string, opts CreateRuleOptsBuilder) CreateRuleResult
+	Create(opts CreateOptsBuilder) CreateResult
+	DeepCopy(securityGroupID string, opts DeepCopyOptsBuilder) DeepCopyResult
+	Delete(securityGroupID string) DeleteResult
+	Export(opts ExportOpts) (*Document, error)
+	Get(id string) GetResult
+	IDFromName(name string) (string, error)
+	Import(doc Document, opts ImportOpts) (*ImportReport, error)
+	Lint(opts LintOpts) ([]Finding, error)
+	List(opts ListOptsBuilder) pagination.Pager
+	ListAll(opts ListOptsBuilder) ([]SecurityGroup, error)
+	ListAllInstances(securityGroupID string) ([]instances.Instance, error)
+	ListInstances(securityGroupID string) pagination.Pager
+	MetadataCreateOrUpdate(id string, opts map[string]interface{}) MetadataActionResult
+	MetadataDelete(id string, key string) MetadataActionResult
+	MetadataGet(id string, key string) MetadataResult
+	MetadataList(id string) pagination.Pager
+	MetadataListAll(id string) ([]Metadata, error)
+	MetadataReplace(id string, opts map[string]interface{}) MetadataActionResult
+	PlanSync(securityGroupID string, desired []CreateSecurityGroupRuleOpts) (*SyncReport, error)
+	Sync(securityGroupID string, desired []CreateSecurityGroupRuleOpts) (*SyncReport, error)
+	Update(securityGroupID string, opts UpdateOptsBuilder) UpdateResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// AddRule calls the package level AddRule function with the service client.
+func (s *Service) AddRule(securityGroupID string, opts CreateRuleOptsBuilder) CreateRuleResult {
+	return AddRule(s.ServiceClient, securityGroupID, opts)
+}
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) CreateResult {
+	return Create(s.ServiceClient, opts)
+}
+
+// DeepCopy calls the package level DeepCopy function with the service client.
+func (s *Service) DeepCopy(securityGroupID string, opts DeepCopyOptsBuilder) DeepCopyResult {
+	return DeepCopy(s.ServiceClient, securityGroupID, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(securityGroupID string) DeleteResult {
+	return Delete(s.ServiceClient, securityGroupID)
+}
+
+// Export calls the package level Export function with the service client.
+func (s *Service) Export(opts ExportOpts) (*Document, error) {
+	return Export(s.ServiceClient, opts)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id string) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// IDFromName calls the package level IDFromName function with the service client.
+func (s *Service) IDFromName(name string) (string, error) {
+	return IDFromName(s.ServiceClient, name)
+}
+
+// Import calls the package level Import function with the service client.
+func (s *Service) Import(doc Document, opts ImportOpts) (*ImportReport, error) {
+	return Import(s.ServiceClient, doc, opts)
+}
+
+// Lint calls the package level Lint function with the service client.
+func (s *Service) Lint(opts LintOpts) ([]Finding, error) {
+	return Lint(s.ServiceClient, opts)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(opts ListOptsBuilder) ([]SecurityGroup, error) {
+	return ListAll(s.ServiceClient, opts)
+}
+
+// ListAllInstances calls the package level ListAllInstances function with the service client.
+func (s *Service) ListAllInstances(securityGroupID string) ([]instances.Instance, error) {
+	return ListAllInstances(s.ServiceClient, securityGroupID)
+}
+
+// ListInstances calls the package level ListInstances function with the service client.
+func (s *Service) ListInstances(securityGroupID string) pagination.Pager {
+	return ListInstances(s.ServiceClient, securityGroupID)
+}
+
+// MetadataCreateOrUpdate calls the package level MetadataCreateOrUpdate function with the service client.
+func (s *Service) MetadataCreateOrUpdate(id string, opts map[string]interface{}) MetadataActionResult {
+	return MetadataCreateOrUpdate(s.ServiceClient, id, opts)
+}
+
+// MetadataDelete calls the package level MetadataDelete function with the service client.
+func (s *Service) MetadataDelete(id string, key string) MetadataActionResult {
+	return MetadataDelete(s.ServiceClient, id, key)
+}
+
+// MetadataGet calls the package level MetadataGet function with the service client.
+func (s *Service) MetadataGet(id string, key string) MetadataResult {
+	return MetadataGet(s.ServiceClient, id, key)
+}
+
+// MetadataList calls the package level MetadataList function with the service client.
+func (s *Service) MetadataList(id string) pagination.Pager {
+	return MetadataList(s.ServiceClient, id)
+}
+
+// MetadataListAll calls the package level MetadataListAll function with the service client.
+func (s *Service) MetadataListAll(id string) ([]Metadata, error) {
+	return MetadataListAll(s.ServiceClient, id)
+}
+
+// MetadataReplace calls the package level MetadataReplace function with the service client.
+func (s *Service) MetadataReplace(id string, opts map[string]interface{}) MetadataActionResult {
+	return MetadataReplace(s.ServiceClient, id, opts)
+}
+
+// PlanSync calls the package level PlanSync function with the service client.
+func (s *Service) PlanSync(securityGroupID string, desired []CreateSecurityGroupRuleOpts) (*SyncReport, error) {
+	return PlanSync(s.ServiceClient, securityGroupID, desired)
+}
+
+// Sync calls the package level Sync function with the service client.
+func (s *Service) Sync(securityGroupID string, desired []CreateSecurityGroupRuleOpts) (*SyncReport, error) {
+	return Sync(s.ServiceClient, securityGroupID, desired)
+}
+
+// Update calls the package level Update function with the service client.
+func (s *Service) Update(securityGroupID string, opts UpdateOptsBuilder) UpdateResult {
+	return Update(s.ServiceClient, securityGroupID, opts)
+}
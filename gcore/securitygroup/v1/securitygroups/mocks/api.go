@@ -0,0 +1,274 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of securitygroups.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/securitygroup/v1/securitygroups"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of securitygroups.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	AddRuleFunc                func(securityGroupID string, opts securitygroups.CreateRuleOptsBuilder) securitygroups.CreateRuleResult
+	CreateFunc                 func(opts securitygroups.CreateOptsBuilder) securitygroups.CreateResult
+	DeepCopyFunc               func(securityGroupID string, opts securitygroups.DeepCopyOptsBuilder) securitygroups.DeepCopyResult
+	DeleteFunc                 func(securityGroupID string) securitygroups.DeleteResult
+	ExportFunc                 func(opts securitygroups.ExportOpts) (*securitygroups.Document, error)
+	GetFunc                    func(id string) securitygroups.GetResult
+	IDFromNameFunc             func(name string) (string, error)
+	ImportFunc                 func(doc securitygroups.Document, opts securitygroups.ImportOpts) (*securitygroups.ImportReport, error)
+	LintFunc                   func(opts securitygroups.LintOpts) ([]securitygroups.Finding, error)
+	ListFunc                   func(opts securitygroups.ListOptsBuilder) pagination.Pager
+	ListAllFunc                func(opts securitygroups.ListOptsBuilder) ([]securitygroups.SecurityGroup, error)
+	ListAllInstancesFunc       func(securityGroupID string) ([]instances.Instance, error)
+	ListInstancesFunc          func(securityGroupID string) pagination.Pager
+	MetadataCreateOrUpdateFunc func(id string, opts map[string]interface{}) securitygroups.MetadataActionResult
+	MetadataDeleteFunc         func(id string, key string) securitygroups.MetadataActionResult
+	MetadataGetFunc            func(id string, key string) securitygroups.MetadataResult
+	MetadataListFunc           func(id string) pagination.Pager
+	MetadataListAllFunc        func(id string) ([]securitygroups.Metadata, error)
+	MetadataReplaceFunc        func(id string, opts map[string]interface{}) securitygroups.MetadataActionResult
+	PlanSyncFunc               func(securityGroupID string, desired []securitygroups.CreateSecurityGroupRuleOpts) (*securitygroups.SyncReport, error)
+	SyncFunc                   func(securityGroupID string, desired []securitygroups.CreateSecurityGroupRuleOpts) (*securitygroups.SyncReport, error)
+	UpdateFunc                 func(securityGroupID string, opts securitygroups.UpdateOptsBuilder) securitygroups.UpdateResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ securitygroups.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// AddRule implements securitygroups.API.
+func (m *API) AddRule(securityGroupID string, opts securitygroups.CreateRuleOptsBuilder) securitygroups.CreateRuleResult {
+	m.record("AddRule", securityGroupID, opts)
+	if m.AddRuleFunc == nil {
+		panic("mocks: securitygroups.API.AddRuleFunc is not set")
+	}
+	return m.AddRuleFunc(securityGroupID, opts)
+}
+
+// Create implements securitygroups.API.
+func (m *API) Create(opts securitygroups.CreateOptsBuilder) securitygroups.CreateResult {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: securitygroups.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// DeepCopy implements securitygroups.API.
+func (m *API) DeepCopy(securityGroupID string, opts securitygroups.DeepCopyOptsBuilder) securitygroups.DeepCopyResult {
+	m.record("DeepCopy", securityGroupID, opts)
+	if m.DeepCopyFunc == nil {
+		panic("mocks: securitygroups.API.DeepCopyFunc is not set")
+	}
+	return m.DeepCopyFunc(securityGroupID, opts)
+}
+
+// Delete implements securitygroups.API.
+func (m *API) Delete(securityGroupID string) securitygroups.DeleteResult {
+	m.record("Delete", securityGroupID)
+	if m.DeleteFunc == nil {
+		panic("mocks: securitygroups.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(securityGroupID)
+}
+
+// Export implements securitygroups.API.
+func (m *API) Export(opts securitygroups.ExportOpts) (*securitygroups.Document, error) {
+	m.record("Export", opts)
+	if m.ExportFunc == nil {
+		panic("mocks: securitygroups.API.ExportFunc is not set")
+	}
+	return m.ExportFunc(opts)
+}
+
+// Get implements securitygroups.API.
+func (m *API) Get(id string) securitygroups.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: securitygroups.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// IDFromName implements securitygroups.API.
+func (m *API) IDFromName(name string) (string, error) {
+	m.record("IDFromName", name)
+	if m.IDFromNameFunc == nil {
+		panic("mocks: securitygroups.API.IDFromNameFunc is not set")
+	}
+	return m.IDFromNameFunc(name)
+}
+
+// Import implements securitygroups.API.
+func (m *API) Import(doc securitygroups.Document, opts securitygroups.ImportOpts) (*securitygroups.ImportReport, error) {
+	m.record("Import", doc, opts)
+	if m.ImportFunc == nil {
+		panic("mocks: securitygroups.API.ImportFunc is not set")
+	}
+	return m.ImportFunc(doc, opts)
+}
+
+// Lint implements securitygroups.API.
+func (m *API) Lint(opts securitygroups.LintOpts) ([]securitygroups.Finding, error) {
+	m.record("Lint", opts)
+	if m.LintFunc == nil {
+		panic("mocks: securitygroups.API.LintFunc is not set")
+	}
+	return m.LintFunc(opts)
+}
+
+// List implements securitygroups.API.
+func (m *API) List(opts securitygroups.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: securitygroups.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements securitygroups.API.
+func (m *API) ListAll(opts securitygroups.ListOptsBuilder) ([]securitygroups.SecurityGroup, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: securitygroups.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// ListAllInstances implements securitygroups.API.
+func (m *API) ListAllInstances(securityGroupID string) ([]instances.Instance, error) {
+	m.record("ListAllInstances", securityGroupID)
+	if m.ListAllInstancesFunc == nil {
+		panic("mocks: securitygroups.API.ListAllInstancesFunc is not set")
+	}
+	return m.ListAllInstancesFunc(securityGroupID)
+}
+
+// ListInstances implements securitygroups.API.
+func (m *API) ListInstances(securityGroupID string) pagination.Pager {
+	m.record("ListInstances", securityGroupID)
+	if m.ListInstancesFunc == nil {
+		panic("mocks: securitygroups.API.ListInstancesFunc is not set")
+	}
+	return m.ListInstancesFunc(securityGroupID)
+}
+
+// MetadataCreateOrUpdate implements securitygroups.API.
+func (m *API) MetadataCreateOrUpdate(id string, opts map[string]interface{}) securitygroups.MetadataActionResult {
+	m.record("MetadataCreateOrUpdate", id, opts)
+	if m.MetadataCreateOrUpdateFunc == nil {
+		panic("mocks: securitygroups.API.MetadataCreateOrUpdateFunc is not set")
+	}
+	return m.MetadataCreateOrUpdateFunc(id, opts)
+}
+
+// MetadataDelete implements securitygroups.API.
+func (m *API) MetadataDelete(id string, key string) securitygroups.MetadataActionResult {
+	m.record("MetadataDelete", id, key)
+	if m.MetadataDeleteFunc == nil {
+		panic("mocks: securitygroups.API.MetadataDeleteFunc is not set")
+	}
+	return m.MetadataDeleteFunc(id, key)
+}
+
+// MetadataGet implements securitygroups.API.
+func (m *API) MetadataGet(id string, key string) securitygroups.MetadataResult {
+	m.record("MetadataGet", id, key)
+	if m.MetadataGetFunc == nil {
+		panic("mocks: securitygroups.API.MetadataGetFunc is not set")
+	}
+	return m.MetadataGetFunc(id, key)
+}
+
+// MetadataList implements securitygroups.API.
+func (m *API) MetadataList(id string) pagination.Pager {
+	m.record("MetadataList", id)
+	if m.MetadataListFunc == nil {
+		panic("mocks: securitygroups.API.MetadataListFunc is not set")
+	}
+	return m.MetadataListFunc(id)
+}
+
+// MetadataListAll implements securitygroups.API.
+func (m *API) MetadataListAll(id string) ([]securitygroups.Metadata, error) {
+	m.record("MetadataListAll", id)
+	if m.MetadataListAllFunc == nil {
+		panic("mocks: securitygroups.API.MetadataListAllFunc is not set")
+	}
+	return m.MetadataListAllFunc(id)
+}
+
+// MetadataReplace implements securitygroups.API.
+func (m *API) MetadataReplace(id string, opts map[string]interface{}) securitygroups.MetadataActionResult {
+	m.record("MetadataReplace", id, opts)
+	if m.MetadataReplaceFunc == nil {
+		panic("mocks: securitygroups.API.MetadataReplaceFunc is not set")
+	}
+	return m.MetadataReplaceFunc(id, opts)
+}
+
+// PlanSync implements securitygroups.API.
+func (m *API) PlanSync(securityGroupID string, desired []securitygroups.CreateSecurityGroupRuleOpts) (*securitygroups.SyncReport, error) {
+	m.record("PlanSync", securityGroupID, desired)
+	if m.PlanSyncFunc == nil {
+		panic("mocks: securitygroups.API.PlanSyncFunc is not set")
+	}
+	return m.PlanSyncFunc(securityGroupID, desired)
+}
+
+// Sync implements securitygroups.API.
+func (m *API) Sync(securityGroupID string, desired []securitygroups.CreateSecurityGroupRuleOpts) (*securitygroups.SyncReport, error) {
+	m.record("Sync", securityGroupID, desired)
+	if m.SyncFunc == nil {
+		panic("mocks: securitygroups.API.SyncFunc is not set")
+	}
+	return m.SyncFunc(securityGroupID, desired)
+}
+
+// Update implements securitygroups.API.
+func (m *API) Update(securityGroupID string, opts securitygroups.UpdateOptsBuilder) securitygroups.UpdateResult {
+	m.record("Update", securityGroupID, opts)
+	if m.UpdateFunc == nil {
+		panic("mocks: securitygroups.API.UpdateFunc is not set")
+	}
+	return m.UpdateFunc(securityGroupID, opts)
+}
@@ -1,7 +1,10 @@
 package securitygroups
 
 import (
+	"fmt"
+	"net"
 	"net/http"
+	"strings"
 
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
@@ -296,3 +299,678 @@ func MetadataGet(client *gcorecloud.ServiceClient, id string, key string) (r Met
 	_, r.Err = client.Get(url, &r.Body, nil) // nolint
 	return
 }
+
+// NormalizedRule is the comparable form of a rule: two rules allowing the same traffic have the same normalized
+// form. The description is not part of it.
+type NormalizedRule struct {
+	Direction types.RuleDirection
+	EtherType types.EtherType
+	Protocol  types.Protocol
+	// PortRangeMin and PortRangeMax are 0 when all ports are allowed. They are the ICMP type and code for the ICMP
+	// protocols, -1 when not set.
+	PortRangeMin   int
+	PortRangeMax   int
+	RemoteIPPrefix string
+	RemoteGroupID  string
+}
+
+// protocolAliases maps the protocol numbers to the protocol names used by the API.
+var protocolAliases = map[string]types.Protocol{
+	"":       types.ProtocolAny,
+	"1":      types.ProtocolICMP,
+	"6":      types.ProtocolTCP,
+	"17":     types.ProtocolUDP,
+	"58":     types.ProtocolIPv6ICMP,
+	"132":    types.ProtocolSCTP,
+	"icmpv6": types.ProtocolIPv6ICMP,
+}
+
+// NormalizeRule returns the normalized form of the rule: the protocol defaults to any, the ethertype to the family
+// of the remote prefix, a single port bound applies to both, the full port range and the any remote prefixes
+// are dropped and the remote prefix is reduced to its network.
+func NormalizeRule(opts CreateSecurityGroupRuleOpts) NormalizedRule {
+	r := NormalizedRule{Direction: opts.Direction, EtherType: opts.EtherType}
+	r.Protocol = types.Protocol(strings.ToLower(string(opts.Protocol)))
+	if alias, ok := protocolAliases[string(r.Protocol)]; ok {
+		r.Protocol = alias
+	}
+	if opts.RemoteGroupID != nil {
+		r.RemoteGroupID = *opts.RemoteGroupID
+	}
+
+	if opts.RemoteIPPrefix != nil && *opts.RemoteIPPrefix != "" {
+		prefix := *opts.RemoteIPPrefix
+		if !strings.Contains(prefix, "/") {
+			if ip := net.ParseIP(prefix); ip != nil && ip.To4() != nil {
+				prefix += "/32"
+			} else {
+				prefix += "/128"
+			}
+		}
+		if _, network, err := net.ParseCIDR(prefix); err == nil {
+			prefix = network.String()
+			if r.EtherType == "" && network.IP.To4() == nil {
+				r.EtherType = types.EtherTypeIPv6
+			}
+			if ones, _ := network.Mask.Size(); ones == 0 {
+				prefix = ""
+			}
+		}
+		r.RemoteIPPrefix = prefix
+	}
+	if r.EtherType == "" {
+		r.EtherType = types.EtherTypeIPv4
+	}
+
+	min, max := opts.PortRangeMin, opts.PortRangeMax
+	switch r.Protocol {
+	case types.ProtocolTCP, types.ProtocolUDP, types.ProtocolSCTP, types.ProtocolUDPLITE, types.ProtocolDCCP:
+		if min == nil {
+			min = max
+		}
+		if max == nil {
+			max = min
+		}
+		if min != nil && !(*min <= 1 && *max >= 65535) {
+			r.PortRangeMin, r.PortRangeMax = *min, *max
+		}
+	case types.ProtocolICMP, types.ProtocolIPv6ICMP:
+		r.PortRangeMin, r.PortRangeMax = -1, -1
+		if min != nil {
+			r.PortRangeMin = *min
+		}
+		if max != nil {
+			r.PortRangeMax = *max
+		}
+	}
+	return r
+}
+
+// PlanSync returns the rules to add to and to delete from the security group to converge it to the desired rules,
+// without applying them. Rules are compared in their normalized form, see NormalizeRule; duplicate rules of the
+// group are deleted.
+func PlanSync(c *gcorecloud.ServiceClient, securityGroupID string, desired []CreateSecurityGroupRuleOpts) (*SyncReport, error) {
+	group, err := Get(c, securityGroupID).Extract()
+	if err != nil {
+		return nil, err
+	}
+	existing := map[NormalizedRule][]SecurityGroupRule{}
+	for _, rule := range group.SecurityGroupRules {
+		key := NormalizeRule(rule.ToCreateOpts())
+		existing[key] = append(existing[key], rule)
+	}
+
+	report := &SyncReport{SecurityGroupID: securityGroupID, Changes: []RuleChange{}}
+	wanted := map[NormalizedRule]bool{}
+	for _, rule := range desired {
+		key := NormalizeRule(rule)
+		if wanted[key] {
+			continue
+		}
+		wanted[key] = true
+		if len(existing[key]) > 0 {
+			existing[key] = existing[key][1:]
+			report.Unchanged++
+			continue
+		}
+		rule.SecurityGroupID = nil
+		report.Changes = append(report.Changes, RuleChange{Action: RuleActionAdd, Rule: rule})
+	}
+	for _, rule := range group.SecurityGroupRules {
+		key := NormalizeRule(rule.ToCreateOpts())
+		for _, extra := range existing[key] {
+			if extra.ID == rule.ID {
+				report.Changes = append(report.Changes, RuleChange{
+					Action: RuleActionDelete,
+					RuleID: rule.ID,
+					Rule:   rule.ToCreateOpts(),
+				})
+			}
+		}
+	}
+	return report, nil
+}
+
+// Sync converges the rules of the security group to the desired rules with the minimal set of changes, see
+// PlanSync. The rules are added before the extra rules are deleted, so the allowed traffic is never narrowed in
+// between. On failure, the report tells the changes already applied.
+func Sync(c *gcorecloud.ServiceClient, securityGroupID string, desired []CreateSecurityGroupRuleOpts) (*SyncReport, error) {
+	report, err := PlanSync(c, securityGroupID, desired)
+	if err != nil {
+		return nil, err
+	}
+	for i := range report.Changes {
+		change := &report.Changes[i]
+		switch change.Action {
+		case RuleActionAdd:
+			rule, err := AddRule(c, securityGroupID, change.Rule).Extract()
+			if err != nil {
+				return report, fmt.Errorf("adding rule to security group %s: %w", securityGroupID, err)
+			}
+			change.RuleID = rule.ID
+		case RuleActionDelete:
+			if _, err := c.Delete(ruleURL(c, change.RuleID), nil); err != nil {
+				return report, fmt.Errorf("deleting rule %s of security group %s: %w", change.RuleID, securityGroupID, err)
+			}
+		}
+		change.Applied = true
+	}
+	return report, nil
+}
+
+// ExportOpts selects the security groups of an export.
+type ExportOpts struct {
+	// SecurityGroupIDs selects the groups by ID, all the groups of the project when empty.
+	SecurityGroupIDs []string
+}
+
+// Export returns the portable document of the security groups with their rules and metadata. The remote groups
+// of the rules are referenced by name, so they must have a unique name in the project.
+func Export(c *gcorecloud.ServiceClient, opts ExportOpts) (*Document, error) {
+	all, err := ListAll(c, nil)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]SecurityGroup, len(all))
+	nameCount := map[string]int{}
+	for _, g := range all {
+		byID[g.ID] = g
+		nameCount[g.Name]++
+	}
+	selected := all
+	if len(opts.SecurityGroupIDs) > 0 {
+		selected = make([]SecurityGroup, 0, len(opts.SecurityGroupIDs))
+		for _, id := range opts.SecurityGroupIDs {
+			g, ok := byID[id]
+			if !ok {
+				return nil, gcorecloud.ErrResourceNotFound{Name: id, ResourceType: "security group"}
+			}
+			selected = append(selected, g)
+		}
+	}
+
+	doc := &Document{Version: DocumentVersion, SecurityGroups: make([]GroupDocument, 0, len(selected))}
+	for _, g := range selected {
+		group := GroupDocument{Name: g.Name, Description: g.Description, Rules: make([]RuleDocument, 0, len(g.SecurityGroupRules))}
+		for _, m := range g.Metadata {
+			if m.ReadOnly {
+				continue
+			}
+			if group.Metadata == nil {
+				group.Metadata = map[string]string{}
+			}
+			group.Metadata[m.Key] = m.Value
+		}
+		for _, rule := range g.SecurityGroupRules {
+			opts := rule.ToCreateOpts()
+			r := RuleDocument{
+				Direction:      opts.Direction,
+				EtherType:      opts.EtherType,
+				Protocol:       opts.Protocol,
+				PortRangeMin:   opts.PortRangeMin,
+				PortRangeMax:   opts.PortRangeMax,
+				RemoteIPPrefix: opts.RemoteIPPrefix,
+				Description:    opts.Description,
+			}
+			if opts.RemoteGroupID != nil && *opts.RemoteGroupID != "" {
+				remote, ok := byID[*opts.RemoteGroupID]
+				if !ok {
+					return nil, fmt.Errorf("rule %s of security group %s references unknown security group %s", rule.ID, g.Name, *opts.RemoteGroupID)
+				}
+				if count := nameCount[remote.Name]; count > 1 {
+					return nil, gcorecloud.ErrMultipleResourcesFound{Name: remote.Name, Count: count, ResourceType: "security group"}
+				}
+				name := remote.Name
+				r.RemoteGroup = &name
+			}
+			group.Rules = append(group.Rules, r)
+		}
+		doc.SecurityGroups = append(doc.SecurityGroups, group)
+	}
+	return doc, nil
+}
+
+// Validate checks the document: group names must be unique and the rules must have valid types.
+func (d Document) Validate() error {
+	if d.Version > DocumentVersion {
+		return fmt.Errorf("unsupported security group document version %d", d.Version)
+	}
+	names := map[string]bool{}
+	for _, g := range d.SecurityGroups {
+		if g.Name == "" {
+			return fmt.Errorf("security group name is required")
+		}
+		if names[g.Name] {
+			return fmt.Errorf("security group %s is defined twice", g.Name)
+		}
+		names[g.Name] = true
+		for i, r := range g.Rules {
+			if err := r.validate(); err != nil {
+				return fmt.Errorf("rule %d of security group %s: %w", i+1, g.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (r RuleDocument) validate() error {
+	if err := r.Direction.IsValid(); err != nil {
+		return err
+	}
+	if r.EtherType != "" {
+		if err := r.EtherType.IsValid(); err != nil {
+			return err
+		}
+	}
+	if r.Protocol != "" {
+		if err := r.Protocol.IsValid(); err != nil {
+			return err
+		}
+	}
+	if r.RemoteGroup != nil && r.RemoteIPPrefix != nil {
+		return fmt.Errorf("remote_group and remote_ip_prefix are mutually exclusive")
+	}
+	return nil
+}
+
+// toCreateOpts returns the rule with the remote group ID. The ethertype and the protocol default to their
+// normalized values and a single port bound applies to both.
+func (r RuleDocument) toCreateOpts(remoteGroupID string) CreateSecurityGroupRuleOpts {
+	opts := CreateSecurityGroupRuleOpts{
+		Direction:      r.Direction,
+		EtherType:      r.EtherType,
+		Protocol:       r.Protocol,
+		PortRangeMin:   r.PortRangeMin,
+		PortRangeMax:   r.PortRangeMax,
+		RemoteIPPrefix: r.RemoteIPPrefix,
+		Description:    r.Description,
+	}
+	if remoteGroupID != "" {
+		opts.RemoteGroupID = &remoteGroupID
+	}
+	normalized := NormalizeRule(opts)
+	if opts.EtherType == "" {
+		opts.EtherType = normalized.EtherType
+	}
+	if opts.Protocol == "" {
+		opts.Protocol = normalized.Protocol
+	}
+	icmp := normalized.Protocol == types.ProtocolICMP || normalized.Protocol == types.ProtocolIPv6ICMP
+	if !icmp && opts.PortRangeMin != nil && opts.PortRangeMax == nil {
+		opts.PortRangeMax = opts.PortRangeMin
+	}
+	return opts
+}
+
+// ImportOpts represents options of an import.
+type ImportOpts struct {
+	// Plan computes the changes without applying them.
+	Plan bool
+}
+
+// Import creates the security groups of the document missing in the project and converges the rules and the
+// metadata of the existing ones, matched by name, see Sync. The groups are created before the rules are synced so
+// the rules may reference any group of the document or of the project. The description of an existing group is
+// left as is. On failure, the report tells the changes already applied.
+func Import(c *gcorecloud.ServiceClient, doc Document, opts ImportOpts) (*ImportReport, error) {
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+	existing, err := ListAll(c, nil)
+	if err != nil {
+		return nil, err
+	}
+	byName := map[string][]SecurityGroup{}
+	names := map[string]string{}
+	for _, g := range existing {
+		byName[g.Name] = append(byName[g.Name], g)
+		names[g.ID] = g.Name
+	}
+	find := func(name string) (*SecurityGroup, error) {
+		switch matches := byName[name]; len(matches) {
+		case 0:
+			return nil, nil
+		case 1:
+			return &matches[0], nil
+		default:
+			return nil, gcorecloud.ErrMultipleResourcesFound{Name: name, Count: len(matches), ResourceType: "security group"}
+		}
+	}
+
+	report := &ImportReport{SecurityGroups: make([]ImportResult, 0, len(doc.SecurityGroups))}
+	created := map[string]bool{}
+	for _, g := range doc.SecurityGroups {
+		result := ImportResult{Name: g.Name, Action: ImportActionUnchanged}
+		current, err := find(g.Name)
+		if err != nil {
+			return report, err
+		}
+		if current != nil {
+			result.SecurityGroupID = current.ID
+			result.Metadata = !sameMetadata(current.Metadata, g.Metadata)
+		} else {
+			result.Action = ImportActionCreate
+			created[g.Name] = true
+			if !opts.Plan {
+				id, err := createFromDocument(c, g)
+				if err != nil {
+					return report, err
+				}
+				result.SecurityGroupID = id
+				names[id] = g.Name
+			}
+		}
+		report.SecurityGroups = append(report.SecurityGroups, result)
+	}
+
+	for i, g := range doc.SecurityGroups {
+		result := &report.SecurityGroups[i]
+		desired := make([]CreateSecurityGroupRuleOpts, 0, len(g.Rules))
+		for j, r := range g.Rules {
+			var remoteGroupID string
+			if r.RemoteGroup != nil {
+				remote, err := find(*r.RemoteGroup)
+				if err != nil {
+					return report, err
+				}
+				switch {
+				case remote != nil:
+					remoteGroupID = remote.ID
+				case !created[*r.RemoteGroup]:
+					return report, fmt.Errorf("rule %d of security group %s: %w", j+1, g.Name,
+						gcorecloud.ErrResourceNotFound{Name: *r.RemoteGroup, ResourceType: "security group"})
+				case opts.Plan:
+					// A placeholder ID, so the rule matches no existing rule.
+					remoteGroupID = "planned:" + *r.RemoteGroup
+					names[remoteGroupID] = *r.RemoteGroup
+				default:
+					remoteGroupID = importedID(report, *r.RemoteGroup)
+				}
+			}
+			desired = append(desired, r.toCreateOpts(remoteGroupID))
+		}
+
+		var sync *SyncReport
+		switch {
+		case result.SecurityGroupID == "":
+			// A group to create in plan-only mode: all of its rules are added.
+			sync = &SyncReport{Changes: make([]RuleChange, 0, len(desired))}
+			for _, rule := range desired {
+				sync.Changes = append(sync.Changes, RuleChange{Action: RuleActionAdd, Rule: rule})
+			}
+		case opts.Plan:
+			sync, err = PlanSync(c, result.SecurityGroupID, desired)
+		default:
+			sync, err = Sync(c, result.SecurityGroupID, desired)
+		}
+		if sync != nil {
+			for j := range sync.Changes {
+				change := &sync.Changes[j]
+				if id := change.Rule.RemoteGroupID; id != nil {
+					change.RemoteGroup = names[*id]
+					if strings.HasPrefix(*id, "planned:") {
+						change.Rule.RemoteGroupID = nil
+					}
+				}
+			}
+			result.Rules = *sync
+		}
+		if err != nil {
+			return report, err
+		}
+		if result.Metadata && !opts.Plan {
+			// An empty map, not null, removes all the metadata of the group.
+			metadata := metadataMap(g.Metadata)
+			if metadata == nil {
+				metadata = map[string]interface{}{}
+			}
+			if err := MetadataReplace(c, result.SecurityGroupID, metadata).ExtractErr(); err != nil {
+				return report, fmt.Errorf("replacing metadata of security group %s: %w", g.Name, err)
+			}
+		}
+		if result.Action == ImportActionUnchanged && (result.Metadata || result.Rules.HasChanges()) {
+			result.Action = ImportActionUpdate
+		}
+	}
+	return report, nil
+}
+
+func createFromDocument(c *gcorecloud.ServiceClient, g GroupDocument) (string, error) {
+	opts := CreateSecurityGroupOpts{
+		Name:               g.Name,
+		SecurityGroupRules: []CreateSecurityGroupRuleOpts{},
+		Metadata:           metadataMap(g.Metadata),
+	}
+	if g.Description != "" {
+		opts.Description = &g.Description
+	}
+	group, err := Create(c, CreateOpts{SecurityGroup: opts}).Extract()
+	if err != nil {
+		return "", fmt.Errorf("creating security group %s: %w", g.Name, err)
+	}
+	return group.ID, nil
+}
+
+func importedID(report *ImportReport, name string) string {
+	for _, g := range report.SecurityGroups {
+		if g.Name == name {
+			return g.SecurityGroupID
+		}
+	}
+	return ""
+}
+
+func metadataMap(metadata map[string]string) map[string]interface{} {
+	if len(metadata) == 0 {
+		return nil
+	}
+	result := make(map[string]interface{}, len(metadata))
+	for k, v := range metadata {
+		result[k] = v
+	}
+	return result
+}
+
+// sameMetadata compares the metadata of a group, except the read-only ones, with the metadata of a document.
+func sameMetadata(current []Metadata, desired map[string]string) bool {
+	count := 0
+	for _, m := range current {
+		if m.ReadOnly {
+			continue
+		}
+		if v, ok := desired[m.Key]; !ok || v != m.Value {
+			return false
+		}
+		count++
+	}
+	return count == len(desired)
+}
+
+// DefaultSensitivePorts are the ports checked by Lint when none are given: SSH, RDP and the usual database ports.
+var DefaultSensitivePorts = []int{22, 3389, 1433, 1521, 3306, 5432, 5984, 6379, 9200, 11211, 27017}
+
+// LintOpts selects the security groups and the checks of Lint.
+type LintOpts struct {
+	// SecurityGroupIDs selects the groups by ID, all the groups of the project when empty.
+	SecurityGroupIDs []string
+	// SensitivePorts are the ports which should not be open to any address, DefaultSensitivePorts if empty.
+	SensitivePorts []int
+	// SkipUnused skips the check of the unused groups, which lists the instances of every group.
+	SkipUnused bool
+}
+
+// Lint checks the security groups for risky configurations: ingress from any address to a sensitive port, rules
+// allowing any protocol, duplicate rules, rules shadowed by a broader rule of the group, rules referencing a deleted
+// remote group and groups not used by any instance. The findings are ordered by group and rule.
+func Lint(c *gcorecloud.ServiceClient, opts LintOpts) ([]Finding, error) {
+	all, err := ListAll(c, nil)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]SecurityGroup, len(all))
+	for _, g := range all {
+		byID[g.ID] = g
+	}
+	selected := all
+	if len(opts.SecurityGroupIDs) > 0 {
+		selected = make([]SecurityGroup, 0, len(opts.SecurityGroupIDs))
+		for _, id := range opts.SecurityGroupIDs {
+			g, ok := byID[id]
+			if !ok {
+				return nil, gcorecloud.ErrResourceNotFound{Name: id, ResourceType: "security group"}
+			}
+			selected = append(selected, g)
+		}
+	}
+	sensitive := opts.SensitivePorts
+	if len(sensitive) == 0 {
+		sensitive = DefaultSensitivePorts
+	}
+
+	findings := []Finding{}
+	for _, g := range selected {
+		add := func(check LintCheck, severity Severity, ruleID, format string, args ...interface{}) {
+			findings = append(findings, Finding{
+				Check:             check,
+				Severity:          severity,
+				SecurityGroupID:   g.ID,
+				SecurityGroupName: g.Name,
+				RuleID:            ruleID,
+				Message:           fmt.Sprintf(format, args...),
+			})
+		}
+
+		normalized := make([]NormalizedRule, len(g.SecurityGroupRules))
+		for i, rule := range g.SecurityGroupRules {
+			normalized[i] = NormalizeRule(rule.ToCreateOpts())
+		}
+		first := map[NormalizedRule]string{}
+		for i, rule := range g.SecurityGroupRules {
+			r := normalized[i]
+			anywhere := r.RemoteIPPrefix == "" && r.RemoteGroupID == ""
+
+			if r.RemoteGroupID != "" {
+				if _, ok := byID[r.RemoteGroupID]; !ok {
+					add(LintCheckUnknownRemoteGroup, SeverityMedium, rule.ID, "remote security group %s does not exist", r.RemoteGroupID)
+				}
+			}
+			if r.Direction == types.RuleDirectionIngress && anywhere {
+				if ports := openPorts(r, sensitive); len(ports) > 0 {
+					add(LintCheckOpenSensitivePort, SeverityHigh, rule.ID, "%s ingress from any address to sensitive ports %s",
+						r.EtherType, joinPorts(ports))
+				}
+			}
+			if r.Protocol == types.ProtocolAny {
+				switch {
+				case r.Direction == types.RuleDirectionEgress:
+					add(LintCheckAnyProtocol, SeverityLow, rule.ID, "%s egress of any protocol", r.EtherType)
+				case anywhere:
+					add(LintCheckAnyProtocol, SeverityHigh, rule.ID, "%s ingress of any protocol from any address", r.EtherType)
+				default:
+					add(LintCheckAnyProtocol, SeverityMedium, rule.ID, "%s ingress of any protocol from %s", r.EtherType, source(r))
+				}
+			}
+
+			if original, ok := first[r]; ok {
+				add(LintCheckDuplicateRule, SeverityLow, rule.ID, "duplicate of rule %s", original)
+				continue
+			}
+			first[r] = rule.ID
+			for j, other := range normalized {
+				if j != i && other != r && covers(other, r) {
+					add(LintCheckShadowedRule, SeverityLow, rule.ID, "shadowed by rule %s", g.SecurityGroupRules[j].ID)
+					break
+				}
+			}
+		}
+
+		if !opts.SkipUnused {
+			used, err := ListAllInstances(c, g.ID)
+			if err != nil {
+				return nil, fmt.Errorf("listing instances of security group %s: %w", g.ID, err)
+			}
+			if len(used) == 0 {
+				add(LintCheckUnusedGroup, SeverityLow, "", "security group %s is not used by any instance", g.Name)
+			}
+		}
+	}
+	return findings, nil
+}
+
+func isPortProtocol(p types.Protocol) bool {
+	switch p {
+	case types.ProtocolTCP, types.ProtocolUDP, types.ProtocolSCTP, types.ProtocolUDPLITE, types.ProtocolDCCP:
+		return true
+	}
+	return false
+}
+
+// openPorts returns the ports of the list allowed by the normalized rule.
+func openPorts(r NormalizedRule, ports []int) []int {
+	if r.Protocol != types.ProtocolAny && !isPortProtocol(r.Protocol) {
+		return nil
+	}
+	var result []int
+	for _, port := range ports {
+		if r.PortRangeMin == 0 || (r.PortRangeMin <= port && port <= r.PortRangeMax) {
+			result = append(result, port)
+		}
+	}
+	return result
+}
+
+func joinPorts(ports []int) string {
+	s := make([]string, 0, len(ports))
+	for _, port := range ports {
+		s = append(s, fmt.Sprint(port))
+	}
+	return strings.Join(s, ", ")
+}
+
+func source(r NormalizedRule) string {
+	if r.RemoteGroupID != "" {
+		return "security group " + r.RemoteGroupID
+	}
+	return r.RemoteIPPrefix
+}
+
+// covers reports whether the normalized rule a allows all the traffic of the normalized rule b.
+func covers(a, b NormalizedRule) bool {
+	if a.Direction != b.Direction || a.EtherType != b.EtherType {
+		return false
+	}
+
+	switch {
+	case a.Protocol == types.ProtocolAny:
+	case a.Protocol != b.Protocol:
+		return false
+	case isPortProtocol(a.Protocol):
+		if a.PortRangeMin != 0 && (b.PortRangeMin == 0 || b.PortRangeMin < a.PortRangeMin || b.PortRangeMax > a.PortRangeMax) {
+			return false
+		}
+	case a.Protocol == types.ProtocolICMP || a.Protocol == types.ProtocolIPv6ICMP:
+		if a.PortRangeMin != -1 && (a.PortRangeMin != b.PortRangeMin || (a.PortRangeMax != -1 && a.PortRangeMax != b.PortRangeMax)) {
+			return false
+		}
+	}
+
+	switch {
+	case a.RemoteGroupID == "" && a.RemoteIPPrefix == "":
+		return true
+	case a.RemoteGroupID != "":
+		return a.RemoteGroupID == b.RemoteGroupID
+	case b.RemoteGroupID != "" || b.RemoteIPPrefix == "":
+		return false
+	}
+	_, outer, err := net.ParseCIDR(a.RemoteIPPrefix)
+	if err != nil {
+		return false
+	}
+	_, inner, err := net.ParseCIDR(b.RemoteIPPrefix)
+	if err != nil {
+		return false
+	}
+	outerOnes, _ := outer.Mask.Size()
+	innerOnes, _ := inner.Mask.Size()
+	return outer.Contains(inner.IP) && outerOnes <= innerOnes
+}
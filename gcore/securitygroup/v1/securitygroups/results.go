@@ -1,6 +1,9 @@
 package securitygroups
 
 import (
+	"encoding/json"
+	"fmt"
+
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
 	"github.com/G-Core/gcorelabscloud-go/gcore/securitygroup/v1/types"
@@ -220,3 +223,293 @@ func ExtractMetadata(r pagination.Page) ([]Metadata, error) {
 type MetadataActionResult struct {
 	gcorecloud.ErrResult
 }
+
+// ToCreateOpts returns the options creating the same rule.
+func (r SecurityGroupRule) ToCreateOpts() CreateSecurityGroupRuleOpts {
+	opts := CreateSecurityGroupRuleOpts{
+		Direction:      r.Direction,
+		RemoteGroupID:  r.RemoteGroupID,
+		PortRangeMax:   r.PortRangeMax,
+		PortRangeMin:   r.PortRangeMin,
+		Description:    r.Description,
+		RemoteIPPrefix: r.RemoteIPPrefix,
+	}
+	if r.EtherType != nil {
+		opts.EtherType = *r.EtherType
+	}
+	if r.Protocol != nil {
+		opts.Protocol = *r.Protocol
+	}
+	return opts
+}
+
+// RuleAction is a change of a security group rule made by Sync.
+type RuleAction string
+
+const (
+	RuleActionAdd    RuleAction = "add"
+	RuleActionDelete RuleAction = "delete"
+)
+
+// RuleChange is a rule added or deleted by Sync.
+type RuleChange struct {
+	Action RuleAction `json:"action"`
+	// RuleID is the deleted rule, or the added one once applied.
+	RuleID  string                      `json:"rule_id,omitempty"`
+	Rule    CreateSecurityGroupRuleOpts `json:"rule"`
+	Applied bool                        `json:"applied"`
+	// RemoteGroup is the name of the remote group of the rule, set by Import.
+	RemoteGroup string `json:"remote_group,omitempty"`
+}
+
+// SyncReport describes the changes converging a security group to the desired rules.
+type SyncReport struct {
+	SecurityGroupID string       `json:"security_group_id"`
+	Changes         []RuleChange `json:"changes"`
+	// Unchanged is the count of the rules kept as is.
+	Unchanged int `json:"unchanged"`
+}
+
+// HasChanges reports whether the rules of the group differ from the desired ones.
+func (r SyncReport) HasChanges() bool {
+	return len(r.Changes) > 0
+}
+
+// DocumentVersion is the version of the portable security group documents written by Export.
+const DocumentVersion = 1
+
+// Document is a portable description of security groups, see Export and Import. It holds no IDs: the groups are
+// identified by name, including the remote groups of the rules, so it can be applied to another project or region.
+type Document struct {
+	Version        int             `json:"version" yaml:"version"`
+	SecurityGroups []GroupDocument `json:"security_groups" yaml:"security_groups"`
+}
+
+// GroupDocument is a security group of a Document.
+type GroupDocument struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	// Metadata holds the metadata which is not read-only.
+	Metadata map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	Rules    []RuleDocument    `json:"rules" yaml:"rules"`
+}
+
+// RuleDocument is a security group rule of a Document.
+type RuleDocument struct {
+	Direction      types.RuleDirection `json:"direction" yaml:"direction"`
+	EtherType      types.EtherType     `json:"ethertype,omitempty" yaml:"ethertype,omitempty"`
+	Protocol       types.Protocol      `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	PortRangeMin   *int                `json:"port_range_min,omitempty" yaml:"port_range_min,omitempty"`
+	PortRangeMax   *int                `json:"port_range_max,omitempty" yaml:"port_range_max,omitempty"`
+	RemoteIPPrefix *string             `json:"remote_ip_prefix,omitempty" yaml:"remote_ip_prefix,omitempty"`
+	// RemoteGroup is the name of the remote security group.
+	RemoteGroup *string `json:"remote_group,omitempty" yaml:"remote_group,omitempty"`
+	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// ImportAction is what Import does to a security group of a Document.
+type ImportAction string
+
+const (
+	ImportActionCreate    ImportAction = "create"
+	ImportActionUpdate    ImportAction = "update"
+	ImportActionUnchanged ImportAction = "unchanged"
+)
+
+// ImportResult is the import of a security group of a Document.
+type ImportResult struct {
+	Name   string       `json:"name"`
+	Action ImportAction `json:"action"`
+	// SecurityGroupID is empty for a group to create in plan-only mode.
+	SecurityGroupID string `json:"security_group_id,omitempty"`
+	// Metadata tells whether the metadata of an existing group are replaced.
+	Metadata bool       `json:"metadata"`
+	Rules    SyncReport `json:"rules"`
+}
+
+// ImportReport describes the changes made, or planned, by Import.
+type ImportReport struct {
+	SecurityGroups []ImportResult `json:"security_groups"`
+}
+
+// HasChanges reports whether the target differs from the document.
+func (r ImportReport) HasChanges() bool {
+	for _, g := range r.SecurityGroups {
+		if g.Action != ImportActionUnchanged {
+			return true
+		}
+	}
+	return false
+}
+
+// LintCheck is a check of Lint.
+type LintCheck string
+
+const (
+	LintCheckOpenSensitivePort  LintCheck = "open-sensitive-port"
+	LintCheckAnyProtocol        LintCheck = "any-protocol"
+	LintCheckDuplicateRule      LintCheck = "duplicate-rule"
+	LintCheckShadowedRule       LintCheck = "shadowed-rule"
+	LintCheckUnusedGroup        LintCheck = "unused-group"
+	LintCheckUnknownRemoteGroup LintCheck = "unknown-remote-group"
+)
+
+var lintCheckDescriptions = map[LintCheck]string{
+	LintCheckOpenSensitivePort:  "Ingress from any address to a sensitive port",
+	LintCheckAnyProtocol:        "Rule allowing any protocol",
+	LintCheckDuplicateRule:      "Rule allowing the same traffic as another rule of the group",
+	LintCheckShadowedRule:       "Rule allowing a part of the traffic of another rule of the group",
+	LintCheckUnusedGroup:        "Security group not used by any instance",
+	LintCheckUnknownRemoteGroup: "Rule referencing a deleted remote security group",
+}
+
+// Description returns the description of the check.
+func (c LintCheck) Description() string {
+	return lintCheckDescriptions[c]
+}
+
+// Severity is the severity of a lint finding.
+type Severity string
+
+const (
+	SeverityLow    Severity = "low"
+	SeverityMedium Severity = "medium"
+	SeverityHigh   Severity = "high"
+)
+
+func (s Severity) String() string {
+	return string(s)
+}
+
+func (s Severity) List() []Severity {
+	return []Severity{SeverityLow, SeverityMedium, SeverityHigh}
+}
+
+func (s Severity) StringList() []string {
+	var list []string
+	for _, v := range s.List() {
+		list = append(list, v.String())
+	}
+	return list
+}
+
+func (s Severity) IsValid() error {
+	switch s {
+	case SeverityLow, SeverityMedium, SeverityHigh:
+		return nil
+	}
+	return fmt.Errorf("invalid Severity type: %v", s)
+}
+
+func (s Severity) rank() int {
+	for i, v := range s.List() {
+		if v == s {
+			return i
+		}
+	}
+	return -1
+}
+
+// AtLeast reports whether the severity is the given one or a higher one.
+func (s Severity) AtLeast(other Severity) bool {
+	return s.rank() >= other.rank()
+}
+
+// Finding is a risky configuration of a security group found by Lint.
+type Finding struct {
+	Check             LintCheck `json:"check"`
+	Severity          Severity  `json:"severity"`
+	SecurityGroupID   string    `json:"security_group_id"`
+	SecurityGroupName string    `json:"security_group_name"`
+	// RuleID is empty for the findings about the group.
+	RuleID  string `json:"rule_id,omitempty"`
+	Message string `json:"message"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRun struct {
+	Tool struct {
+		Driver sarifDriver `json:"driver"`
+	} `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+var sarifLevels = map[Severity]string{
+	SeverityLow:    "note",
+	SeverityMedium: "warning",
+	SeverityHigh:   "error",
+}
+
+// ToSARIF returns the findings as a SARIF 2.1.0 log. The groups and the rules are logical locations named
+// securitygroups/<group ID>[/rules/<rule ID>].
+func ToSARIF(findings []Finding) ([]byte, error) {
+	var run sarifRun
+	run.Tool.Driver.Name = "securitygroups.Lint"
+	checks := []LintCheck{LintCheckOpenSensitivePort, LintCheckAnyProtocol, LintCheckDuplicateRule,
+		LintCheckShadowedRule, LintCheckUnusedGroup, LintCheckUnknownRemoteGroup}
+	for _, check := range checks {
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+			ID:               string(check),
+			ShortDescription: sarifMessage{Text: check.Description()},
+		})
+	}
+	run.Results = make([]sarifResult, 0, len(findings))
+	for _, f := range findings {
+		location := sarifLogicalLocation{
+			Name:               f.SecurityGroupName,
+			FullyQualifiedName: "securitygroups/" + f.SecurityGroupID,
+			Kind:               "resource",
+		}
+		if f.RuleID != "" {
+			location.Name = f.RuleID
+			location.FullyQualifiedName += "/rules/" + f.RuleID
+		}
+		run.Results = append(run.Results, sarifResult{
+			RuleID:    string(f.Check),
+			Level:     sarifLevels[f.Severity],
+			Message:   sarifMessage{Text: f.Message},
+			Locations: []sarifLocation{{LogicalLocations: []sarifLogicalLocation{location}}},
+		})
+	}
+	return json.MarshalIndent(sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}, "", "  ")
+}
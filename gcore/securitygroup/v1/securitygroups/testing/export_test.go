@@ -0,0 +1,134 @@
+package testing
+
+import (
+	"testing"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/securitygroup/v1/securitygroups"
+	"github.com/G-Core/gcorelabscloud-go/gcore/securitygroup/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/testhelper/fakecloud"
+	"github.com/stretchr/testify/require"
+)
+
+func createGroup(t *testing.T, client *gcorecloud.ServiceClient, name string, metadata map[string]interface{}, rules ...securitygroups.CreateSecurityGroupRuleOpts) *securitygroups.SecurityGroup {
+	if rules == nil {
+		rules = []securitygroups.CreateSecurityGroupRuleOpts{}
+	}
+	group, err := securitygroups.Create(client, securitygroups.CreateOpts{
+		SecurityGroup: securitygroups.CreateSecurityGroupOpts{
+			Name:               name,
+			SecurityGroupRules: rules,
+			Metadata:           metadata,
+		},
+	}).Extract()
+	require.NoError(t, err)
+	return group
+}
+
+func groupsByName(t *testing.T, client *gcorecloud.ServiceClient) map[string]securitygroups.SecurityGroup {
+	all, err := securitygroups.ListAll(client, nil)
+	require.NoError(t, err)
+	result := map[string]securitygroups.SecurityGroup{}
+	for _, g := range all {
+		result[g.Name] = g
+	}
+	return result
+}
+
+func TestExportImport(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	source := server.ServiceClient("securitygroups", "v1")
+	target := server.ProjectServiceClient("securitygroups", "v1", server.AddProject("target"), fakecloud.DefaultRegionID)
+
+	web := createGroup(t, source, "web", map[string]interface{}{"env": "prod"},
+		tcpRule(intPtr(443), intPtr(443), stringPtr("0.0.0.0/0")))
+	db := createGroup(t, source, "db", nil)
+	_, err := securitygroups.AddRule(source, db.ID, securitygroups.CreateSecurityGroupRuleOpts{
+		Direction:     types.RuleDirectionIngress,
+		EtherType:     types.EtherTypeIPv4,
+		Protocol:      types.ProtocolTCP,
+		PortRangeMin:  intPtr(5432),
+		PortRangeMax:  intPtr(5432),
+		RemoteGroupID: &web.ID,
+	}).Extract()
+	require.NoError(t, err)
+
+	doc, err := securitygroups.Export(source, securitygroups.ExportOpts{})
+	require.NoError(t, err)
+	require.Equal(t, securitygroups.DocumentVersion, doc.Version)
+	require.Len(t, doc.SecurityGroups, 2)
+	require.Equal(t, "web", doc.SecurityGroups[0].Name)
+	require.Equal(t, map[string]string{"env": "prod"}, doc.SecurityGroups[0].Metadata)
+	require.Equal(t, "db", doc.SecurityGroups[1].Name)
+	require.Len(t, doc.SecurityGroups[1].Rules, 1)
+	require.Equal(t, "web", *doc.SecurityGroups[1].Rules[0].RemoteGroup)
+
+	single, err := securitygroups.Export(source, securitygroups.ExportOpts{SecurityGroupIDs: []string{db.ID}})
+	require.NoError(t, err)
+	require.Len(t, single.SecurityGroups, 1)
+	_, err = securitygroups.Export(source, securitygroups.ExportOpts{SecurityGroupIDs: []string{"unknown"}})
+	require.IsType(t, gcorecloud.ErrResourceNotFound{}, err)
+
+	// The db group references web, which only the document defines: the plan names it.
+	plan, err := securitygroups.Import(target, *doc, securitygroups.ImportOpts{Plan: true})
+	require.NoError(t, err)
+	require.True(t, plan.HasChanges())
+	require.Equal(t, securitygroups.ImportActionCreate, plan.SecurityGroups[1].Action)
+	require.Empty(t, plan.SecurityGroups[1].SecurityGroupID)
+	require.Equal(t, "web", plan.SecurityGroups[1].Rules.Changes[0].RemoteGroup)
+	require.Nil(t, plan.SecurityGroups[1].Rules.Changes[0].Rule.RemoteGroupID)
+	require.Empty(t, groupsByName(t, target))
+
+	report, err := securitygroups.Import(target, *doc, securitygroups.ImportOpts{})
+	require.NoError(t, err)
+	require.Equal(t, securitygroups.ImportActionCreate, report.SecurityGroups[0].Action)
+	require.Equal(t, securitygroups.ImportActionCreate, report.SecurityGroups[1].Action)
+	imported := groupsByName(t, target)
+	require.Len(t, imported, 2)
+	require.Equal(t, imported["web"].ID, report.SecurityGroups[0].SecurityGroupID)
+	require.Equal(t, imported["web"].ID, *imported["db"].SecurityGroupRules[0].RemoteGroupID)
+
+	again, err := securitygroups.Export(target, securitygroups.ExportOpts{})
+	require.NoError(t, err)
+	require.Equal(t, doc, again)
+
+	report, err = securitygroups.Import(target, *doc, securitygroups.ImportOpts{})
+	require.NoError(t, err)
+	require.False(t, report.HasChanges())
+
+	// The existing groups are converged to the document.
+	doc.SecurityGroups[0].Metadata["env"] = "staging"
+	doc.SecurityGroups[1].Rules = append(doc.SecurityGroups[1].Rules, securitygroups.RuleDocument{
+		Direction:   types.RuleDirectionIngress,
+		Protocol:    types.ProtocolTCP,
+		RemoteGroup: stringPtr("db"),
+	})
+	report, err = securitygroups.Import(target, *doc, securitygroups.ImportOpts{})
+	require.NoError(t, err)
+	require.Equal(t, securitygroups.ImportActionUpdate, report.SecurityGroups[0].Action)
+	require.True(t, report.SecurityGroups[0].Metadata)
+	require.Equal(t, securitygroups.ImportActionUpdate, report.SecurityGroups[1].Action)
+	require.Len(t, report.SecurityGroups[1].Rules.Changes, 1)
+	require.Equal(t, "db", report.SecurityGroups[1].Rules.Changes[0].RemoteGroup)
+
+	metadata, err := securitygroups.MetadataListAll(target, imported["web"].ID)
+	require.NoError(t, err)
+	require.Len(t, metadata, 1)
+	require.Equal(t, "staging", metadata[0].Value)
+
+	// A group without metadata in the document removes the metadata of the existing group.
+	doc.SecurityGroups[0].Metadata = nil
+	report, err = securitygroups.Import(target, *doc, securitygroups.ImportOpts{})
+	require.NoError(t, err)
+	require.True(t, report.SecurityGroups[0].Metadata)
+	metadata, err = securitygroups.MetadataListAll(target, imported["web"].ID)
+	require.NoError(t, err)
+	require.Empty(t, metadata)
+
+	doc.SecurityGroups[1].Rules[0].RemoteGroup = stringPtr("unknown")
+	_, err = securitygroups.Import(target, *doc, securitygroups.ImportOpts{Plan: true})
+	require.Error(t, err)
+	doc.SecurityGroups = append(doc.SecurityGroups, doc.SecurityGroups[0])
+	require.Error(t, doc.Validate())
+}
@@ -0,0 +1,127 @@
+package testing
+
+import (
+	"encoding/json"
+	"testing"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	instancetypes "github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/securitygroup/v1/securitygroups"
+	"github.com/G-Core/gcorelabscloud-go/gcore/securitygroup/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/testhelper/fakecloud"
+	"github.com/stretchr/testify/require"
+)
+
+type finding struct {
+	check  securitygroups.LintCheck
+	ruleID string
+}
+
+func TestLint(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	client := server.ServiceClient("securitygroups", "v1")
+
+	web := createGroup(t, client, "web", nil,
+		tcpRule(intPtr(22), intPtr(22), stringPtr("0.0.0.0/0")),
+		tcpRule(intPtr(80), intPtr(80), stringPtr("10.0.0.0/8")),
+		tcpRule(intPtr(80), intPtr(80), stringPtr("10.1.0.0/16")),
+		tcpRule(intPtr(80), nil, stringPtr("10.0.0.0/8")),
+		securitygroups.CreateSecurityGroupRuleOpts{Direction: types.RuleDirectionEgress, EtherType: types.EtherTypeIPv4, Protocol: types.ProtocolAny},
+		securitygroups.CreateSecurityGroupRuleOpts{Direction: types.RuleDirectionIngress, EtherType: types.EtherTypeIPv6, Protocol: types.ProtocolAny, RemoteIPPrefix: stringPtr("::/0")},
+	)
+	db := createGroup(t, client, "db", nil,
+		tcpRule(intPtr(5400), intPtr(5500), stringPtr("10.0.0.0/8")),
+		securitygroups.CreateSecurityGroupRuleOpts{Direction: types.RuleDirectionIngress, EtherType: types.EtherTypeIPv4, Protocol: types.ProtocolTCP, RemoteGroupID: &web.ID},
+		securitygroups.CreateSecurityGroupRuleOpts{Direction: types.RuleDirectionIngress, EtherType: types.EtherTypeIPv4, Protocol: types.ProtocolTCP, RemoteGroupID: stringPtr("deleted")},
+	)
+	_, err := instances.Create(server.ServiceClient("instances", "v1"), instances.CreateOpts{
+		Flavor:         "g1-standard-1-2",
+		Names:          []string{"web"},
+		Volumes:        []instances.CreateVolumeOpts{{Source: instancetypes.NewVolume, Size: 10}},
+		Interfaces:     []instances.InterfaceInstanceCreateOpts{{InterfaceOpts: instances.InterfaceOpts{Type: instancetypes.ExternalInterfaceType}}},
+		SecurityGroups: []gcorecloud.ItemID{{ID: web.ID}},
+	}).Extract()
+	require.NoError(t, err)
+
+	findings, err := securitygroups.Lint(client, securitygroups.LintOpts{})
+	require.NoError(t, err)
+	rules := web.SecurityGroupRules
+	var actual []finding
+	for _, f := range findings {
+		actual = append(actual, finding{f.Check, f.RuleID})
+	}
+	require.Equal(t, []finding{
+		{securitygroups.LintCheckOpenSensitivePort, rules[0].ID},
+		{securitygroups.LintCheckShadowedRule, rules[2].ID},
+		{securitygroups.LintCheckDuplicateRule, rules[3].ID},
+		{securitygroups.LintCheckAnyProtocol, rules[4].ID},
+		{securitygroups.LintCheckOpenSensitivePort, rules[5].ID},
+		{securitygroups.LintCheckAnyProtocol, rules[5].ID},
+		{securitygroups.LintCheckUnknownRemoteGroup, db.SecurityGroupRules[2].ID},
+		{securitygroups.LintCheckUnusedGroup, ""},
+	}, actual)
+	require.Equal(t, securitygroups.SeverityHigh, findings[0].Severity)
+	require.Equal(t, "IPv4 ingress from any address to sensitive ports 22", findings[0].Message)
+	require.Equal(t, securitygroups.SeverityLow, findings[3].Severity)
+	require.Equal(t, securitygroups.SeverityHigh, findings[5].Severity)
+	require.Equal(t, db.ID, findings[7].SecurityGroupID)
+
+	// Port 80 is open to 10.0.0.0/8 only, the ingress of any protocol from anywhere opens it.
+	findings, err = securitygroups.Lint(client, securitygroups.LintOpts{
+		SecurityGroupIDs: []string{web.ID},
+		SensitivePorts:   []int{80},
+		SkipUnused:       true,
+	})
+	require.NoError(t, err)
+	require.Len(t, findings, 5)
+	require.Equal(t, securitygroups.LintCheckOpenSensitivePort, findings[3].Check)
+	require.Equal(t, "IPv6 ingress from any address to sensitive ports 80", findings[3].Message)
+	_, err = securitygroups.Lint(client, securitygroups.LintOpts{SecurityGroupIDs: []string{"unknown"}})
+	require.IsType(t, gcorecloud.ErrResourceNotFound{}, err)
+
+	require.True(t, securitygroups.SeverityHigh.AtLeast(securitygroups.SeverityMedium))
+	require.False(t, securitygroups.SeverityLow.AtLeast(securitygroups.SeverityMedium))
+}
+
+func TestToSARIF(t *testing.T) {
+	content, err := securitygroups.ToSARIF([]securitygroups.Finding{{
+		Check:             securitygroups.LintCheckOpenSensitivePort,
+		Severity:          securitygroups.SeverityHigh,
+		SecurityGroupID:   "group",
+		SecurityGroupName: "web",
+		RuleID:            "rule",
+		Message:           "open",
+	}})
+	require.NoError(t, err)
+
+	var log struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Rules []struct {
+						ID string `json:"id"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []struct {
+				RuleID    string `json:"ruleId"`
+				Level     string `json:"level"`
+				Locations []struct {
+					LogicalLocations []struct {
+						FullyQualifiedName string `json:"fullyQualifiedName"`
+					} `json:"logicalLocations"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	require.NoError(t, json.Unmarshal(content, &log))
+	require.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs[0].Tool.Driver.Rules, 6)
+	result := log.Runs[0].Results[0]
+	require.Equal(t, "open-sensitive-port", result.RuleID)
+	require.Equal(t, "error", result.Level)
+	require.Equal(t, "securitygroups/group/rules/rule", result.Locations[0].LogicalLocations[0].FullyQualifiedName)
+}
@@ -0,0 +1,147 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/securitygroup/v1/securitygroups"
+	"github.com/G-Core/gcorelabscloud-go/gcore/securitygroup/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/testhelper/fakecloud"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func tcpRule(min, max *int, prefix *string) securitygroups.CreateSecurityGroupRuleOpts {
+	return securitygroups.CreateSecurityGroupRuleOpts{
+		Direction:      types.RuleDirectionIngress,
+		EtherType:      types.EtherTypeIPv4,
+		Protocol:       types.ProtocolTCP,
+		PortRangeMin:   min,
+		PortRangeMax:   max,
+		RemoteIPPrefix: prefix,
+	}
+}
+
+func TestNormalizeRule(t *testing.T) {
+	same := [][]securitygroups.CreateSecurityGroupRuleOpts{
+		{
+			tcpRule(intPtr(22), intPtr(22), nil),
+			tcpRule(intPtr(22), nil, stringPtr("0.0.0.0/0")),
+			{Direction: types.RuleDirectionIngress, Protocol: "6", PortRangeMax: intPtr(22)},
+		},
+		{
+			tcpRule(nil, nil, stringPtr("10.1.2.3/8")),
+			tcpRule(intPtr(1), intPtr(65535), stringPtr("10.0.0.0/8")),
+		},
+		{
+			tcpRule(intPtr(443), intPtr(443), stringPtr("192.168.1.10")),
+			tcpRule(intPtr(443), intPtr(443), stringPtr("192.168.1.10/32")),
+		},
+		{
+			{Direction: types.RuleDirectionEgress},
+			{Direction: types.RuleDirectionEgress, EtherType: types.EtherTypeIPv4, Protocol: types.ProtocolAny},
+		},
+		{
+			{Direction: types.RuleDirectionIngress, RemoteIPPrefix: stringPtr("2001:db8::1/32")},
+			{Direction: types.RuleDirectionIngress, EtherType: types.EtherTypeIPv6, RemoteIPPrefix: stringPtr("2001:db8::/32")},
+		},
+	}
+	for i, rules := range same {
+		for _, rule := range rules[1:] {
+			require.Equal(t, securitygroups.NormalizeRule(rules[0]), securitygroups.NormalizeRule(rule), "group %d", i)
+		}
+	}
+
+	different := []securitygroups.CreateSecurityGroupRuleOpts{
+		tcpRule(intPtr(22), intPtr(22), nil),
+		tcpRule(intPtr(22), intPtr(23), nil),
+		tcpRule(intPtr(22), intPtr(22), stringPtr("10.0.0.0/8")),
+		{Direction: types.RuleDirectionIngress, EtherType: types.EtherTypeIPv4, Protocol: types.ProtocolUDP, PortRangeMin: intPtr(22)},
+		{Direction: types.RuleDirectionEgress, EtherType: types.EtherTypeIPv4, Protocol: types.ProtocolTCP, PortRangeMin: intPtr(22)},
+		{Direction: types.RuleDirectionIngress, Protocol: types.ProtocolICMP},
+		{Direction: types.RuleDirectionIngress, Protocol: types.ProtocolICMP, PortRangeMin: intPtr(8)},
+		{Direction: types.RuleDirectionIngress, Protocol: types.ProtocolTCP, RemoteGroupID: stringPtr("group")},
+	}
+	seen := map[securitygroups.NormalizedRule]int{}
+	for i, rule := range different {
+		key := securitygroups.NormalizeRule(rule)
+		previous, ok := seen[key]
+		require.False(t, ok, "rule %d is the same as rule %d", i, previous)
+		seen[key] = i
+	}
+}
+
+func TestSync(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	client := server.ServiceClient("securitygroups", "v1")
+
+	ssh := tcpRule(intPtr(22), intPtr(22), stringPtr("0.0.0.0/0"))
+	httpRule := tcpRule(intPtr(80), intPtr(80), nil)
+	egress := securitygroups.CreateSecurityGroupRuleOpts{
+		Direction: types.RuleDirectionEgress,
+		EtherType: types.EtherTypeIPv4,
+		Protocol:  types.ProtocolAny,
+	}
+	group, err := securitygroups.Create(client, securitygroups.CreateOpts{
+		SecurityGroup: securitygroups.CreateSecurityGroupOpts{
+			Name:               "web",
+			SecurityGroupRules: []securitygroups.CreateSecurityGroupRuleOpts{ssh, httpRule, egress, ssh},
+		},
+	}).Extract()
+	require.NoError(t, err)
+
+	https := tcpRule(intPtr(443), nil, nil)
+	desired := []securitygroups.CreateSecurityGroupRuleOpts{
+		tcpRule(intPtr(22), nil, nil),
+		https,
+		{Direction: types.RuleDirectionEgress},
+		https,
+	}
+
+	plan, err := securitygroups.PlanSync(client, group.ID, desired)
+	require.NoError(t, err)
+	require.Equal(t, 2, plan.Unchanged)
+	require.Len(t, plan.Changes, 3)
+	require.Equal(t, securitygroups.RuleActionAdd, plan.Changes[0].Action)
+	require.Equal(t, https, plan.Changes[0].Rule)
+	require.Equal(t, securitygroups.RuleActionDelete, plan.Changes[1].Action)
+	require.Equal(t, group.SecurityGroupRules[1].ID, plan.Changes[1].RuleID)
+	require.Equal(t, securitygroups.RuleActionDelete, plan.Changes[2].Action)
+	require.Equal(t, group.SecurityGroupRules[3].ID, plan.Changes[2].RuleID)
+	for _, change := range plan.Changes {
+		require.False(t, change.Applied)
+	}
+
+	// The plan leaves the group as is.
+	current, err := securitygroups.Get(client, group.ID).Extract()
+	require.NoError(t, err)
+	require.Len(t, current.SecurityGroupRules, 4)
+
+	report, err := securitygroups.Sync(client, group.ID, desired)
+	require.NoError(t, err)
+	require.Len(t, report.Changes, 3)
+	require.NotEmpty(t, report.Changes[0].RuleID)
+	for _, change := range report.Changes {
+		require.True(t, change.Applied)
+	}
+
+	current, err = securitygroups.Get(client, group.ID).Extract()
+	require.NoError(t, err)
+	var ids []string
+	for _, rule := range current.SecurityGroupRules {
+		ids = append(ids, rule.ID)
+	}
+	require.Equal(t, []string{group.SecurityGroupRules[0].ID, group.SecurityGroupRules[2].ID, report.Changes[0].RuleID}, ids)
+
+	report, err = securitygroups.Sync(client, group.ID, desired)
+	require.NoError(t, err)
+	require.False(t, report.HasChanges())
+	require.Equal(t, 3, report.Unchanged)
+}
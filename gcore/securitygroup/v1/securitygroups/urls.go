@@ -56,3 +56,8 @@ func metadataURL(c *gcorecloud.ServiceClient, id string) string {
 func metadataItemURL(c *gcorecloud.ServiceClient, id string, key string) string {
 	return resourceActionURL(c, id, fmt.Sprintf("metadata_item?key=%s", key))
 }
+
+// ruleURL is the URL of a rule in the security group rules API, see securitygrouprules.
+func ruleURL(c *gcorecloud.ServiceClient, ruleID string) string {
+	return c.ForService("securitygrouprules").ServiceURL(ruleID)
+}
@@ -0,0 +1,104 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of servergroups.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/servergroup/v1/servergroups"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of servergroups.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc  func(opts servergroups.CreateOptsBuilder) servergroups.GetResult
+	DeleteFunc  func(serverGroupID string) tasks.Result
+	GetFunc     func(id string) servergroups.GetResult
+	ListFunc    func() pagination.Pager
+	ListAllFunc func() ([]servergroups.ServerGroup, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ servergroups.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements servergroups.API.
+func (m *API) Create(opts servergroups.CreateOptsBuilder) servergroups.GetResult {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: servergroups.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements servergroups.API.
+func (m *API) Delete(serverGroupID string) tasks.Result {
+	m.record("Delete", serverGroupID)
+	if m.DeleteFunc == nil {
+		panic("mocks: servergroups.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(serverGroupID)
+}
+
+// Get implements servergroups.API.
+func (m *API) Get(id string) servergroups.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: servergroups.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// List implements servergroups.API.
+func (m *API) List() pagination.Pager {
+	m.record("List")
+	if m.ListFunc == nil {
+		panic("mocks: servergroups.API.ListFunc is not set")
+	}
+	return m.ListFunc()
+}
+
+// ListAll implements servergroups.API.
+func (m *API) ListAll() ([]servergroups.ServerGroup, error) {
+	m.record("ListAll")
+	if m.ListAllFunc == nil {
+		panic("mocks: servergroups.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc()
+}
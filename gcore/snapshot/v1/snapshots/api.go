@@ -0,0 +1,68 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package snapshots
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of snapshots operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Create(opts CreateOptsBuilder) tasks.Result
+	Delete(snapshotID string) tasks.Result
+	Get(id string) GetResult
+	IDFromName(name string, opts ListOptsBuilder) (string, error)
+	List(opts ListOptsBuilder) pagination.Pager
+	ListAll(opts ListOptsBuilder) ([]Snapshot, error)
+	MetadataReplace(id string, opts MetadataSetOpts) GetResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(snapshotID string) tasks.Result {
+	return Delete(s.ServiceClient, snapshotID)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id string) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// IDFromName calls the package level IDFromName function with the service client.
+func (s *Service) IDFromName(name string, opts ListOptsBuilder) (string, error) {
+	return IDFromName(s.ServiceClient, name, opts)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(opts ListOptsBuilder) ([]Snapshot, error) {
+	return ListAll(s.ServiceClient, opts)
+}
+
+// MetadataReplace calls the package level MetadataReplace function with the service client.
+func (s *Service) MetadataReplace(id string, opts MetadataSetOpts) GetResult {
+	return MetadataReplace(s.ServiceClient, id, opts)
+}
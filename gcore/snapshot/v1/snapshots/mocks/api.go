@@ -0,0 +1,124 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of snapshots.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/snapshot/v1/snapshots"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of snapshots.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc          func(opts snapshots.CreateOptsBuilder) tasks.Result
+	DeleteFunc          func(snapshotID string) tasks.Result
+	GetFunc             func(id string) snapshots.GetResult
+	IDFromNameFunc      func(name string, opts snapshots.ListOptsBuilder) (string, error)
+	ListFunc            func(opts snapshots.ListOptsBuilder) pagination.Pager
+	ListAllFunc         func(opts snapshots.ListOptsBuilder) ([]snapshots.Snapshot, error)
+	MetadataReplaceFunc func(id string, opts snapshots.MetadataSetOpts) snapshots.GetResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ snapshots.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements snapshots.API.
+func (m *API) Create(opts snapshots.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: snapshots.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements snapshots.API.
+func (m *API) Delete(snapshotID string) tasks.Result {
+	m.record("Delete", snapshotID)
+	if m.DeleteFunc == nil {
+		panic("mocks: snapshots.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(snapshotID)
+}
+
+// Get implements snapshots.API.
+func (m *API) Get(id string) snapshots.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: snapshots.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// IDFromName implements snapshots.API.
+func (m *API) IDFromName(name string, opts snapshots.ListOptsBuilder) (string, error) {
+	m.record("IDFromName", name, opts)
+	if m.IDFromNameFunc == nil {
+		panic("mocks: snapshots.API.IDFromNameFunc is not set")
+	}
+	return m.IDFromNameFunc(name, opts)
+}
+
+// List implements snapshots.API.
+func (m *API) List(opts snapshots.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: snapshots.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements snapshots.API.
+func (m *API) ListAll(opts snapshots.ListOptsBuilder) ([]snapshots.Snapshot, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: snapshots.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// MetadataReplace implements snapshots.API.
+func (m *API) MetadataReplace(id string, opts snapshots.MetadataSetOpts) snapshots.GetResult {
+	m.record("MetadataReplace", id, opts)
+	if m.MetadataReplaceFunc == nil {
+		panic("mocks: snapshots.API.MetadataReplaceFunc is not set")
+	}
+	return m.MetadataReplaceFunc(id, opts)
+}
@@ -0,0 +1,62 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of ipam.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/subnet/v1/ipam"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of ipam.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	ReportFunc func(opts ipam.Opts) ([]ipam.Usage, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ ipam.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Report implements ipam.API.
+func (m *API) Report(opts ipam.Opts) ([]ipam.Usage, error) {
+	m.record("Report", opts)
+	if m.ReportFunc == nil {
+		panic("mocks: ipam.API.ReportFunc is not set")
+	}
+	return m.ReportFunc(opts)
+}
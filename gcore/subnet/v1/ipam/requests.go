@@ -0,0 +1,353 @@
+package ipam
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"sort"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/port/v1/ports"
+	"github.com/G-Core/gcorelabscloud-go/gcore/reservedfixedip/v1/reservedfixedips"
+	"github.com/G-Core/gcorelabscloud-go/gcore/router/v1/routers"
+	"github.com/G-Core/gcorelabscloud-go/gcore/subnet/v1/subnets"
+)
+
+// DefaultNextFree is the count of next free addresses of a report.
+const DefaultNextFree = 5
+
+// Opts selects the subnets of a report.
+type Opts struct {
+	// SubnetIDs selects the subnets by ID, all the subnets of the network or the project when empty.
+	SubnetIDs []string
+	NetworkID string
+	// NextFree is the count of next free addresses listed per subnet, DefaultNextFree if not set. A negative
+	// value lists none.
+	NextFree int
+}
+
+// allocations keeps the allocated addresses by subnet ID and address.
+type allocations map[string]map[string]Allocation
+
+// priority orders the owners of an address found in several sources: a resource using a reserved fixed IP or the
+// gateway address is reported rather than the reservation or the gateway, and a reservation rather than its port.
+func priority(owner Owner) int {
+	switch owner {
+	case PortOwner:
+		return 0
+	case ReservedOwner:
+		return 1
+	case GatewayOwner:
+		return 2
+	}
+	return 3
+}
+
+func (a allocations) add(subnetID string, allocation Allocation) {
+	if subnetID == "" || allocation.IPAddress == nil {
+		return
+	}
+	bySubnet, ok := a[subnetID]
+	if !ok {
+		bySubnet = map[string]Allocation{}
+		a[subnetID] = bySubnet
+	}
+	key := allocation.IPAddress.String()
+	if current, ok := bySubnet[key]; ok {
+		if priority(current.Owner) > priority(allocation.Owner) {
+			return
+		}
+		if allocation.PortID == "" {
+			allocation.PortID = current.PortID
+		}
+	}
+	bySubnet[key] = allocation
+}
+
+// Report returns the address usage of the subnets, combining the subnet gateways, the addresses of the interfaces of
+// the instances and baremetal servers and of the router interfaces, the reserved fixed IPs, including the VIPs and
+// the load balancer addresses, and the ports, e.g. the DHCP ports of the subnets or the ports of file shares. The
+// usage is limited to the allocation pools of the subnets.
+func Report(client *gcorecloud.ServiceClient, opts Opts) ([]Usage, error) {
+	var listOpts subnets.ListOptsBuilder
+	if opts.NetworkID != "" {
+		listOpts = subnets.ListOpts{NetworkID: opts.NetworkID}
+	}
+	all, err := subnets.ListAll(client.ForService("subnets"), listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("listing subnets: %w", err)
+	}
+	selected, err := selectSubnets(all, opts.SubnetIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	allocated := allocations{}
+	for _, s := range selected {
+		allocated.add(s.ID, Allocation{IPAddress: s.GatewayIP, Owner: GatewayOwner})
+	}
+	collectors := []func(*gcorecloud.ServiceClient, allocations) error{
+		collectReserved, collectPorts, collectRouters, collectInstances,
+	}
+	for _, collect := range collectors {
+		if err := collect(client, allocated); err != nil {
+			return nil, err
+		}
+	}
+
+	nextFree := opts.NextFree
+	if nextFree == 0 {
+		nextFree = DefaultNextFree
+	}
+	result := make([]Usage, 0, len(selected))
+	for _, s := range selected {
+		result = append(result, usage(s, allocated[s.ID], nextFree))
+	}
+	return result, nil
+}
+
+func selectSubnets(all []subnets.Subnet, ids []string) ([]subnets.Subnet, error) {
+	if len(ids) == 0 {
+		return all, nil
+	}
+	byID := make(map[string]subnets.Subnet, len(all))
+	for _, s := range all {
+		byID[s.ID] = s
+	}
+	result := make([]subnets.Subnet, 0, len(ids))
+	for _, id := range ids {
+		s, ok := byID[id]
+		if !ok {
+			return nil, gcorecloud.ErrResourceNotFound{Name: id, ResourceType: "subnets"}
+		}
+		result = append(result, s)
+	}
+	return result, nil
+}
+
+func collectReserved(client *gcorecloud.ServiceClient, allocated allocations) error {
+	all, err := reservedfixedips.ListAll(client.ForService("reserved_fixed_ips"), nil)
+	if err != nil {
+		return fmt.Errorf("listing reserved fixed IPs: %w", err)
+	}
+	for _, ip := range all {
+		allocation := Allocation{IPAddress: ip.FixedIPAddress, Owner: ReservedOwner, PortID: ip.PortID, DeviceName: ip.Name}
+		switch {
+		case ip.IsVip:
+			allocation.Owner = VIPOwner
+		case ip.Reservation.ResourceType != nil && *ip.Reservation.ResourceType != "":
+			allocation.Owner = Owner(*ip.Reservation.ResourceType)
+			allocation.DeviceName = ""
+			if ip.Reservation.ResourceID != nil {
+				allocation.DeviceID = *ip.Reservation.ResourceID
+			}
+		}
+		allocated.add(ip.SubnetID, allocation)
+	}
+	return nil
+}
+
+func collectPorts(client *gcorecloud.ServiceClient, allocated allocations) error {
+	all, err := ports.ListAll(client.ForService("ports"), nil)
+	if err != nil {
+		return fmt.Errorf("listing ports: %w", err)
+	}
+	for _, p := range all {
+		allocation := Allocation{Owner: PortOwner, PortID: p.PortID}
+		if p.InstanceID != "" {
+			allocation.Owner, allocation.DeviceID, allocation.DeviceName = InstanceOwner, p.InstanceID, p.InstanceName
+		}
+		for _, a := range p.IPAssignments {
+			allocation.IPAddress = a.IPAddress
+			allocated.add(a.SubnetID, allocation)
+		}
+	}
+	return nil
+}
+
+func collectRouters(client *gcorecloud.ServiceClient, allocated allocations) error {
+	all, err := routers.ListAll(client.ForService("routers"), nil)
+	if err != nil {
+		return fmt.Errorf("listing routers: %w", err)
+	}
+	for _, r := range all {
+		for _, iface := range r.Interfaces {
+			for _, a := range iface.IPAssignments {
+				allocated.add(a.SubnetID, Allocation{
+					IPAddress:  a.IPAddress,
+					Owner:      RouterOwner,
+					PortID:     iface.PortID,
+					DeviceID:   r.ID,
+					DeviceName: r.Name,
+				})
+			}
+		}
+	}
+	return nil
+}
+
+func collectInstances(client *gcorecloud.ServiceClient, allocated allocations) error {
+	client = client.ForService("instances")
+	all, err := instances.ListAll(client, instances.ListOpts{IncludeBaremetal: true})
+	if err != nil {
+		return fmt.Errorf("listing instances: %w", err)
+	}
+	for _, instance := range all {
+		interfaces, err := instances.ListInterfacesAll(client, instance.ID)
+		if err != nil {
+			return fmt.Errorf("listing interfaces of instance %s: %w", instance.ID, err)
+		}
+		add := func(portID string, assignments []instances.PortIP) {
+			for _, a := range assignments {
+				allocated.add(a.SubnetID, Allocation{
+					IPAddress:  a.IPAddress,
+					Owner:      InstanceOwner,
+					PortID:     portID,
+					DeviceID:   instance.ID,
+					DeviceName: instance.Name,
+				})
+			}
+		}
+		for _, iface := range interfaces {
+			add(iface.PortID, iface.IPAssignments)
+			for _, sub := range iface.SubPorts {
+				add(sub.PortID, sub.IPAssignments)
+			}
+		}
+	}
+	return nil
+}
+
+// assignableRange returns the first and last assignable addresses of the subnet: the network address and the
+// IPv4 broadcast address are excluded.
+func assignableRange(cidr net.IPNet) (*big.Int, *big.Int, int) {
+	ones, bits := cidr.Mask.Size()
+	ip := cidr.IP.To4()
+	if ip == nil || bits == 8*net.IPv6len {
+		ip = cidr.IP.To16()
+	}
+	first := new(big.Int).SetBytes(ip.Mask(cidr.Mask))
+	last := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+	last.Add(last, first).Sub(last, big.NewInt(1))
+	if bits-ones < 2 {
+		return first, last, bits
+	}
+	first.Add(first, big.NewInt(1))
+	if bits == 8*net.IPv4len {
+		last.Sub(last, big.NewInt(1))
+	}
+	return first, last, bits
+}
+
+// addressRange is an inclusive range of addresses.
+type addressRange struct {
+	first, last *big.Int
+}
+
+// assignableRanges returns the ranges of the addresses the subnet assigns to its ports: its allocation pools, or
+// its assignable range when it has none.
+func assignableRanges(s subnets.Subnet) ([]addressRange, int) {
+	first, last, bits := assignableRange(s.CIDR.IPNet)
+	if len(s.AllocationPools) == 0 {
+		return []addressRange{{first: first, last: last}}, bits
+	}
+	ranges := make([]addressRange, 0, len(s.AllocationPools))
+	for _, pool := range s.AllocationPools {
+		ranges = append(ranges, addressRange{first: toInt(pool.Start, bits), last: toInt(pool.End, bits)})
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].first.Cmp(ranges[j].first) < 0
+	})
+	return ranges, bits
+}
+
+func inRanges(ranges []addressRange, n *big.Int) bool {
+	for _, r := range ranges {
+		if r.first.Cmp(n) <= 0 && n.Cmp(r.last) <= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func toInt(ip net.IP, bits int) *big.Int {
+	if bits == 8*net.IPv4len {
+		ip = ip.To4()
+	} else {
+		ip = ip.To16()
+	}
+	return new(big.Int).SetBytes(ip)
+}
+
+func toIP(n *big.Int, bits int) net.IP {
+	ip := make(net.IP, bits/8)
+	n.FillBytes(ip)
+	return ip
+}
+
+func capped(n *big.Int) uint64 {
+	if !n.IsUint64() {
+		return math.MaxUint64
+	}
+	return n.Uint64()
+}
+
+func usage(s subnets.Subnet, allocated map[string]Allocation, nextFree int) Usage {
+	u := Usage{
+		SubnetID:    s.ID,
+		SubnetName:  s.Name,
+		NetworkID:   s.NetworkID,
+		CIDR:        s.CIDR,
+		Allocations: []Allocation{},
+		NextFree:    []net.IP{},
+	}
+	ranges, bits := assignableRanges(s)
+	total := new(big.Int)
+	for _, r := range ranges {
+		total.Add(total, new(big.Int).Sub(r.last, r.first))
+		total.Add(total, big.NewInt(1))
+	}
+	u.Total = capped(total)
+
+	var pooled uint64
+	for _, a := range allocated {
+		if !s.CIDR.Contains(a.IPAddress) {
+			continue
+		}
+		u.Allocations = append(u.Allocations, a)
+		if a.Owner == ReservedOwner {
+			u.Reserved++
+		} else {
+			u.Used++
+		}
+		if inRanges(ranges, toInt(a.IPAddress, bits)) {
+			pooled++
+		}
+	}
+	sort.Slice(u.Allocations, func(i, j int) bool {
+		return bytes.Compare(u.Allocations[i].IPAddress.To16(), u.Allocations[j].IPAddress.To16()) < 0
+	})
+
+	free := new(big.Int).Sub(total, new(big.Int).SetUint64(pooled))
+	if free.Sign() > 0 {
+		u.Free = capped(free)
+	}
+	if total.Sign() > 0 {
+		percent, _ := new(big.Float).Quo(new(big.Float).SetUint64(pooled), new(big.Float).SetInt(total)).Float64()
+		u.UsedPercent = math.Round(percent*10000) / 100
+	}
+
+	for _, r := range ranges {
+		candidate := new(big.Int).Set(r.first)
+		for ; candidate.Cmp(r.last) <= 0 && len(u.NextFree) < nextFree; candidate.Add(candidate, big.NewInt(1)) {
+			ip := toIP(candidate, bits)
+			if _, ok := allocated[ip.String()]; !ok {
+				u.NextFree = append(u.NextFree, ip)
+			}
+		}
+	}
+	return u
+}
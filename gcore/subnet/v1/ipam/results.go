@@ -0,0 +1,60 @@
+package ipam
+
+import (
+	"net"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+)
+
+// Owner is the kind of resource an address is allocated to. The resource types of the reserved fixed IP
+// reservations, e.g. "loadbalancer", are used as is.
+type Owner string
+
+const (
+	GatewayOwner  Owner = "gateway"
+	InstanceOwner Owner = "instance"
+	RouterOwner   Owner = "router"
+	VIPOwner      Owner = "vip"
+	// ReservedOwner is a reserved fixed IP not used by any resource.
+	ReservedOwner Owner = "reserved"
+	// PortOwner is a port of no instance, router or reservation, e.g. the DHCP port of a subnet.
+	PortOwner Owner = "port"
+)
+
+// Allocation is an address of a subnet and its owner.
+type Allocation struct {
+	IPAddress net.IP `json:"ip_address"`
+	Owner     Owner  `json:"owner"`
+	PortID    string `json:"port_id,omitempty"`
+	// DeviceID and DeviceName identify the instance, router or other resource using the address.
+	DeviceID   string `json:"device_id,omitempty"`
+	DeviceName string `json:"device_name,omitempty"`
+}
+
+// Usage is the address usage of a subnet.
+type Usage struct {
+	SubnetID   string          `json:"subnet_id"`
+	SubnetName string          `json:"subnet_name"`
+	NetworkID  string          `json:"network_id"`
+	CIDR       gcorecloud.CIDR `json:"cidr"`
+	// Total is the count of the addresses of the allocation pools, or of the assignable addresses without the
+	// network and broadcast addresses when the subnet has no pools. It is capped to the maximum uint64 for the
+	// largest IPv6 subnets.
+	Total uint64 `json:"total"`
+	// Used is the count of the addresses used by a resource, including the gateway and the VIPs.
+	Used uint64 `json:"used"`
+	// Reserved is the count of the reserved fixed IPs not used by any resource.
+	Reserved uint64 `json:"reserved"`
+	// Free is the count of the addresses of the pools neither used nor reserved.
+	Free uint64 `json:"free"`
+	// UsedPercent is the share of the used and reserved addresses of the pools.
+	UsedPercent float64      `json:"used_percent"`
+	Allocations []Allocation `json:"allocations"`
+	// NextFree are the first free addresses of the pools.
+	NextFree []net.IP `json:"next_free"`
+}
+
+// Exceeds reports whether the used and reserved addresses take at least the percentage of the subnet.
+func (u Usage) Exceeds(percent float64) bool {
+	return u.UsedPercent >= percent
+}
@@ -0,0 +1,163 @@
+package testing
+
+import (
+	"net"
+	"testing"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/baremetal/v1/bminstances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/network/v1/networks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/reservedfixedip/v1/reservedfixedips"
+	"github.com/G-Core/gcorelabscloud-go/gcore/router/v1/routers"
+	routertypes "github.com/G-Core/gcorelabscloud-go/gcore/router/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/subnet/v1/ipam"
+	"github.com/G-Core/gcorelabscloud-go/gcore/subnet/v1/subnets"
+	"github.com/G-Core/gcorelabscloud-go/testhelper/fakecloud"
+	"github.com/stretchr/testify/require"
+)
+
+func createSubnet(t *testing.T, server *fakecloud.Server, networkID, cidr string) string {
+	parsed, err := gcorecloud.ParseCIDRString(cidr)
+	require.NoError(t, err)
+	task := server.FinishedTask(t, subnets.Create(server.ServiceClient("subnets", "v1"), subnets.CreateOpts{
+		Name:      "subnet-" + cidr,
+		CIDR:      *parsed,
+		NetworkID: networkID,
+	}))
+	id, err := subnets.ExtractSubnetIDFromTask(task)
+	require.NoError(t, err)
+	return id
+}
+
+func createReservedFixedIP(t *testing.T, server *fakecloud.Server, subnetID string, vip bool) string {
+	task := server.FinishedTask(t, reservedfixedips.Create(server.ServiceClient("reserved_fixed_ips", "v1"), reservedfixedips.CreateOpts{
+		Type:     reservedfixedips.Subnet,
+		SubnetID: subnetID,
+		IsVip:    vip,
+	}))
+	id, err := reservedfixedips.ExtractReservedFixedIPIDFromTask(task)
+	require.NoError(t, err)
+	return id
+}
+
+func TestReport(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+
+	task := server.FinishedTask(t, networks.Create(server.ServiceClient("networks", "v1"), networks.CreateOpts{Name: "network"}))
+	networkID, err := networks.ExtractNetworkIDFromTask(task)
+	require.NoError(t, err)
+	subnetID := createSubnet(t, server, networkID, "192.168.10.0/24")
+	fullSubnetID := createSubnet(t, server, networkID, "192.168.20.0/30")
+
+	task = server.FinishedTask(t, routers.Create(server.ServiceClient("routers", "v1"), routers.CreateOpts{
+		Name:       "router",
+		Interfaces: []routers.Interface{{Type: routertypes.SubnetInterfaceType, SubnetID: subnetID}},
+	}))
+	routerID, err := routers.ExtractRouterIDFromTask(task)
+	require.NoError(t, err)
+	task = server.FinishedTask(t, instances.Create(server.ServiceClient("instances", "v1"), instances.CreateOpts{
+		Flavor:  "g1-standard-1-2",
+		Names:   []string{"web"},
+		Volumes: []instances.CreateVolumeOpts{{Source: types.NewVolume, Size: 10}},
+		Interfaces: []instances.InterfaceInstanceCreateOpts{{
+			InterfaceOpts: instances.InterfaceOpts{Type: types.SubnetInterfaceType, SubnetID: subnetID},
+		}},
+	}))
+	instanceID, err := instances.ExtractInstanceIDFromTask(task)
+	require.NoError(t, err)
+	reservedID := createReservedFixedIP(t, server, subnetID, false)
+	vipID := createReservedFixedIP(t, server, subnetID, true)
+
+	client := server.ServiceClient("subnets", "v1")
+	report, err := ipam.Report(client, ipam.Opts{NetworkID: networkID})
+	require.NoError(t, err)
+	require.Len(t, report, 2)
+
+	usage := report[0]
+	require.Equal(t, subnetID, usage.SubnetID)
+	// The allocation pool leaves out the gateway address.
+	require.Equal(t, uint64(253), usage.Total)
+	require.Equal(t, uint64(4), usage.Used)
+	require.Equal(t, uint64(1), usage.Reserved)
+	require.Equal(t, uint64(249), usage.Free)
+	require.Equal(t, 1.58, usage.UsedPercent)
+	require.True(t, usage.Exceeds(1.5))
+	require.False(t, usage.Exceeds(2))
+
+	owners := map[ipam.Owner]ipam.Allocation{}
+	allocated := map[string]bool{}
+	for _, a := range usage.Allocations {
+		owners[a.Owner] = a
+		allocated[a.IPAddress.String()] = true
+	}
+	require.Len(t, owners, 5)
+	// The gateway address is reported as the router interface using it.
+	require.Equal(t, "192.168.10.1", owners[ipam.RouterOwner].IPAddress.String())
+	// The DHCP port takes the first address of the pool.
+	require.Equal(t, "192.168.10.2", owners[ipam.PortOwner].IPAddress.String())
+	require.Empty(t, owners[ipam.PortOwner].DeviceID)
+	require.Equal(t, routerID, owners[ipam.RouterOwner].DeviceID)
+	require.Equal(t, instanceID, owners[ipam.InstanceOwner].DeviceID)
+	require.Equal(t, "web", owners[ipam.InstanceOwner].DeviceName)
+	require.Equal(t, reservedID, owners[ipam.ReservedOwner].PortID)
+	require.Equal(t, vipID, owners[ipam.VIPOwner].PortID)
+
+	require.Len(t, usage.NextFree, ipam.DefaultNextFree)
+	_, cidr, _ := net.ParseCIDR("192.168.10.0/24")
+	for _, ip := range usage.NextFree {
+		require.True(t, cidr.Contains(ip))
+		require.False(t, allocated[ip.String()], ip.String())
+	}
+	require.Equal(t, "192.168.10.3", usage.NextFree[0].String())
+
+	// The pool of a /30 is its only address but the gateway, which the DHCP port takes.
+	full := report[1]
+	require.Equal(t, fullSubnetID, full.SubnetID)
+	require.Equal(t, uint64(1), full.Total)
+	require.Equal(t, uint64(2), full.Used)
+	require.Equal(t, uint64(0), full.Free)
+	require.Equal(t, ipam.GatewayOwner, full.Allocations[0].Owner)
+	require.Equal(t, ipam.PortOwner, full.Allocations[1].Owner)
+	require.Empty(t, full.NextFree)
+	require.Equal(t, 100.0, full.UsedPercent)
+
+	report, err = ipam.Report(client, ipam.Opts{SubnetIDs: []string{subnetID}, NextFree: -1})
+	require.NoError(t, err)
+	require.Len(t, report, 1)
+	require.Empty(t, report[0].NextFree)
+
+	_, err = ipam.Report(client, ipam.Opts{SubnetIDs: []string{"unknown"}})
+	require.IsType(t, gcorecloud.ErrResourceNotFound{}, err)
+}
+
+func TestReportBaremetal(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+
+	task := server.FinishedTask(t, networks.Create(server.ServiceClient("networks", "v1"), networks.CreateOpts{Name: "network"}))
+	networkID, err := networks.ExtractNetworkIDFromTask(task)
+	require.NoError(t, err)
+	subnetID := createSubnet(t, server, networkID, "192.168.10.0/24")
+	baremetalID := server.CreatedID(t, bminstances.Create(server.ServiceClient("bminstances", "v1"), bminstances.CreateOpts{
+		Flavor:     "bm1-infrastructure-small",
+		Names:      []string{"bm-1"},
+		ImageID:    "1b4ab5cf-7e3c-4f3f-9b0e-6a2d0c5e8f11",
+		Interfaces: []bminstances.InterfaceOpts{{Type: types.SubnetInterfaceType, NetworkID: networkID, SubnetID: subnetID}},
+	}), instances.ExtractInstanceIDFromTask)
+
+	report, err := ipam.Report(server.ServiceClient("subnets", "v1"), ipam.Opts{SubnetIDs: []string{subnetID}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), report[0].Used)
+	var owners []ipam.Owner
+	for _, a := range report[0].Allocations {
+		owners = append(owners, a.Owner)
+		if a.Owner == ipam.InstanceOwner {
+			require.Equal(t, baremetalID, a.DeviceID)
+			require.Equal(t, "bm-1", a.DeviceName)
+		}
+	}
+	require.ElementsMatch(t, []ipam.Owner{ipam.GatewayOwner, ipam.PortOwner, ipam.InstanceOwner}, owners)
+}
@@ -0,0 +1,80 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package subnets
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of subnets operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Create(opts CreateOptsBuilder) tasks.Result
+	CreateInFreeCIDR(opts CreateOpts, free FreeCIDROpts) tasks.Result
+	Delete(subnetID string) tasks.Result
+	FindFreeCIDR(opts FreeCIDROpts) (*gcorecloud.CIDR, error)
+	Get(id string) GetResult
+	IDFromName(name string) (string, error)
+	List(opts ListOptsBuilder) pagination.Pager
+	ListAll(opts ListOptsBuilder) ([]Subnet, error)
+	Update(subnetID string, opts UpdateOptsBuilder) UpdateResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, opts)
+}
+
+// CreateInFreeCIDR calls the package level CreateInFreeCIDR function with the service client.
+func (s *Service) CreateInFreeCIDR(opts CreateOpts, free FreeCIDROpts) tasks.Result {
+	return CreateInFreeCIDR(s.ServiceClient, opts, free)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(subnetID string) tasks.Result {
+	return Delete(s.ServiceClient, subnetID)
+}
+
+// FindFreeCIDR calls the package level FindFreeCIDR function with the service client.
+func (s *Service) FindFreeCIDR(opts FreeCIDROpts) (*gcorecloud.CIDR, error) {
+	return FindFreeCIDR(s.ServiceClient, opts)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id string) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// IDFromName calls the package level IDFromName function with the service client.
+func (s *Service) IDFromName(name string) (string, error) {
+	return IDFromName(s.ServiceClient, name)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(opts ListOptsBuilder) ([]Subnet, error) {
+	return ListAll(s.ServiceClient, opts)
+}
+
+// Update calls the package level Update function with the service client.
+func (s *Service) Update(subnetID string, opts UpdateOptsBuilder) UpdateResult {
+	return Update(s.ServiceClient, subnetID, opts)
+}
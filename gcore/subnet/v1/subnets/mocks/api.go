@@ -0,0 +1,145 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of subnets.API.
+package mocks
+
+import (
+	"sync"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/subnet/v1/subnets"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of subnets.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	CreateFunc           func(opts subnets.CreateOptsBuilder) tasks.Result
+	CreateInFreeCIDRFunc func(opts subnets.CreateOpts, free subnets.FreeCIDROpts) tasks.Result
+	DeleteFunc           func(subnetID string) tasks.Result
+	FindFreeCIDRFunc     func(opts subnets.FreeCIDROpts) (*gcorecloud.CIDR, error)
+	GetFunc              func(id string) subnets.GetResult
+	IDFromNameFunc       func(name string) (string, error)
+	ListFunc             func(opts subnets.ListOptsBuilder) pagination.Pager
+	ListAllFunc          func(opts subnets.ListOptsBuilder) ([]subnets.Subnet, error)
+	UpdateFunc           func(subnetID string, opts subnets.UpdateOptsBuilder) subnets.UpdateResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ subnets.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Create implements subnets.API.
+func (m *API) Create(opts subnets.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: subnets.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// CreateInFreeCIDR implements subnets.API.
+func (m *API) CreateInFreeCIDR(opts subnets.CreateOpts, free subnets.FreeCIDROpts) tasks.Result {
+	m.record("CreateInFreeCIDR", opts, free)
+	if m.CreateInFreeCIDRFunc == nil {
+		panic("mocks: subnets.API.CreateInFreeCIDRFunc is not set")
+	}
+	return m.CreateInFreeCIDRFunc(opts, free)
+}
+
+// Delete implements subnets.API.
+func (m *API) Delete(subnetID string) tasks.Result {
+	m.record("Delete", subnetID)
+	if m.DeleteFunc == nil {
+		panic("mocks: subnets.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(subnetID)
+}
+
+// FindFreeCIDR implements subnets.API.
+func (m *API) FindFreeCIDR(opts subnets.FreeCIDROpts) (*gcorecloud.CIDR, error) {
+	m.record("FindFreeCIDR", opts)
+	if m.FindFreeCIDRFunc == nil {
+		panic("mocks: subnets.API.FindFreeCIDRFunc is not set")
+	}
+	return m.FindFreeCIDRFunc(opts)
+}
+
+// Get implements subnets.API.
+func (m *API) Get(id string) subnets.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: subnets.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// IDFromName implements subnets.API.
+func (m *API) IDFromName(name string) (string, error) {
+	m.record("IDFromName", name)
+	if m.IDFromNameFunc == nil {
+		panic("mocks: subnets.API.IDFromNameFunc is not set")
+	}
+	return m.IDFromNameFunc(name)
+}
+
+// List implements subnets.API.
+func (m *API) List(opts subnets.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: subnets.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements subnets.API.
+func (m *API) ListAll(opts subnets.ListOptsBuilder) ([]subnets.Subnet, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: subnets.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// Update implements subnets.API.
+func (m *API) Update(subnetID string, opts subnets.UpdateOptsBuilder) subnets.UpdateResult {
+	m.record("Update", subnetID, opts)
+	if m.UpdateFunc == nil {
+		panic("mocks: subnets.API.UpdateFunc is not set")
+	}
+	return m.UpdateFunc(subnetID, opts)
+}
@@ -1,7 +1,11 @@
 package subnets
 
 import (
+	"errors"
+	"fmt"
+	"math/big"
 	"net"
+	"sort"
 
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
@@ -188,3 +192,127 @@ func IDFromName(client *gcorecloud.ServiceClient, name string) (string, error) {
 		return "", gcorecloud.ErrMultipleResourcesFound{Name: name, Count: count, ResourceType: "subnets"}
 	}
 }
+
+// ErrNoFreeCIDR is returned when a supernet has no free block of the requested size.
+var ErrNoFreeCIDR = errors.New("no free CIDR")
+
+// FreeCIDROpts selects a block of a supernet not overlapping the subnets of the project.
+type FreeCIDROpts struct {
+	// Supernet is the range the block is taken from, e.g. 10.0.0.0/8.
+	Supernet gcorecloud.CIDR `validate:"required"`
+	// PrefixLength is the prefix length of the block, e.g. 24.
+	PrefixLength int `validate:"required,gt=0,lte=128"`
+	// Reserved ranges are avoided as well, e.g. the PodsIPPool and ServicesIPPool of the k8s clusters.
+	Reserved []gcorecloud.CIDR
+}
+
+// Validate checks the free CIDR options.
+func (opts FreeCIDROpts) Validate() error {
+	if err := gcorecloud.ValidateStruct(opts); err != nil {
+		return err
+	}
+	ones, bits := opts.Supernet.Mask.Size()
+	if opts.PrefixLength < ones || opts.PrefixLength > bits {
+		return fmt.Errorf("prefix length %d is out of the range of supernet %s", opts.PrefixLength, opts.Supernet)
+	}
+	return nil
+}
+
+// FirstFreeCIDR returns the first block of the supernet with the prefix length not overlapping the used ranges.
+// Used ranges of the other IP family are ignored. ErrNoFreeCIDR is returned when the supernet is full.
+func FirstFreeCIDR(supernet net.IPNet, prefixLength int, used []net.IPNet) (*net.IPNet, error) {
+	ones, bits := supernet.Mask.Size()
+	if prefixLength < ones || prefixLength > bits {
+		return nil, fmt.Errorf("prefix length %d is out of the range of supernet %s", prefixLength, supernet.String())
+	}
+	first, last := addressRange(supernet)
+	size := new(big.Int).Lsh(big.NewInt(1), uint(bits-prefixLength))
+
+	type span struct{ first, last *big.Int }
+	var spans []span
+	for _, u := range used {
+		if _, uBits := u.Mask.Size(); uBits != bits {
+			continue
+		}
+		uFirst, uLast := addressRange(u)
+		spans = append(spans, span{uFirst, uLast})
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i].first.Cmp(spans[j].first) < 0 })
+
+	candidate := new(big.Int).Set(first)
+	for {
+		candidateLast := new(big.Int).Add(candidate, size)
+		candidateLast.Sub(candidateLast, big.NewInt(1))
+		if candidateLast.Cmp(last) > 0 {
+			return nil, ErrNoFreeCIDR
+		}
+		free := true
+		for _, s := range spans {
+			if s.first.Cmp(candidateLast) > 0 || s.last.Cmp(candidate) < 0 {
+				continue
+			}
+			// Move to the first aligned block after the overlapping range.
+			next := new(big.Int).Add(s.last, big.NewInt(1))
+			if rem := new(big.Int).Mod(next, size); rem.Sign() > 0 {
+				next.Add(next, new(big.Int).Sub(size, rem))
+			}
+			candidate = next
+			free = false
+			break
+		}
+		if free {
+			ip := make(net.IP, bits/8)
+			candidate.FillBytes(ip)
+			return &net.IPNet{IP: ip, Mask: net.CIDRMask(prefixLength, bits)}, nil
+		}
+	}
+}
+
+// addressRange returns the first and last addresses of the network as integers.
+func addressRange(n net.IPNet) (*big.Int, *big.Int) {
+	ip := n.IP.To4()
+	if ip == nil || len(n.Mask) == net.IPv6len {
+		ip = n.IP.To16()
+	}
+	ones, bits := n.Mask.Size()
+	first := new(big.Int).SetBytes(ip.Mask(net.CIDRMask(ones, bits)))
+	last := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+	last.Add(last, first).Sub(last, big.NewInt(1))
+	return first, last
+}
+
+// FindFreeCIDR returns the first block of the supernet not overlapping the subnets of the project or the reserved
+// ranges. ErrNoFreeCIDR is returned when the supernet is full.
+func FindFreeCIDR(c *gcorecloud.ServiceClient, opts FreeCIDROpts) (*gcorecloud.CIDR, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	all, err := ListAll(c, nil)
+	if err != nil {
+		return nil, err
+	}
+	used := make([]net.IPNet, 0, len(all)+len(opts.Reserved))
+	for _, s := range all {
+		used = append(used, s.CIDR.IPNet)
+	}
+	for _, r := range opts.Reserved {
+		used = append(used, r.IPNet)
+	}
+	block, err := FirstFreeCIDR(opts.Supernet.IPNet, opts.PrefixLength, used)
+	if err != nil {
+		return nil, fmt.Errorf("supernet %s, prefix length %d: %w", opts.Supernet, opts.PrefixLength, err)
+	}
+	return &gcorecloud.CIDR{IPNet: *block}, nil
+}
+
+// CreateInFreeCIDR creates a subnet in the first free block of the supernet, see FindFreeCIDR. The CIDR of the
+// options is ignored; the one used is the CIDR of the created subnet.
+func CreateInFreeCIDR(c *gcorecloud.ServiceClient, opts CreateOpts, free FreeCIDROpts) (r tasks.Result) {
+	cidr, err := FindFreeCIDR(c, free)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	opts.CIDR = *cidr
+	return Create(c, opts)
+}
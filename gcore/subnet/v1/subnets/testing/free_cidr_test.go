@@ -0,0 +1,94 @@
+package testing
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/network/v1/networks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/subnet/v1/subnets"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/testhelper/fakecloud"
+	"github.com/stretchr/testify/require"
+)
+
+func parseNets(t *testing.T, cidrs ...string) []net.IPNet {
+	var result []net.IPNet
+	for _, c := range cidrs {
+		_, n, err := net.ParseCIDR(c)
+		require.NoError(t, err)
+		result = append(result, *n)
+	}
+	return result
+}
+
+func TestFirstFreeCIDR(t *testing.T) {
+	cases := []struct {
+		supernet string
+		prefix   int
+		used     []string
+		expected string
+	}{
+		{"10.0.0.0/8", 24, nil, "10.0.0.0/24"},
+		{"10.0.0.0/8", 24, []string{"10.0.0.0/24", "10.0.1.0/24"}, "10.0.2.0/24"},
+		{"10.0.0.0/8", 24, []string{"10.0.1.0/24"}, "10.0.0.0/24"},
+		// A larger used range is skipped as a whole, a smaller one blocks the enclosing candidate.
+		{"10.0.0.0/8", 24, []string{"10.0.0.0/16"}, "10.1.0.0/24"},
+		{"10.0.0.0/8", 16, []string{"10.0.3.128/25"}, "10.1.0.0/16"},
+		// Used ranges out of the supernet or of the other family are ignored.
+		{"192.168.0.0/16", 24, []string{"10.0.0.0/8", "fd00::/8", "192.168.0.0/23"}, "192.168.2.0/24"},
+		{"192.168.10.0/24", 24, nil, "192.168.10.0/24"},
+		{"fd00::/48", 64, []string{"fd00::/64", "fd00:0:0:1::/64"}, "fd00:0:0:2::/64"},
+	}
+	for _, c := range cases {
+		supernet := parseNets(t, c.supernet)[0]
+		actual, err := subnets.FirstFreeCIDR(supernet, c.prefix, parseNets(t, c.used...))
+		require.NoError(t, err, c.supernet)
+		require.Equal(t, c.expected, actual.String(), "%s /%d %v", c.supernet, c.prefix, c.used)
+	}
+
+	supernet := parseNets(t, "192.168.0.0/23")[0]
+	_, err := subnets.FirstFreeCIDR(supernet, 24, parseNets(t, "192.168.0.0/24", "192.168.1.128/25"))
+	require.True(t, errors.Is(err, subnets.ErrNoFreeCIDR))
+	_, err = subnets.FirstFreeCIDR(supernet, 16, nil)
+	require.Error(t, err)
+	require.False(t, errors.Is(err, subnets.ErrNoFreeCIDR))
+}
+
+func TestCreateInFreeCIDR(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+	client := server.ServiceClient("subnets", "v1")
+	taskClient := server.ServiceClient("tasks", "v1")
+
+	results, err := networks.Create(server.ServiceClient("networks", "v1"), networks.CreateOpts{Name: "network"}).Extract()
+	require.NoError(t, err)
+	task, err := tasks.Get(taskClient, string(results.Tasks[0])).Extract()
+	require.NoError(t, err)
+	networkID, err := networks.ExtractNetworkIDFromTask(task)
+	require.NoError(t, err)
+
+	supernet, err := gcorecloud.ParseCIDRString("10.0.0.0/8")
+	require.NoError(t, err)
+	pods, err := gcorecloud.ParseCIDRString("10.0.1.0/24")
+	require.NoError(t, err)
+	free := subnets.FreeCIDROpts{Supernet: *supernet, PrefixLength: 24, Reserved: []gcorecloud.CIDR{*pods}}
+
+	var created []string
+	for i := 0; i < 2; i++ {
+		results, err := subnets.CreateInFreeCIDR(client, subnets.CreateOpts{Name: "subnet", NetworkID: networkID}, free).Extract()
+		require.NoError(t, err)
+		task, err := tasks.Get(taskClient, string(results.Tasks[0])).Extract()
+		require.NoError(t, err)
+		subnetID, err := subnets.ExtractSubnetIDFromTask(task)
+		require.NoError(t, err)
+		subnet, err := subnets.Get(client, subnetID).Extract()
+		require.NoError(t, err)
+		created = append(created, subnet.CIDR.String())
+	}
+	require.Equal(t, []string{"10.0.0.0/24", "10.0.2.0/24"}, created)
+
+	_, err = subnets.FindFreeCIDR(client, subnets.FreeCIDROpts{Supernet: *supernet, PrefixLength: 4})
+	require.Error(t, err)
+}
@@ -0,0 +1,61 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package tasks
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of tasks operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Get(id string) GetResult
+	List() pagination.Pager
+	WaitForStatus(id string, status TaskState, secs int, stopOnTaskError bool) error
+	WaitForTask(id string, opts gcorecloud.WaitOpts) (*Task, error)
+	WaitTaskAndProcessResult(task TaskID, stopOnTaskError bool, waitSeconds int, taskProcessor CheckTaskResult) error
+	WaitTaskAndReturnResult(task TaskID, stopOnTaskError bool, waitSeconds int, taskProcessor RetrieveTaskResult) (interface{}, error)
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id string) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List() pagination.Pager {
+	return List(s.ServiceClient)
+}
+
+// WaitForStatus calls the package level WaitForStatus function with the service client.
+func (s *Service) WaitForStatus(id string, status TaskState, secs int, stopOnTaskError bool) error {
+	return WaitForStatus(s.ServiceClient, id, status, secs, stopOnTaskError)
+}
+
+// WaitForTask calls the package level WaitForTask function with the service client.
+func (s *Service) WaitForTask(id string, opts gcorecloud.WaitOpts) (*Task, error) {
+	return WaitForTask(s.ServiceClient, id, opts)
+}
+
+// WaitTaskAndProcessResult calls the package level WaitTaskAndProcessResult function with the service client.
+func (s *Service) WaitTaskAndProcessResult(task TaskID, stopOnTaskError bool, waitSeconds int, taskProcessor CheckTaskResult) error {
+	return WaitTaskAndProcessResult(s.ServiceClient, task, stopOnTaskError, waitSeconds, taskProcessor)
+}
+
+// WaitTaskAndReturnResult calls the package level WaitTaskAndReturnResult function with the service client.
+func (s *Service) WaitTaskAndReturnResult(task TaskID, stopOnTaskError bool, waitSeconds int, taskProcessor RetrieveTaskResult) (interface{}, error) {
+	return WaitTaskAndReturnResult(s.ServiceClient, task, stopOnTaskError, waitSeconds, taskProcessor)
+}
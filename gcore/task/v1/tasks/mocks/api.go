@@ -0,0 +1,114 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of tasks.API.
+package mocks
+
+import (
+	"sync"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of tasks.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	GetFunc                      func(id string) tasks.GetResult
+	ListFunc                     func() pagination.Pager
+	WaitForStatusFunc            func(id string, status tasks.TaskState, secs int, stopOnTaskError bool) error
+	WaitForTaskFunc              func(id string, opts gcorecloud.WaitOpts) (*tasks.Task, error)
+	WaitTaskAndProcessResultFunc func(task tasks.TaskID, stopOnTaskError bool, waitSeconds int, taskProcessor tasks.CheckTaskResult) error
+	WaitTaskAndReturnResultFunc  func(task tasks.TaskID, stopOnTaskError bool, waitSeconds int, taskProcessor tasks.RetrieveTaskResult) (interface{}, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ tasks.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Get implements tasks.API.
+func (m *API) Get(id string) tasks.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: tasks.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// List implements tasks.API.
+func (m *API) List() pagination.Pager {
+	m.record("List")
+	if m.ListFunc == nil {
+		panic("mocks: tasks.API.ListFunc is not set")
+	}
+	return m.ListFunc()
+}
+
+// WaitForStatus implements tasks.API.
+func (m *API) WaitForStatus(id string, status tasks.TaskState, secs int, stopOnTaskError bool) error {
+	m.record("WaitForStatus", id, status, secs, stopOnTaskError)
+	if m.WaitForStatusFunc == nil {
+		panic("mocks: tasks.API.WaitForStatusFunc is not set")
+	}
+	return m.WaitForStatusFunc(id, status, secs, stopOnTaskError)
+}
+
+// WaitForTask implements tasks.API.
+func (m *API) WaitForTask(id string, opts gcorecloud.WaitOpts) (*tasks.Task, error) {
+	m.record("WaitForTask", id, opts)
+	if m.WaitForTaskFunc == nil {
+		panic("mocks: tasks.API.WaitForTaskFunc is not set")
+	}
+	return m.WaitForTaskFunc(id, opts)
+}
+
+// WaitTaskAndProcessResult implements tasks.API.
+func (m *API) WaitTaskAndProcessResult(task tasks.TaskID, stopOnTaskError bool, waitSeconds int, taskProcessor tasks.CheckTaskResult) error {
+	m.record("WaitTaskAndProcessResult", task, stopOnTaskError, waitSeconds, taskProcessor)
+	if m.WaitTaskAndProcessResultFunc == nil {
+		panic("mocks: tasks.API.WaitTaskAndProcessResultFunc is not set")
+	}
+	return m.WaitTaskAndProcessResultFunc(task, stopOnTaskError, waitSeconds, taskProcessor)
+}
+
+// WaitTaskAndReturnResult implements tasks.API.
+func (m *API) WaitTaskAndReturnResult(task tasks.TaskID, stopOnTaskError bool, waitSeconds int, taskProcessor tasks.RetrieveTaskResult) (interface{}, error) {
+	m.record("WaitTaskAndReturnResult", task, stopOnTaskError, waitSeconds, taskProcessor)
+	if m.WaitTaskAndReturnResultFunc == nil {
+		panic("mocks: tasks.API.WaitTaskAndReturnResultFunc is not set")
+	}
+	return m.WaitTaskAndReturnResultFunc(task, stopOnTaskError, waitSeconds, taskProcessor)
+}
@@ -1,10 +1,13 @@
 package testing
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
 	fake "github.com/G-Core/gcorelabscloud-go/testhelper/client"
 
@@ -123,3 +126,83 @@ func TestWaitTask(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestWaitTaskSpan(t *testing.T) {
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(prepareGetTestURL(Task1.ID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		_, err := fmt.Fprint(w, FinishedTaskResponse)
+		if err != nil {
+			log.Error(err)
+		}
+	})
+
+	tracer := new(th.InMemoryTracer)
+	client := fake.ServiceTokenClient("tasks", "v1")
+	client.Tracer = tracer
+
+	err := tasks.WaitForStatus(client, Task1.ID, tasks.TaskStateFinished, 600, true)
+	require.NoError(t, err)
+
+	waitSpans := tracer.SpansByName(gcorecloud.SpanNameTaskWait)
+	require.Len(t, waitSpans, 1)
+	require.True(t, waitSpans[0].Ended)
+	require.Equal(t, Task1.ID, waitSpans[0].Attributes[gcorecloud.SpanAttributeTaskID])
+	require.Equal(t, "tasks", waitSpans[0].Attributes[gcorecloud.SpanAttributeResourceType])
+
+	requestSpans := tracer.SpansByName(gcorecloud.SpanNameRequest)
+	require.Len(t, requestSpans, 1)
+	require.Equal(t, waitSpans[0].Context, requestSpans[0].Parent)
+}
+
+func TestWaitForTask(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	responses := []string{GetResponse, FinishedTaskResponse}
+	calls := 0
+	th.Mux.HandleFunc(prepareGetTestURL(Task1.ID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprint(w, responses[calls])
+		if err != nil {
+			log.Error(err)
+		}
+		calls++
+	})
+
+	client := fake.ServiceTokenClient("tasks", "v1")
+	task, err := tasks.WaitForTask(client, Task1.ID, gcorecloud.WaitOpts{Interval: time.Millisecond})
+	require.NoError(t, err)
+	require.Equal(t, tasks.TaskStateFinished, task.State)
+	require.Equal(t, 2, calls)
+}
+
+func TestWaitForTaskError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(prepareGetTestURL(Task1.ID), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprintf(w, `{"id": "%s", "state": "ERROR", "error": "no capacity", "created_on": "2020-01-24T13:57:12"}`, Task1.ID)
+		if err != nil {
+			log.Error(err)
+		}
+	})
+
+	client := fake.ServiceTokenClient("tasks", "v1")
+	task, err := tasks.WaitForTask(client, Task1.ID, gcorecloud.WaitOpts{Interval: time.Millisecond})
+	require.True(t, errors.Is(err, gcorecloud.ErrResourceErrorState))
+	require.Contains(t, err.Error(), "no capacity")
+	require.Equal(t, tasks.TaskStateError, task.State)
+}
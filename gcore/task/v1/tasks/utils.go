@@ -9,7 +9,12 @@ import (
 // WaitForStatus will continually poll the task resource, checking for a particular
 // status. It will do this for the amount of seconds defined.
 func WaitForStatus(client *gcorecloud.ServiceClient, id string, status TaskState, secs int, stopOnTaskError bool) error {
-	return gcorecloud.WaitFor(secs, func() (bool, error) {
+	ctx, span := client.StartSpan(gcorecloud.SpanNameTaskWait)
+	defer span.End()
+	span.SetAttribute(gcorecloud.SpanAttributeTaskID, id)
+	client = client.WithContext(ctx)
+
+	err := gcorecloud.WaitFor(secs, func() (bool, error) {
 		task, err := Get(client, id).Extract()
 		if err != nil {
 			return false, err
@@ -33,6 +38,10 @@ func WaitForStatus(client *gcorecloud.ServiceClient, id string, status TaskState
 
 		return false, nil
 	})
+	if err != nil {
+		span.RecordError(err)
+	}
+	return err
 }
 
 // WaitTaskAndProcessResult periodically check status state and invoke taskProcessor when when task is finished
@@ -67,3 +76,31 @@ func WaitTaskAndReturnResult(
 
 type RetrieveTaskResult func(task TaskID) (interface{}, error)
 type CheckTaskResult func(task TaskID) error
+
+// WaitForTask polls the task with backoff until it is finished and returns it. It fails fast when the task enters the
+// ERROR state. The wait is cancelled with the context of the client.
+func WaitForTask(client *gcorecloud.ServiceClient, id string, opts gcorecloud.WaitOpts) (*Task, error) {
+	var task *Task
+	err := client.WaitForResource(id, opts, func(client *gcorecloud.ServiceClient) (bool, error) {
+		current, err := Get(client, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		task = current
+		switch task.State {
+		case TaskStateFinished:
+			return true, nil
+		case TaskStateError:
+			errorText := ""
+			if task.Error != nil {
+				errorText = *task.Error
+			}
+			return false, fmt.Errorf("%w: %s", gcorecloud.ErrResourceErrorState, errorText)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return task, fmt.Errorf("waiting for task %s: %w", id, err)
+	}
+	return task, nil
+}
@@ -0,0 +1,42 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package users
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+)
+
+// API is the set of users operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	AssignUser(opts UserAssignmentOptsBuilder) UserAssignmentResult
+	CreateApiToken(opts CreateApiTokenOptsBuilder) CreateApiTokenResult
+	CreateUser(opts CreateUserOptsBuilder) CreateUserResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// AssignUser calls the package level AssignUser function with the service client.
+func (s *Service) AssignUser(opts UserAssignmentOptsBuilder) UserAssignmentResult {
+	return AssignUser(s.ServiceClient, opts)
+}
+
+// CreateApiToken calls the package level CreateApiToken function with the service client.
+func (s *Service) CreateApiToken(opts CreateApiTokenOptsBuilder) CreateApiTokenResult {
+	return CreateApiToken(s.ServiceClient, opts)
+}
+
+// CreateUser calls the package level CreateUser function with the service client.
+func (s *Service) CreateUser(opts CreateUserOptsBuilder) CreateUserResult {
+	return CreateUser(s.ServiceClient, opts)
+}
@@ -0,0 +1,82 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of users.API.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/users/v1/users"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of users.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	AssignUserFunc     func(opts users.UserAssignmentOptsBuilder) users.UserAssignmentResult
+	CreateApiTokenFunc func(opts users.CreateApiTokenOptsBuilder) users.CreateApiTokenResult
+	CreateUserFunc     func(opts users.CreateUserOptsBuilder) users.CreateUserResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ users.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// AssignUser implements users.API.
+func (m *API) AssignUser(opts users.UserAssignmentOptsBuilder) users.UserAssignmentResult {
+	m.record("AssignUser", opts)
+	if m.AssignUserFunc == nil {
+		panic("mocks: users.API.AssignUserFunc is not set")
+	}
+	return m.AssignUserFunc(opts)
+}
+
+// CreateApiToken implements users.API.
+func (m *API) CreateApiToken(opts users.CreateApiTokenOptsBuilder) users.CreateApiTokenResult {
+	m.record("CreateApiToken", opts)
+	if m.CreateApiTokenFunc == nil {
+		panic("mocks: users.API.CreateApiTokenFunc is not set")
+	}
+	return m.CreateApiTokenFunc(opts)
+}
+
+// CreateUser implements users.API.
+func (m *API) CreateUser(opts users.CreateUserOptsBuilder) users.CreateUserResult {
+	m.record("CreateUser", opts)
+	if m.CreateUserFunc == nil {
+		panic("mocks: users.API.CreateUserFunc is not set")
+	}
+	return m.CreateUserFunc(opts)
+}
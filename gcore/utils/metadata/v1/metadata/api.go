@@ -0,0 +1,62 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package metadata
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	metadata2 "github.com/G-Core/gcorelabscloud-go/gcore/utils/metadata"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of metadata operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	MetadataCreateOrUpdate(id string, opts map[string]string) metadata2.MetadataActionResult
+	MetadataDelete(id string, key string) metadata2.MetadataActionResult
+	MetadataGet(id string, key string) metadata2.MetadataResult
+	MetadataList(id string) pagination.Pager
+	MetadataListAll(id string) ([]metadata2.Metadata, error)
+	MetadataReplace(id string, opts map[string]string) metadata2.MetadataActionResult
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// MetadataCreateOrUpdate calls the package level MetadataCreateOrUpdate function with the service client.
+func (s *Service) MetadataCreateOrUpdate(id string, opts map[string]string) metadata2.MetadataActionResult {
+	return MetadataCreateOrUpdate(s.ServiceClient, id, opts)
+}
+
+// MetadataDelete calls the package level MetadataDelete function with the service client.
+func (s *Service) MetadataDelete(id string, key string) metadata2.MetadataActionResult {
+	return MetadataDelete(s.ServiceClient, id, key)
+}
+
+// MetadataGet calls the package level MetadataGet function with the service client.
+func (s *Service) MetadataGet(id string, key string) metadata2.MetadataResult {
+	return MetadataGet(s.ServiceClient, id, key)
+}
+
+// MetadataList calls the package level MetadataList function with the service client.
+func (s *Service) MetadataList(id string) pagination.Pager {
+	return MetadataList(s.ServiceClient, id)
+}
+
+// MetadataListAll calls the package level MetadataListAll function with the service client.
+func (s *Service) MetadataListAll(id string) ([]metadata2.Metadata, error) {
+	return MetadataListAll(s.ServiceClient, id)
+}
+
+// MetadataReplace calls the package level MetadataReplace function with the service client.
+func (s *Service) MetadataReplace(id string, opts map[string]string) metadata2.MetadataActionResult {
+	return MetadataReplace(s.ServiceClient, id, opts)
+}
@@ -0,0 +1,114 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of metadata.API.
+package mocks
+
+import (
+	"sync"
+
+	metadata2 "github.com/G-Core/gcorelabscloud-go/gcore/utils/metadata"
+	"github.com/G-Core/gcorelabscloud-go/gcore/utils/metadata/v1/metadata"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of metadata.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	MetadataCreateOrUpdateFunc func(id string, opts map[string]string) metadata2.MetadataActionResult
+	MetadataDeleteFunc         func(id string, key string) metadata2.MetadataActionResult
+	MetadataGetFunc            func(id string, key string) metadata2.MetadataResult
+	MetadataListFunc           func(id string) pagination.Pager
+	MetadataListAllFunc        func(id string) ([]metadata2.Metadata, error)
+	MetadataReplaceFunc        func(id string, opts map[string]string) metadata2.MetadataActionResult
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ metadata.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// MetadataCreateOrUpdate implements metadata.API.
+func (m *API) MetadataCreateOrUpdate(id string, opts map[string]string) metadata2.MetadataActionResult {
+	m.record("MetadataCreateOrUpdate", id, opts)
+	if m.MetadataCreateOrUpdateFunc == nil {
+		panic("mocks: metadata.API.MetadataCreateOrUpdateFunc is not set")
+	}
+	return m.MetadataCreateOrUpdateFunc(id, opts)
+}
+
+// MetadataDelete implements metadata.API.
+func (m *API) MetadataDelete(id string, key string) metadata2.MetadataActionResult {
+	m.record("MetadataDelete", id, key)
+	if m.MetadataDeleteFunc == nil {
+		panic("mocks: metadata.API.MetadataDeleteFunc is not set")
+	}
+	return m.MetadataDeleteFunc(id, key)
+}
+
+// MetadataGet implements metadata.API.
+func (m *API) MetadataGet(id string, key string) metadata2.MetadataResult {
+	m.record("MetadataGet", id, key)
+	if m.MetadataGetFunc == nil {
+		panic("mocks: metadata.API.MetadataGetFunc is not set")
+	}
+	return m.MetadataGetFunc(id, key)
+}
+
+// MetadataList implements metadata.API.
+func (m *API) MetadataList(id string) pagination.Pager {
+	m.record("MetadataList", id)
+	if m.MetadataListFunc == nil {
+		panic("mocks: metadata.API.MetadataListFunc is not set")
+	}
+	return m.MetadataListFunc(id)
+}
+
+// MetadataListAll implements metadata.API.
+func (m *API) MetadataListAll(id string) ([]metadata2.Metadata, error) {
+	m.record("MetadataListAll", id)
+	if m.MetadataListAllFunc == nil {
+		panic("mocks: metadata.API.MetadataListAllFunc is not set")
+	}
+	return m.MetadataListAllFunc(id)
+}
+
+// MetadataReplace implements metadata.API.
+func (m *API) MetadataReplace(id string, opts map[string]string) metadata2.MetadataActionResult {
+	m.record("MetadataReplace", id, opts)
+	if m.MetadataReplaceFunc == nil {
+		panic("mocks: metadata.API.MetadataReplaceFunc is not set")
+	}
+	return m.MetadataReplaceFunc(id, opts)
+}
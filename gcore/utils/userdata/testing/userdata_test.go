@@ -0,0 +1,136 @@
+package testing
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/utils/userdata"
+	"github.com/stretchr/testify/require"
+)
+
+type part struct {
+	contentType string
+	filename    string
+	content     string
+}
+
+func parseMultipart(t *testing.T, payload []byte) []part {
+	msg, err := mail.ReadMessage(bytes.NewReader(payload))
+	require.NoError(t, err)
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/mixed", mediaType)
+	require.Equal(t, "1.0", msg.Header.Get("MIME-Version"))
+
+	var parts []part
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		p, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(p)
+		require.NoError(t, err)
+		contentType, _, err := mime.ParseMediaType(p.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		parts = append(parts, part{contentType: contentType, filename: p.FileName(), content: string(content)})
+	}
+	return parts
+}
+
+func TestBuild(t *testing.T) {
+	encoded, err := userdata.New().
+		CloudConfig("packages:\n  - nginx\n").
+		ShellScript("start.sh", "#!/bin/sh\nsystemctl start nginx\n").
+		Boothook("hook.sh", "#cloud-boothook\necho boot\n").
+		Build()
+	require.NoError(t, err)
+
+	payload, err := base64.StdEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+	parts := parseMultipart(t, payload)
+	require.Equal(t, []part{
+		{contentType: "text/cloud-config", filename: "cloud-config-1.yaml", content: "#cloud-config\npackages:\n  - nginx\n"},
+		{contentType: "text/x-shellscript", filename: "start.sh", content: "#!/bin/sh\nsystemctl start nginx\n"},
+		{contentType: "text/cloud-boothook", filename: "hook.sh", content: "#cloud-boothook\necho boot\n"},
+	}, parts)
+}
+
+func TestBuildGzip(t *testing.T) {
+	builder := userdata.New().CloudConfig("#cloud-config\nruncmd:\n  - echo ok\n")
+	plain, err := builder.Render()
+	require.NoError(t, err)
+
+	encoded, err := builder.Gzip(true).Build()
+	require.NoError(t, err)
+	payload, err := base64.StdEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+	reader, err := gzip.NewReader(bytes.NewReader(payload))
+	require.NoError(t, err)
+	uncompressed, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, plain, uncompressed)
+}
+
+func TestValidation(t *testing.T) {
+	_, err := userdata.New().Build()
+	require.True(t, errors.Is(err, userdata.ErrEmpty))
+
+	_, err = userdata.New().CloudConfig("packages: [nginx").Build()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid cloud-config")
+
+	_, err = userdata.New().CloudConfig("- not\n- a mapping\n").Build()
+	require.Error(t, err)
+
+	_, err = userdata.New().ShellScript("start.sh", "echo no shebang").Build()
+	require.Error(t, err)
+
+	_, err = userdata.New().Add(userdata.Part{Type: "text/plain", Content: "text"}).Build()
+	require.Error(t, err)
+}
+
+func TestSizeLimit(t *testing.T) {
+	script := "#!/bin/sh\n" + strings.Repeat("echo 0123456789abcdef\n", 4000)
+
+	_, err := userdata.New().ShellScript("big.sh", script).Build()
+	require.True(t, errors.Is(err, userdata.ErrTooLarge))
+
+	_, err = userdata.New().ShellScript("big.sh", script).Gzip(true).Build()
+	require.NoError(t, err)
+
+	_, err = userdata.New().ShellScript("big.sh", script).MaxSize(0).Build()
+	require.NoError(t, err)
+}
+
+func TestFile(t *testing.T) {
+	dir := t.TempDir()
+	config := filepath.Join(dir, "config.yaml")
+	script := filepath.Join(dir, "setup.sh")
+	unknown := filepath.Join(dir, "notes.txt")
+	require.NoError(t, os.WriteFile(config, []byte("#cloud-config\nhostname: test\n"), 0o600))
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/bash\necho setup\n"), 0o600))
+	require.NoError(t, os.WriteFile(unknown, []byte("notes\n"), 0o600))
+
+	builder := userdata.New().File(config).File(script)
+	require.NoError(t, builder.Validate())
+	parts := builder.Parts()
+	require.Len(t, parts, 2)
+	require.Equal(t, userdata.CloudConfig, parts[0].Type)
+	require.Equal(t, "config.yaml", parts[0].Filename)
+	require.Equal(t, userdata.ShellScript, parts[1].Type)
+
+	require.Error(t, userdata.New().File(unknown).Validate())
+	require.Error(t, userdata.New().File(filepath.Join(dir, "missing")).Validate())
+}
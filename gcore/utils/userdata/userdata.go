@@ -0,0 +1,269 @@
+/*
+Package userdata builds cloud-init user data for instances and baremetal servers.
+
+A Builder composes cloud-config documents, shell scripts and boothooks into a multi-part MIME payload, validates the
+cloud-config YAML, optionally gzips the payload and returns it base64 encoded within the size limit of the platform.
+
+Example of passing user data to an instance
+
+	builder := userdata.New().
+		CloudConfig("packages:\n  - nginx\n").
+		ShellScript("start.sh", "#!/bin/sh\nsystemctl start nginx\n").
+		Gzip(true)
+
+	opts := instances.CreateOpts{
+		...
+		UserDataBuilder: builder,
+	}
+*/
+package userdata
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v2"
+)
+
+// MaxSize is the maximum size of base64 encoded user data accepted by the platform.
+const MaxSize = 65535
+
+// PartType is the MIME type of a user data part.
+type PartType string
+
+const (
+	CloudConfig PartType = "text/cloud-config"
+	ShellScript PartType = "text/x-shellscript"
+	Boothook    PartType = "text/cloud-boothook"
+)
+
+const (
+	cloudConfigHeader = "#cloud-config"
+	boothookHeader    = "#cloud-boothook"
+	shebang           = "#!"
+	boundary          = "==GCORE-USERDATA-BOUNDARY=="
+)
+
+var (
+	// ErrEmpty is returned when the builder has no parts.
+	ErrEmpty = errors.New("user data has no parts")
+	// ErrTooLarge is returned when the encoded user data exceeds the size limit.
+	ErrTooLarge = errors.New("user data is too large")
+)
+
+// Part is a user data part.
+type Part struct {
+	Type     PartType
+	Filename string
+	Content  string
+}
+
+// Validate checks the part content against its type.
+func (p Part) Validate() error {
+	switch p.Type {
+	case CloudConfig:
+		var doc map[string]interface{}
+		if err := yaml.Unmarshal([]byte(p.Content), &doc); err != nil {
+			return fmt.Errorf("%s: invalid cloud-config: %w", p.Filename, err)
+		}
+		if len(doc) == 0 {
+			return fmt.Errorf("%s: cloud-config is empty", p.Filename)
+		}
+	case ShellScript:
+		if !strings.HasPrefix(p.Content, shebang) {
+			return fmt.Errorf("%s: shell script should start with %s", p.Filename, shebang)
+		}
+	case Boothook:
+		if strings.TrimSpace(p.Content) == "" {
+			return fmt.Errorf("%s: boothook is empty", p.Filename)
+		}
+	default:
+		return fmt.Errorf("%s: unsupported part type %q", p.Filename, p.Type)
+	}
+	if strings.Contains(p.Content, boundary) {
+		return fmt.Errorf("%s: content contains the MIME boundary", p.Filename)
+	}
+	return nil
+}
+
+// DetectType returns the part type from the first line of the content.
+func DetectType(content string) (PartType, error) {
+	switch {
+	case strings.HasPrefix(content, cloudConfigHeader):
+		return CloudConfig, nil
+	case strings.HasPrefix(content, boothookHeader):
+		return Boothook, nil
+	case strings.HasPrefix(content, shebang):
+		return ShellScript, nil
+	}
+	return "", fmt.Errorf("cannot detect user data part type, content should start with %s, %s or %s",
+		cloudConfigHeader, boothookHeader, shebang)
+}
+
+// Builder composes a cloud-init user data payload. Its methods can be chained, errors are reported by Build.
+type Builder struct {
+	parts   []Part
+	gzip    bool
+	maxSize int
+	err     error
+}
+
+// New returns an empty builder with the platform size limit.
+func New() *Builder {
+	return &Builder{maxSize: MaxSize}
+}
+
+// Add adds a part.
+func (b *Builder) Add(part Part) *Builder {
+	if part.Filename == "" {
+		part.Filename = fmt.Sprintf("part-%d", len(b.parts)+1)
+	}
+	b.parts = append(b.parts, part)
+	return b
+}
+
+// CloudConfig adds a cloud-config YAML document. The #cloud-config header is optional.
+func (b *Builder) CloudConfig(content string) *Builder {
+	if !strings.HasPrefix(content, cloudConfigHeader) {
+		content = cloudConfigHeader + "\n" + content
+	}
+	return b.Add(Part{Type: CloudConfig, Filename: fmt.Sprintf("cloud-config-%d.yaml", len(b.parts)+1), Content: content})
+}
+
+// ShellScript adds a shell script run once on the first boot.
+func (b *Builder) ShellScript(filename, content string) *Builder {
+	return b.Add(Part{Type: ShellScript, Filename: filename, Content: content})
+}
+
+// Boothook adds a boothook run on every boot, early in the boot process.
+func (b *Builder) Boothook(filename, content string) *Builder {
+	return b.Add(Part{Type: Boothook, Filename: filename, Content: content})
+}
+
+// File adds a part read from a file, its type is detected from the first line of the content.
+func (b *Builder) File(path string) *Builder {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		b.err = err
+		return b
+	}
+	partType, err := DetectType(string(content))
+	if err != nil {
+		b.err = fmt.Errorf("%s: %w", path, err)
+		return b
+	}
+	return b.Add(Part{Type: partType, Filename: filepath.Base(path), Content: string(content)})
+}
+
+// Gzip enables the compression of the payload, which cloud-init detects automatically.
+func (b *Builder) Gzip(enabled bool) *Builder {
+	b.gzip = enabled
+	return b
+}
+
+// MaxSize sets the maximum size of the encoded payload. Zero disables the limit.
+func (b *Builder) MaxSize(size int) *Builder {
+	b.maxSize = size
+	return b
+}
+
+// Parts returns the parts added so far.
+func (b *Builder) Parts() []Part {
+	parts := make([]Part, len(b.parts))
+	copy(parts, b.parts)
+	return parts
+}
+
+// Validate checks the parts.
+func (b *Builder) Validate() error {
+	if b.err != nil {
+		return b.err
+	}
+	if len(b.parts) == 0 {
+		return ErrEmpty
+	}
+	for _, p := range b.parts {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Render returns the multi-part MIME payload, gzipped if enabled.
+func (b *Builder) Render() ([]byte, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=\"%s\"\r\nMIME-Version: 1.0\r\n\r\n", boundary)
+	w := multipart.NewWriter(&buf)
+	if err := w.SetBoundary(boundary); err != nil {
+		return nil, err
+	}
+	for _, p := range b.parts {
+		encoding := "7bit"
+		if !isASCII(p.Content) {
+			encoding = "8bit"
+		}
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Type", fmt.Sprintf("%s; charset=\"utf-8\"", p.Type))
+		header.Set("MIME-Version", "1.0")
+		header.Set("Content-Transfer-Encoding", encoding)
+		header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", p.Filename))
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := pw.Write([]byte(p.Content)); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	if !b.gzip {
+		return buf.Bytes(), nil
+	}
+
+	var compressed bytes.Buffer
+	gw := gzip.NewWriter(&compressed)
+	if _, err := gw.Write(buf.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	return compressed.Bytes(), nil
+}
+
+// Build returns the base64 encoded payload, as expected by the user_data field of the create requests.
+func (b *Builder) Build() (string, error) {
+	payload, err := b.Render()
+	if err != nil {
+		return "", err
+	}
+	encoded := base64.StdEncoding.EncodeToString(payload)
+	if b.maxSize > 0 && len(encoded) > b.maxSize {
+		return "", fmt.Errorf("%w: %d bytes encoded, the limit is %d", ErrTooLarge, len(encoded), b.maxSize)
+	}
+	return encoded, nil
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package volumes
+
+import (
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// API is the set of volumes operations. It allows consumers to depend on an interface and to use
+// mocks.API in their tests. See the package level functions for the documentation of each method.
+type API interface {
+	Attach(volumeID string, opts InstanceOperationOptsBuilder) UpdateResult
+	Create(opts CreateOptsBuilder) tasks.Result
+	Delete(volumeID string, opts DeleteOptsBuilder) tasks.Result
+	Detach(volumeID string, opts InstanceOperationOptsBuilder) UpdateResult
+	Extend(volumeID string, opts PropertiesOperationOptsBuilder) tasks.Result
+	Get(id string) GetResult
+	IDFromName(name string) (string, error)
+	List(opts ListOptsBuilder) pagination.Pager
+	ListAll(opts ListOptsBuilder) ([]Volume, error)
+	Retype(volumeID string, opts PropertiesOperationOptsBuilder) UpdateResult
+	Revert(volumeID string) tasks.Result
+	Update(volumeID string, opts UpdateOptsBuilder) UpdateResult
+	WaitForStatus(volumeID string, status VolumeStatus, opts gcorecloud.WaitOpts) (*Volume, error)
+}
+
+// Service implements API by calling the package level functions with a service client.
+type Service struct {
+	ServiceClient *gcorecloud.ServiceClient
+}
+
+// NewService returns an API backed by the service client.
+func NewService(client *gcorecloud.ServiceClient) *Service {
+	return &Service{ServiceClient: client}
+}
+
+var _ API = (*Service)(nil)
+
+// Attach calls the package level Attach function with the service client.
+func (s *Service) Attach(volumeID string, opts InstanceOperationOptsBuilder) UpdateResult {
+	return Attach(s.ServiceClient, volumeID, opts)
+}
+
+// Create calls the package level Create function with the service client.
+func (s *Service) Create(opts CreateOptsBuilder) tasks.Result {
+	return Create(s.ServiceClient, opts)
+}
+
+// Delete calls the package level Delete function with the service client.
+func (s *Service) Delete(volumeID string, opts DeleteOptsBuilder) tasks.Result {
+	return Delete(s.ServiceClient, volumeID, opts)
+}
+
+// Detach calls the package level Detach function with the service client.
+func (s *Service) Detach(volumeID string, opts InstanceOperationOptsBuilder) UpdateResult {
+	return Detach(s.ServiceClient, volumeID, opts)
+}
+
+// Extend calls the package level Extend function with the service client.
+func (s *Service) Extend(volumeID string, opts PropertiesOperationOptsBuilder) tasks.Result {
+	return Extend(s.ServiceClient, volumeID, opts)
+}
+
+// Get calls the package level Get function with the service client.
+func (s *Service) Get(id string) GetResult {
+	return Get(s.ServiceClient, id)
+}
+
+// IDFromName calls the package level IDFromName function with the service client.
+func (s *Service) IDFromName(name string) (string, error) {
+	return IDFromName(s.ServiceClient, name)
+}
+
+// List calls the package level List function with the service client.
+func (s *Service) List(opts ListOptsBuilder) pagination.Pager {
+	return List(s.ServiceClient, opts)
+}
+
+// ListAll calls the package level ListAll function with the service client.
+func (s *Service) ListAll(opts ListOptsBuilder) ([]Volume, error) {
+	return ListAll(s.ServiceClient, opts)
+}
+
+// Retype calls the package level Retype function with the service client.
+func (s *Service) Retype(volumeID string, opts PropertiesOperationOptsBuilder) UpdateResult {
+	return Retype(s.ServiceClient, volumeID, opts)
+}
+
+// Revert calls the package level Revert function with the service client.
+func (s *Service) Revert(volumeID string) tasks.Result {
+	return Revert(s.ServiceClient, volumeID)
+}
+
+// Update calls the package level Update function with the service client.
+func (s *Service) Update(volumeID string, opts UpdateOptsBuilder) UpdateResult {
+	return Update(s.ServiceClient, volumeID, opts)
+}
+
+// WaitForStatus calls the package level WaitForStatus function with the service client.
+func (s *Service) WaitForStatus(volumeID string, status VolumeStatus, opts gcorecloud.WaitOpts) (*Volume, error) {
+	return WaitForStatus(s.ServiceClient, volumeID, status, opts)
+}
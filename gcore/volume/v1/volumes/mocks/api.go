@@ -0,0 +1,185 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mocks provides a mock of volumes.API.
+package mocks
+
+import (
+	"sync"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/volume/v1/volumes"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+)
+
+// Call is a call recorded by API.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API is a mock of volumes.API. Each method calls the function of the matching field, which must be set
+// by the test, and records the call.
+type API struct {
+	AttachFunc        func(volumeID string, opts volumes.InstanceOperationOptsBuilder) volumes.UpdateResult
+	CreateFunc        func(opts volumes.CreateOptsBuilder) tasks.Result
+	DeleteFunc        func(volumeID string, opts volumes.DeleteOptsBuilder) tasks.Result
+	DetachFunc        func(volumeID string, opts volumes.InstanceOperationOptsBuilder) volumes.UpdateResult
+	ExtendFunc        func(volumeID string, opts volumes.PropertiesOperationOptsBuilder) tasks.Result
+	GetFunc           func(id string) volumes.GetResult
+	IDFromNameFunc    func(name string) (string, error)
+	ListFunc          func(opts volumes.ListOptsBuilder) pagination.Pager
+	ListAllFunc       func(opts volumes.ListOptsBuilder) ([]volumes.Volume, error)
+	RetypeFunc        func(volumeID string, opts volumes.PropertiesOperationOptsBuilder) volumes.UpdateResult
+	RevertFunc        func(volumeID string) tasks.Result
+	UpdateFunc        func(volumeID string, opts volumes.UpdateOptsBuilder) volumes.UpdateResult
+	WaitForStatusFunc func(volumeID string, status volumes.VolumeStatus, opts gcorecloud.WaitOpts) (*volumes.Volume, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ volumes.API = (*API)(nil)
+
+// Calls returns the recorded calls in order.
+func (m *API) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]Call, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method.
+func (m *API) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func (m *API) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Attach implements volumes.API.
+func (m *API) Attach(volumeID string, opts volumes.InstanceOperationOptsBuilder) volumes.UpdateResult {
+	m.record("Attach", volumeID, opts)
+	if m.AttachFunc == nil {
+		panic("mocks: volumes.API.AttachFunc is not set")
+	}
+	return m.AttachFunc(volumeID, opts)
+}
+
+// Create implements volumes.API.
+func (m *API) Create(opts volumes.CreateOptsBuilder) tasks.Result {
+	m.record("Create", opts)
+	if m.CreateFunc == nil {
+		panic("mocks: volumes.API.CreateFunc is not set")
+	}
+	return m.CreateFunc(opts)
+}
+
+// Delete implements volumes.API.
+func (m *API) Delete(volumeID string, opts volumes.DeleteOptsBuilder) tasks.Result {
+	m.record("Delete", volumeID, opts)
+	if m.DeleteFunc == nil {
+		panic("mocks: volumes.API.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(volumeID, opts)
+}
+
+// Detach implements volumes.API.
+func (m *API) Detach(volumeID string, opts volumes.InstanceOperationOptsBuilder) volumes.UpdateResult {
+	m.record("Detach", volumeID, opts)
+	if m.DetachFunc == nil {
+		panic("mocks: volumes.API.DetachFunc is not set")
+	}
+	return m.DetachFunc(volumeID, opts)
+}
+
+// Extend implements volumes.API.
+func (m *API) Extend(volumeID string, opts volumes.PropertiesOperationOptsBuilder) tasks.Result {
+	m.record("Extend", volumeID, opts)
+	if m.ExtendFunc == nil {
+		panic("mocks: volumes.API.ExtendFunc is not set")
+	}
+	return m.ExtendFunc(volumeID, opts)
+}
+
+// Get implements volumes.API.
+func (m *API) Get(id string) volumes.GetResult {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		panic("mocks: volumes.API.GetFunc is not set")
+	}
+	return m.GetFunc(id)
+}
+
+// IDFromName implements volumes.API.
+func (m *API) IDFromName(name string) (string, error) {
+	m.record("IDFromName", name)
+	if m.IDFromNameFunc == nil {
+		panic("mocks: volumes.API.IDFromNameFunc is not set")
+	}
+	return m.IDFromNameFunc(name)
+}
+
+// List implements volumes.API.
+func (m *API) List(opts volumes.ListOptsBuilder) pagination.Pager {
+	m.record("List", opts)
+	if m.ListFunc == nil {
+		panic("mocks: volumes.API.ListFunc is not set")
+	}
+	return m.ListFunc(opts)
+}
+
+// ListAll implements volumes.API.
+func (m *API) ListAll(opts volumes.ListOptsBuilder) ([]volumes.Volume, error) {
+	m.record("ListAll", opts)
+	if m.ListAllFunc == nil {
+		panic("mocks: volumes.API.ListAllFunc is not set")
+	}
+	return m.ListAllFunc(opts)
+}
+
+// Retype implements volumes.API.
+func (m *API) Retype(volumeID string, opts volumes.PropertiesOperationOptsBuilder) volumes.UpdateResult {
+	m.record("Retype", volumeID, opts)
+	if m.RetypeFunc == nil {
+		panic("mocks: volumes.API.RetypeFunc is not set")
+	}
+	return m.RetypeFunc(volumeID, opts)
+}
+
+// Revert implements volumes.API.
+func (m *API) Revert(volumeID string) tasks.Result {
+	m.record("Revert", volumeID)
+	if m.RevertFunc == nil {
+		panic("mocks: volumes.API.RevertFunc is not set")
+	}
+	return m.RevertFunc(volumeID)
+}
+
+// Update implements volumes.API.
+func (m *API) Update(volumeID string, opts volumes.UpdateOptsBuilder) volumes.UpdateResult {
+	m.record("Update", volumeID, opts)
+	if m.UpdateFunc == nil {
+		panic("mocks: volumes.API.UpdateFunc is not set")
+	}
+	return m.UpdateFunc(volumeID, opts)
+}
+
+// WaitForStatus implements volumes.API.
+func (m *API) WaitForStatus(volumeID string, status volumes.VolumeStatus, opts gcorecloud.WaitOpts) (*volumes.Volume, error) {
+	m.record("WaitForStatus", volumeID, status, opts)
+	if m.WaitForStatusFunc == nil {
+		panic("mocks: volumes.API.WaitForStatusFunc is not set")
+	}
+	return m.WaitForStatusFunc(volumeID, status, opts)
+}
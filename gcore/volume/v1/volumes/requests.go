@@ -1,6 +1,8 @@
 package volumes
 
 import (
+	"fmt"
+
 	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
 	"github.com/G-Core/gcorelabscloud-go/pagination"
@@ -302,3 +304,30 @@ func IDFromName(client *gcorecloud.ServiceClient, name string) (string, error) {
 		return "", gcorecloud.ErrMultipleResourcesFound{Name: name, Count: count, ResourceType: "volumes"}
 	}
 }
+
+// WaitForStatus polls the volume until it reaches the status, e.g. Available or InUse after Attach, Detach or Retype.
+// It fails fast when the volume enters an error status. The wait is cancelled with the context of the client.
+func WaitForStatus(c *gcorecloud.ServiceClient, volumeID string, status VolumeStatus, opts gcorecloud.WaitOpts) (*Volume, error) {
+	var volume *Volume
+	err := c.WaitForResource(volumeID, opts, func(c *gcorecloud.ServiceClient) (bool, error) {
+		current, err := Get(c, volumeID).Extract()
+		if err != nil {
+			return false, err
+		}
+		volume = current
+		if volume.Status == status {
+			return true, nil
+		}
+		if volume.Status.IsError() {
+			return false, gcorecloud.ErrResourceErrorState
+		}
+		return false, nil
+	})
+	if err != nil {
+		if volume != nil {
+			return volume, fmt.Errorf("waiting for volume %s status %s, current status %s: %w", volumeID, status, volume.Status, err)
+		}
+		return nil, fmt.Errorf("waiting for volume %s status %s: %w", volumeID, status, err)
+	}
+	return volume, nil
+}
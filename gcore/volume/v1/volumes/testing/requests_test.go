@@ -1,9 +1,12 @@
 package testing
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/G-Core/gcorelabscloud-go/gcore/volume/v1/volumes"
 	fake "github.com/G-Core/gcorelabscloud-go/testhelper/client"
@@ -14,6 +17,8 @@ import (
 
 	"github.com/G-Core/gcorelabscloud-go/pagination"
 	th "github.com/G-Core/gcorelabscloud-go/testhelper"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
 )
 
 func prepareListTestURLParams(projectID int, regionID int) string {
@@ -412,3 +417,51 @@ func TestMetadataUpdate(t *testing.T) {
 func TestMetadataDelete(t *testing.T) {
 	gtesting.BuildTestMetadataDelete("volumes", Volume1.ID)(t)
 }
+
+func handleVolumeStatuses(t *testing.T, statuses ...string) *int {
+	calls := 0
+	th.Mux.HandleFunc(prepareGetTestURL(Volume1.ID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Authorization", fmt.Sprintf("Bearer %s", fake.AccessToken))
+
+		status := statuses[len(statuses)-1]
+		if calls < len(statuses) {
+			status = statuses[calls]
+		}
+		calls++
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprint(w, strings.Replace(GetResponse, `"status": "available"`, fmt.Sprintf(`"status": %q`, status), 1))
+		if err != nil {
+			log.Error(err)
+		}
+	})
+	return &calls
+}
+
+func TestWaitForStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := handleVolumeStatuses(t, "attaching", "attaching", "in-use")
+
+	client := fake.ServiceTokenClient("volumes", "v1")
+	volume, err := volumes.WaitForStatus(client, Volume1.ID, volumes.InUse, gcorecloud.WaitOpts{Interval: time.Millisecond})
+
+	require.NoError(t, err)
+	require.Equal(t, volumes.InUse, volume.Status)
+	require.Equal(t, 3, *calls)
+}
+
+func TestWaitForStatusErrorState(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := handleVolumeStatuses(t, "retyping", "error", "available")
+
+	client := fake.ServiceTokenClient("volumes", "v1")
+	_, err := volumes.WaitForStatus(client, Volume1.ID, volumes.Available, gcorecloud.WaitOpts{Interval: time.Millisecond})
+
+	require.Error(t, err)
+	require.True(t, errors.Is(err, gcorecloud.ErrResourceErrorState))
+	require.Equal(t, 2, *calls)
+}
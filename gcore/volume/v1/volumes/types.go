@@ -66,6 +66,15 @@ func (vs VolumeStatus) IsValid() error {
 	return fmt.Errorf("invalid VolumeStatus type: %v", vs)
 }
 
+// IsError reports whether the status is an error status.
+func (vs VolumeStatus) IsError() bool {
+	switch vs {
+	case Error, ErrorDeleting, ErrorBackingUp, ErrorRestoring, ErrorExtending:
+		return true
+	}
+	return false
+}
+
 func (vs VolumeStatus) ValidOrNil() (*VolumeStatus, error) {
 	if vs.String() == "" {
 		return nil, nil
@@ -0,0 +1,566 @@
+// Package apigen generates the API interface, its ServiceClient backed implementation and the mock of a resource
+// package from the package level functions taking a *gcorecloud.ServiceClient as their first argument.
+package apigen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// APIFile is the name of the generated file in the resource package.
+	APIFile = "api.go"
+	// MocksDir is the name of the generated mocks subpackage.
+	MocksDir = "mocks"
+
+	rootImportPath = "github.com/G-Core/gcorelabscloud-go"
+	header         = "// Code generated by apigen. DO NOT EDIT.\n\n"
+)
+
+// Files are the generated sources of a resource package.
+type Files struct {
+	// API is the content of api.go in the resource package.
+	API []byte
+	// Mock is the content of mocks/api.go.
+	Mock []byte
+}
+
+type param struct {
+	name     string
+	typ      ast.Expr
+	variadic bool
+}
+
+type method struct {
+	name    string
+	params  []param
+	results []ast.Expr
+	imports map[string]string // name used in the source file -> import path
+}
+
+type generator struct {
+	dir        string
+	importPath string
+	pkgName    string
+	methods    []method
+	names      map[string]string // import path -> package name
+}
+
+// Generate returns the generated sources of the package in dir. It returns nil when the package has no function
+// taking a service client.
+func Generate(dir, importPath string) (*Files, error) {
+	g := &generator{dir: dir, importPath: importPath, names: make(map[string]string)}
+	if err := g.parse(); err != nil {
+		return nil, err
+	}
+	if len(g.methods) == 0 {
+		return nil, nil
+	}
+	api, err := g.api()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", APIFile, err)
+	}
+	mock, err := g.mock()
+	if err != nil {
+		return nil, fmt.Errorf("%s/%s: %w", MocksDir, APIFile, err)
+	}
+	return &Files{API: api, Mock: mock}, nil
+}
+
+// Write generates the sources of the package in dir and writes them.
+func Write(dir, importPath string) (bool, error) {
+	files, err := Generate(dir, importPath)
+	if err != nil || files == nil {
+		return false, err
+	}
+	if err := os.WriteFile(filepath.Join(dir, APIFile), files.API, 0o644); err != nil { // nolint
+		return false, err
+	}
+	if err := os.MkdirAll(filepath.Join(dir, MocksDir), 0o755); err != nil {
+		return false, err
+	}
+	return true, os.WriteFile(filepath.Join(dir, MocksDir, APIFile), files.Mock, 0o644) // nolint
+}
+
+// Packages returns the directories below root containing a requests.go file.
+func Packages(root string) ([]string, error) {
+	var dirs []string
+	err := filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && info.Name() == "requests.go" {
+			dirs = append(dirs, filepath.Dir(p))
+		}
+		return nil
+	})
+	sort.Strings(dirs)
+	return dirs, err
+}
+
+func (g *generator) parse() error {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, g.dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != APIFile
+	}, 0)
+	if err != nil {
+		return err
+	}
+	if len(pkgs) != 1 {
+		return fmt.Errorf("%s: expected a single package, found %d", g.dir, len(pkgs))
+	}
+	for name, pkg := range pkgs {
+		g.pkgName = name
+		fileNames := make([]string, 0, len(pkg.Files))
+		for fileName := range pkg.Files {
+			fileNames = append(fileNames, fileName)
+		}
+		sort.Strings(fileNames)
+		for _, fileName := range fileNames {
+			if err := g.parseFile(pkg.Files[fileName]); err != nil {
+				return err
+			}
+		}
+	}
+	sort.Slice(g.methods, func(i, j int) bool { return g.methods[i].name < g.methods[j].name })
+	return nil
+}
+
+func (g *generator) parseFile(file *ast.File) error {
+	imports := make(map[string]string)
+	for _, spec := range file.Imports {
+		importPath, _ := strconv.Unquote(spec.Path.Value)
+		var name string
+		if spec.Name != nil {
+			name = spec.Name.Name
+		} else {
+			var err error
+			if name, err = g.packageName(importPath); err != nil {
+				return err
+			}
+		}
+		imports[name] = importPath
+	}
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || !fn.Name.IsExported() || fn.Type.Params == nil || len(fn.Type.Params.List) == 0 {
+			continue
+		}
+		first := fn.Type.Params.List[0]
+		if !isServiceClient(first.Type, imports) {
+			continue
+		}
+		m := method{name: fn.Name.Name, imports: imports}
+		skip := len(first.Names)
+		if skip == 0 {
+			skip = 1
+		}
+		index := 0
+		for _, field := range fn.Type.Params.List {
+			names := field.Names
+			if len(names) == 0 {
+				names = []*ast.Ident{nil}
+			}
+			for _, n := range names {
+				index++
+				if index <= skip {
+					continue
+				}
+				p := param{typ: field.Type}
+				if n != nil && n.Name != "_" {
+					p.name = n.Name
+				} else {
+					p.name = fmt.Sprintf("arg%d", index-1)
+				}
+				if ellipsis, ok := field.Type.(*ast.Ellipsis); ok {
+					p.variadic = true
+					p.typ = ellipsis.Elt
+				}
+				m.params = append(m.params, p)
+			}
+		}
+		if fn.Type.Results != nil {
+			for _, field := range fn.Type.Results.List {
+				count := len(field.Names)
+				if count == 0 {
+					count = 1
+				}
+				for i := 0; i < count; i++ {
+					m.results = append(m.results, field.Type)
+				}
+			}
+		}
+		g.methods = append(g.methods, m)
+	}
+	return nil
+}
+
+func isServiceClient(expr ast.Expr, imports map[string]string) bool {
+	star, ok := expr.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := star.X.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "ServiceClient" {
+		return false
+	}
+	x, ok := sel.X.(*ast.Ident)
+	return ok && imports[x.Name] == rootImportPath
+}
+
+// packageName returns the name of the imported package, which may differ from the last element of its path.
+func (g *generator) packageName(importPath string) (string, error) {
+	if name, ok := g.names[importPath]; ok {
+		return name, nil
+	}
+	name := path.Base(importPath)
+	switch {
+	case importPath == rootImportPath:
+		name = "gcorecloud"
+	case importPath == g.importPath:
+		name = g.pkgName
+	case strings.HasPrefix(importPath, rootImportPath+"/"):
+		var err error
+		if name, err = g.localPackageName(importPath); err != nil {
+			return "", err
+		}
+	case !isStdLib(importPath):
+		pkg, err := build.Default.Import(importPath, g.dir, 0)
+		if err != nil {
+			return "", err
+		}
+		name = pkg.Name
+	}
+	g.names[importPath] = name
+	return name, nil
+}
+
+// localPackageName reads the package clause of a package of this module.
+func (g *generator) localPackageName(importPath string) (string, error) {
+	root := g.dir
+	for i := strings.Count(strings.TrimPrefix(g.importPath, rootImportPath), "/"); i > 0; i-- {
+		root = filepath.Dir(root)
+	}
+	dir := filepath.Join(root, filepath.FromSlash(strings.TrimPrefix(importPath, rootImportPath+"/")))
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, parser.PackageClauseOnly)
+	if err != nil {
+		return "", err
+	}
+	for name := range pkgs {
+		return name, nil
+	}
+	return "", fmt.Errorf("no package in %s", dir)
+}
+
+func isStdLib(importPath string) bool {
+	return !strings.Contains(strings.Split(importPath, "/")[0], ".")
+}
+
+// file collects the imports of a generated file and renders type expressions with their aliases.
+type file struct {
+	imports map[string]string // import path -> name in the generated file
+	used    map[string]string // name in the generated file -> import path
+	local   string            // qualifier of the resource package identifiers, empty within the package
+	names   func(string) (string, error)
+}
+
+func newFile(local string, names func(string) (string, error)) *file {
+	return &file{imports: make(map[string]string), used: make(map[string]string), local: local, names: names}
+}
+
+func (f *file) alias(importPath string) (string, error) {
+	if name, ok := f.imports[importPath]; ok {
+		return name, nil
+	}
+	name, err := f.names(importPath)
+	if err != nil {
+		return "", err
+	}
+	alias := name
+	for i := 2; ; i++ {
+		if _, taken := f.used[alias]; !taken && alias != f.local {
+			break
+		}
+		alias = fmt.Sprintf("%s%d", name, i)
+	}
+	f.imports[importPath] = alias
+	f.used[alias] = importPath
+	return alias, nil
+}
+
+func (f *file) importBlock() string {
+	paths := make([]string, 0, len(f.imports))
+	for p := range f.imports {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	sort.SliceStable(paths, func(i, j int) bool { return isStdLib(paths[i]) && !isStdLib(paths[j]) })
+	var buf bytes.Buffer
+	buf.WriteString("import (\n")
+	for i, p := range paths {
+		if i > 0 && isStdLib(paths[i-1]) && !isStdLib(p) {
+			buf.WriteString("\n")
+		}
+		name, _ := f.names(p)
+		if alias := f.imports[p]; alias != name || alias != path.Base(p) {
+			fmt.Fprintf(&buf, "\t%s %q\n", alias, p)
+		} else {
+			fmt.Fprintf(&buf, "\t%q\n", p)
+		}
+	}
+	buf.WriteString(")\n\n")
+	return buf.String()
+}
+
+// expr renders a type expression of a method declared in the resource package.
+func (f *file) expr(e ast.Expr, m method) (string, error) {
+	switch t := e.(type) {
+	case *ast.Ident:
+		if f.local != "" && t.IsExported() {
+			return f.local + "." + t.Name, nil
+		}
+		return t.Name, nil
+	case *ast.SelectorExpr:
+		x, ok := t.X.(*ast.Ident)
+		if !ok {
+			return "", fmt.Errorf("unsupported selector %T", t.X)
+		}
+		importPath, ok := m.imports[x.Name]
+		if !ok {
+			return "", fmt.Errorf("unknown package %s in %s", x.Name, m.name)
+		}
+		alias, err := f.alias(importPath)
+		if err != nil {
+			return "", err
+		}
+		return alias + "." + t.Sel.Name, nil
+	case *ast.StarExpr:
+		s, err := f.expr(t.X, m)
+		return "*" + s, err
+	case *ast.ArrayType:
+		s, err := f.expr(t.Elt, m)
+		if err != nil || t.Len == nil {
+			return "[]" + s, err
+		}
+		l, ok := t.Len.(*ast.BasicLit)
+		if !ok {
+			return "", fmt.Errorf("unsupported array length %T", t.Len)
+		}
+		return "[" + l.Value + "]" + s, nil
+	case *ast.MapType:
+		k, err := f.expr(t.Key, m)
+		if err != nil {
+			return "", err
+		}
+		v, err := f.expr(t.Value, m)
+		return "map[" + k + "]" + v, err
+	case *ast.InterfaceType:
+		if t.Methods != nil && len(t.Methods.List) > 0 {
+			return "", fmt.Errorf("unsupported non-empty interface in %s", m.name)
+		}
+		return "interface{}", nil
+	case *ast.ChanType:
+		s, err := f.expr(t.Value, m)
+		switch t.Dir {
+		case ast.SEND:
+			return "chan<- " + s, err
+		case ast.RECV:
+			return "<-chan " + s, err
+		}
+		return "chan " + s, err
+	case *ast.FuncType:
+		params, err := f.fieldList(t.Params, m)
+		if err != nil {
+			return "", err
+		}
+		results, err := f.fieldList(t.Results, m)
+		if err != nil {
+			return "", err
+		}
+		s := "func(" + strings.Join(params, ", ") + ")"
+		if len(results) == 1 {
+			s += " " + results[0]
+		} else if len(results) > 1 {
+			s += " (" + strings.Join(results, ", ") + ")"
+		}
+		return s, nil
+	}
+	return "", fmt.Errorf("unsupported type %T in %s", e, m.name)
+}
+
+func (f *file) fieldList(list *ast.FieldList, m method) ([]string, error) {
+	if list == nil {
+		return nil, nil
+	}
+	var result []string
+	for _, field := range list.List {
+		s, err := f.expr(field.Type, m)
+		if err != nil {
+			return nil, err
+		}
+		count := len(field.Names)
+		if count == 0 {
+			count = 1
+		}
+		for i := 0; i < count; i++ {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+// signature renders the parameters and the results of a method.
+func (f *file) signature(m method) (params, args, results string, err error) {
+	var ps, as, rs []string
+	for _, p := range m.params {
+		t, err := f.expr(p.typ, m)
+		if err != nil {
+			return "", "", "", err
+		}
+		if p.variadic {
+			ps = append(ps, p.name+" ..."+t)
+			as = append(as, p.name+"...")
+		} else {
+			ps = append(ps, p.name+" "+t)
+			as = append(as, p.name)
+		}
+	}
+	for _, r := range m.results {
+		t, err := f.expr(r, m)
+		if err != nil {
+			return "", "", "", err
+		}
+		rs = append(rs, t)
+	}
+	results = strings.Join(rs, ", ")
+	if len(rs) > 1 {
+		results = "(" + results + ")"
+	}
+	return strings.Join(ps, ", "), strings.Join(as, ", "), results, nil
+}
+
+func receiverName(m method, candidates ...string) string {
+	for _, candidate := range candidates {
+		taken := false
+		for _, p := range m.params {
+			if p.name == candidate {
+				taken = true
+			}
+		}
+		if !taken {
+			return candidate
+		}
+	}
+	return "receiver"
+}
+
+func (g *generator) api() ([]byte, error) {
+	f := newFile("", g.packageName)
+	f.used[g.pkgName] = g.importPath
+	gc, err := f.alias(rootImportPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var iface, impl bytes.Buffer
+	for _, m := range g.methods {
+		params, args, results, err := f.signature(m)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&iface, "\t%s(%s) %s\n", m.name, params, results)
+		recv := receiverName(m, "s", "svc", "service")
+		if args != "" {
+			args = ", " + args
+		}
+		fmt.Fprintf(&impl, "\n// %s calls the package level %s function with the service client.\n", m.name, m.name)
+		fmt.Fprintf(&impl, "func (%s *Service) %s(%s) %s {\n\treturn %s(%s.ServiceClient%s)\n}\n",
+			recv, m.name, params, results, m.name, recv, args)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	fmt.Fprintf(&buf, "package %s\n\n", g.pkgName)
+	buf.WriteString(f.importBlock())
+	fmt.Fprintf(&buf, "// API is the set of %s operations. It allows consumers to depend on an interface and to use\n", g.pkgName)
+	buf.WriteString("// mocks.API in their tests. See the package level functions for the documentation of each method.\n")
+	fmt.Fprintf(&buf, "type API interface {\n%s}\n\n", iface.String())
+	buf.WriteString("// Service implements API by calling the package level functions with a service client.\n")
+	fmt.Fprintf(&buf, "type Service struct {\n\tServiceClient *%s.ServiceClient\n}\n\n", gc)
+	buf.WriteString("// NewService returns an API backed by the service client.\n")
+	fmt.Fprintf(&buf, "func NewService(client *%s.ServiceClient) *Service {\n\treturn &Service{ServiceClient: client}\n}\n\n", gc)
+	buf.WriteString("var _ API = (*Service)(nil)\n")
+	buf.WriteString(impl.String())
+	return format.Source(buf.Bytes())
+}
+
+func (g *generator) mock() ([]byte, error) {
+	f := newFile("", g.packageName)
+	f.used["sync"] = "sync"
+	f.imports["sync"] = "sync"
+	local, err := f.alias(g.importPath)
+	if err != nil {
+		return nil, err
+	}
+	f.local = local
+
+	var fields, impl bytes.Buffer
+	for _, m := range g.methods {
+		params, args, results, err := f.signature(m)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&fields, "\t%sFunc func(%s) %s\n", m.name, params, results)
+		var callArgs []string
+		for _, p := range m.params {
+			callArgs = append(callArgs, p.name)
+		}
+		recv := receiverName(m, "m", "mock", "mck")
+		ret := "return "
+		if len(m.results) == 0 {
+			ret = ""
+		}
+		fmt.Fprintf(&impl, "\n// %s implements %s.API.\n", m.name, local)
+		fmt.Fprintf(&impl, "func (%s *API) %s(%s) %s {\n", recv, m.name, params, results)
+		fmt.Fprintf(&impl, "\t%s.record(%q", recv, m.name)
+		for _, a := range callArgs {
+			fmt.Fprintf(&impl, ", %s", a)
+		}
+		impl.WriteString(")\n")
+		fmt.Fprintf(&impl, "\tif %s.%sFunc == nil {\n\t\tpanic(\"mocks: %s.API.%s is not set\")\n\t}\n", recv, m.name, local, m.name+"Func")
+		fmt.Fprintf(&impl, "\t%s%s.%sFunc(%s)\n}\n", ret, recv, m.name, args)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	fmt.Fprintf(&buf, "// Package mocks provides a mock of %s.API.\n", g.pkgName)
+	buf.WriteString("package mocks\n\n")
+	buf.WriteString(f.importBlock())
+	buf.WriteString("// Call is a call recorded by API.\n")
+	buf.WriteString("type Call struct {\n\tMethod string\n\tArgs   []interface{}\n}\n\n")
+	fmt.Fprintf(&buf, "// API is a mock of %s.API. Each method calls the function of the matching field, which must be set\n", local)
+	buf.WriteString("// by the test, and records the call.\n")
+	fmt.Fprintf(&buf, "type API struct {\n%s\n\tmu    sync.Mutex\n\tcalls []Call\n}\n\n", fields.String())
+	fmt.Fprintf(&buf, "var _ %s.API = (*API)(nil)\n\n", local)
+	buf.WriteString("// Calls returns the recorded calls in order.\n")
+	buf.WriteString("func (m *API) Calls() []Call {\n\tm.mu.Lock()\n\tdefer m.mu.Unlock()\n\tcalls := make([]Call, len(m.calls))\n\tcopy(calls, m.calls)\n\treturn calls\n}\n\n")
+	buf.WriteString("// CallsTo returns the recorded calls of a method.\n")
+	buf.WriteString("func (m *API) CallsTo(method string) []Call {\n\tvar calls []Call\n\tfor _, c := range m.Calls() {\n\t\tif c.Method == method {\n\t\t\tcalls = append(calls, c)\n\t\t}\n\t}\n\treturn calls\n}\n\n")
+	buf.WriteString("func (m *API) record(method string, args ...interface{}) {\n\tm.mu.Lock()\n\tdefer m.mu.Unlock()\n\tm.calls = append(m.calls, Call{Method: method, Args: args})\n}\n")
+	buf.WriteString(impl.String())
+	return format.Source(buf.Bytes())
+}
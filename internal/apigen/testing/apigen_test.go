@@ -0,0 +1,64 @@
+package testing
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/G-Core/gcorelabscloud-go/gcore/network/v1/networks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/network/v1/networks/mocks"
+	"github.com/G-Core/gcorelabscloud-go/internal/apigen"
+	"github.com/stretchr/testify/require"
+)
+
+const root = "../../.."
+
+func TestGeneratedFilesAreUpToDate(t *testing.T) {
+	dirs, err := apigen.Packages(filepath.Join(root, "gcore"))
+	require.NoError(t, err)
+	require.NotEmpty(t, dirs)
+
+	for _, dir := range dirs {
+		rel, err := filepath.Rel(root, dir)
+		require.NoError(t, err)
+		files, err := apigen.Generate(dir, "github.com/G-Core/gcorelabscloud-go/"+filepath.ToSlash(rel))
+		require.NoError(t, err, dir)
+		if files == nil {
+			continue
+		}
+		api, err := os.ReadFile(filepath.Join(dir, apigen.APIFile))
+		require.NoError(t, err, "run go run ./internal/cmd/apigen")
+		require.Equal(t, string(files.API), string(api), "%s is outdated, run go run ./internal/cmd/apigen", rel)
+		mock, err := os.ReadFile(filepath.Join(dir, apigen.MocksDir, apigen.APIFile))
+		require.NoError(t, err, "run go run ./internal/cmd/apigen")
+		require.Equal(t, string(files.Mock), string(mock), "%s mocks are outdated, run go run ./internal/cmd/apigen", rel)
+	}
+}
+
+func networkNames(api networks.API) ([]string, error) {
+	list, err := api.ListAll(nil)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(list))
+	for _, n := range list {
+		names = append(names, n.Name)
+	}
+	return names, nil
+}
+
+func TestMock(t *testing.T) {
+	mock := &mocks.API{
+		ListAllFunc: func(opts networks.ListOptsBuilder) ([]networks.Network, error) {
+			return []networks.Network{{Name: "network1"}, {Name: "network2"}}, nil
+		},
+	}
+	names, err := networkNames(mock)
+	require.NoError(t, err)
+	require.Equal(t, []string{"network1", "network2"}, names)
+	require.Len(t, mock.CallsTo("ListAll"), 1)
+	require.Equal(t, []interface{}{networks.ListOptsBuilder(nil)}, mock.Calls()[0].Args)
+
+	require.Panics(t, func() { _ = mock.Get("id") })
+	require.Len(t, mock.Calls(), 2)
+}
@@ -0,0 +1,2 @@
+// apigen unit tests
+package testing
@@ -0,0 +1,39 @@
+// Command apigen generates the API interfaces and their mocks of the resource packages.
+//
+// Usage, from the repository root:
+//
+//	go run ./internal/cmd/apigen
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/G-Core/gcorelabscloud-go/internal/apigen"
+)
+
+const module = "github.com/G-Core/gcorelabscloud-go"
+
+func main() {
+	root := flag.String("root", ".", "repository root")
+	flag.Parse()
+
+	dirs, err := apigen.Packages(filepath.Join(*root, "gcore"))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	for _, dir := range dirs {
+		rel, err := filepath.Rel(*root, dir)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if _, err := apigen.Write(dir, module+"/"+filepath.ToSlash(rel)); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", dir, err)
+			os.Exit(1)
+		}
+	}
+}
@@ -83,12 +83,30 @@ func (p Pager) fetchNextPage(url string) (Page, error) {
 	return p.createPage(remembered), nil
 }
 
+// startSpan starts the parent span of a traversal and binds the pager requests to it.
+func (p *Pager) startSpan() gcorecloud.Span {
+	ctx, span := p.client.StartSpan(gcorecloud.SpanNamePager)
+	span.SetAttribute(gcorecloud.SpanAttributeHTTPURL, p.initialURL)
+	p.client = p.client.WithContext(ctx)
+	return span
+}
+
 // EachPage iterates over each page returned by a Pager, yielding one at a time to a handler function.
 // Return "false" from the handler to prematurely stop iterating.
 func (p Pager) EachPage(handler func(Page) (bool, error)) error {
 	if p.Err != nil {
 		return p.Err
 	}
+	span := p.startSpan()
+	defer span.End()
+	err := p.eachPage(handler)
+	if err != nil {
+		span.RecordError(err)
+	}
+	return err
+}
+
+func (p Pager) eachPage(handler func(Page) (bool, error)) error {
 	currentURL := p.initialURL
 	for {
 		var currentPage Page
@@ -134,6 +152,19 @@ func (p Pager) EachPage(handler func(Page) (bool, error)) error {
 // AllPages returns all the pages from a `List` operation in a single page,
 // allowing the user to retrieve all the pages at once.
 func (p Pager) AllPages() (Page, error) {
+	if p.Err != nil {
+		return nil, p.Err
+	}
+	span := p.startSpan()
+	defer span.End()
+	page, err := p.allPages()
+	if err != nil {
+		span.RecordError(err)
+	}
+	return page, err
+}
+
+func (p Pager) allPages() (Page, error) {
 	// pagesSlice holds all the pages until they get converted into as Page Body.
 	var pagesSlice []interface{}
 	// body will contain the final concatenated Page body.
@@ -163,7 +194,7 @@ func (p Pager) AllPages() (Page, error) {
 		// key is the map key for the page body if the body type is `map[string]interface{}`.
 		var key string
 		// Iterate over the pages to concatenate the bodies.
-		err = p.EachPage(func(page Page) (bool, error) {
+		err = p.eachPage(func(page Page) (bool, error) {
 			b := page.GetBody().(map[string]interface{})
 			for k, v := range b {
 				// If it's a linked page, we don't want the `links`, we want the other one.
@@ -186,7 +217,7 @@ func (p Pager) AllPages() (Page, error) {
 		body.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(pagesSlice))
 	case []byte:
 		// Iterate over the pages to concatenate the bodies.
-		err = p.EachPage(func(page Page) (bool, error) {
+		err = p.eachPage(func(page Page) (bool, error) {
 			b := page.GetBody().([]byte)
 			pagesSlice = append(pagesSlice, b)
 			// separate pages with a comma
@@ -210,7 +241,7 @@ func (p Pager) AllPages() (Page, error) {
 		body.SetBytes(b)
 	case []interface{}:
 		// Iterate over the pages to concatenate the bodies.
-		err = p.EachPage(func(page Page) (bool, error) {
+		err = p.eachPage(func(page Page) (bool, error) {
 			b := page.GetBody().([]interface{})
 			pagesSlice = append(pagesSlice, b...)
 			return true, nil
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"os"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -87,6 +88,9 @@ type ProviderClient struct {
 	// Context is the context passed to the HTTP request.
 	Context context.Context
 
+	// Tracer, if set, is used to start a span per request, per Pager traversal and per task wait.
+	Tracer Tracer
+
 	// mut is a mutex for the client. It protects read and write access to client attributes such as getting
 	// and setting the AccessTokenID.
 	mut *sync.RWMutex
@@ -361,6 +365,11 @@ type RequestOpts struct {
 	// ErrorContext specifies the resource error type to return if an error is encountered.
 	// This lets resources override default error messages based on the response status code.
 	ErrorContext error
+
+	// context overrides the ProviderClient context for a single request. It is set by ServiceClient.
+	context context.Context
+	// spanAttributes are set on the request span. It is set by ServiceClient.
+	spanAttributes map[string]string
 }
 
 // requestState contains temporary state for a single ProviderClient.Request() call.
@@ -370,6 +379,9 @@ type requestState struct {
 	// reauthenticate, but keep getting 401 responses with the fresh token, reauthenticating some more
 	// will just get us into an infinite loop.
 	hasReauthenticated bool
+
+	// span is the span of the request.
+	span Span
 }
 
 var applicationJSON = "application/json"
@@ -377,12 +389,32 @@ var applicationJSON = "application/json"
 // Request performs an HTTP request using the ProviderClient's current HTTPClient. An authentication
 // header will automatically be provided.
 func (client *ProviderClient) Request(method, url string, options *RequestOpts) (*http.Response, error) {
-	return client.doRequest(method, url, options, &requestState{
+	ctx := options.context
+	if ctx == nil {
+		ctx = client.Context
+	}
+	ctx, span := client.StartSpan(ctx, SpanNameRequest)
+	defer span.End()
+	span.SetAttribute(SpanAttributeHTTPMethod, method)
+	span.SetAttribute(SpanAttributeHTTPURL, url)
+	for k, v := range options.spanAttributes {
+		span.SetAttribute(k, v)
+	}
+
+	resp, err := client.doRequest(ctx, method, url, options, &requestState{
 		hasReauthenticated: false,
+		span:               span,
 	})
+	if resp != nil {
+		span.SetAttribute(SpanAttributeHTTPStatus, strconv.Itoa(resp.StatusCode))
+	}
+	if err != nil {
+		span.RecordError(err)
+	}
+	return resp, err
 }
 
-func (client *ProviderClient) doRequest(method, url string, options *RequestOpts, state *requestState) (*http.Response, error) { // nolint: gocyclo
+func (client *ProviderClient) doRequest(ctx context.Context, method, url string, options *RequestOpts, state *requestState) (*http.Response, error) { // nolint: gocyclo
 	var body io.Reader
 	var contentType *string
 
@@ -411,9 +443,7 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 	if err != nil {
 		return nil, err
 	}
-	if client.Context != nil {
-		req = req.WithContext(client.Context)
-	}
+	req = req.WithContext(ctx)
 
 	// Populate the request headers. Apply options.MoreHeaders last, to give the caller the chance to
 	// modify or omit any header.
@@ -440,6 +470,11 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 		req.Header.Set(k, v)
 	}
 
+	// Propagate the trace context
+	if sc := state.span.SpanContext(); sc.IsValid() {
+		req.Header.Set(TraceParentHeader, sc.TraceParent())
+	}
+
 	// Set connection parameter to close the connection immediately when we've got the response
 	req.Close = true
 
@@ -508,7 +543,7 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 					}
 				}
 				state.hasReauthenticated = true
-				resp, err = client.doRequest(method, url, options, state)
+				resp, err = client.doRequest(ctx, method, url, options, state)
 				if err != nil {
 					switch err := err.(type) {
 					case *ErrUnexpectedResponseCode:
@@ -597,6 +632,9 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 		if err := json.NewDecoder(resp.Body).Decode(options.JSONResponse); err != nil {
 			return nil, err
 		}
+		if taskIDs := extractTaskIDs(options.JSONResponse); len(taskIDs) > 0 {
+			state.span.SetAttribute(SpanAttributeTaskIDs, strings.Join(taskIDs, ","))
+		}
 	}
 
 	return resp, nil
@@ -1,6 +1,8 @@
 package gcorecloud
 
 import (
+	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
@@ -32,6 +34,58 @@ type ServiceClient struct {
 
 	// RegionID is an id of chosen region
 	RegionID int
+
+	// ProjectID is an id of chosen project
+	ProjectID int
+
+	// Name is the service name of the client (e.g. instances). It is used to tag the tracing spans.
+	Name string
+
+	// ctx, if set, is used instead of the ProviderClient context. Use WithContext to set it.
+	ctx context.Context
+
+	// err, if set, is returned by every request. ForService sets it when it cannot point the client to the service.
+	err error
+}
+
+// WithContext returns a shallow copy of the service client whose requests use ctx.
+// The ProviderClient, and thus the tokens, are shared with the original client.
+func (client *ServiceClient) WithContext(ctx context.Context) *ServiceClient {
+	c := *client
+	c.ctx = ctx
+	return &c
+}
+
+// ForService returns a shallow copy of the service client pointing to another service of the same project, region
+// and API version, e.g. the volumes API from an instances client. The /<Name>/ segment of the resource base URL is
+// replaced, when the client has no name or its URL has no such segment every request of the copy fails instead of
+// reaching the API of the client.
+func (client *ServiceClient) ForService(name string) *ServiceClient {
+	c := *client
+	c.Name = name
+	if client.err != nil {
+		return &c
+	}
+	base := client.ResourceBaseURL()
+	current := "/" + client.Name + "/"
+	i := strings.LastIndex(base, current)
+	if client.Name == "" || i < 0 {
+		c.err = fmt.Errorf("cannot point service client to %s: resource base %q has no /%s/ segment", name, base, client.Name)
+		return &c
+	}
+	c.ResourceBase = base[:i] + "/" + name + "/" + base[i+len(current):]
+	return &c
+}
+
+// RequestContext returns the context used by the requests of the service client.
+func (client *ServiceClient) RequestContext() context.Context {
+	if client.ctx != nil {
+		return client.ctx
+	}
+	if client.ProviderClient != nil && client.ProviderClient.Context != nil {
+		return client.ProviderClient.Context
+	}
+	return context.Background()
 }
 
 // ResourceBaseURL returns the base URL of any resources used by this service. It MUST end with a /.
@@ -134,13 +188,21 @@ func (client *ServiceClient) Head(url string, opts *RequestOpts) (*http.Response
 
 // Request carries out the HTTP operation for the service client
 func (client *ServiceClient) Request(method, url string, options *RequestOpts) (*http.Response, error) {
+	if client.err != nil {
+		return nil, client.err
+	}
+	if options == nil {
+		options = new(RequestOpts)
+	}
 	if len(client.MoreHeaders) > 0 {
-		if options == nil {
-			options = new(RequestOpts)
+		if options.MoreHeaders == nil {
+			options.MoreHeaders = make(map[string]string)
 		}
 		for k, v := range client.MoreHeaders {
 			options.MoreHeaders[k] = v
 		}
 	}
+	options.context = client.ctx
+	options.spanAttributes = client.spanAttributes()
 	return client.ProviderClient.Request(method, url, options)
 }
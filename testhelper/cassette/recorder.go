@@ -0,0 +1,320 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+)
+
+// Mode selects how a Recorder handles requests.
+type Mode int
+
+const (
+	// ModePassthrough sends the requests to the API without recording them.
+	ModePassthrough Mode = iota
+	// ModeRecord sends the requests to the API and records the interactions.
+	ModeRecord
+	// ModeReplay serves the responses from the cassette without network access.
+	ModeReplay
+)
+
+// Redacted replaces scrubbed values in cassettes.
+const Redacted = "REDACTED"
+
+var (
+	// DefaultScrubHeaders are the headers scrubbed from recorded requests and responses.
+	DefaultScrubHeaders = []string{"Authorization", "X-Auth-Token", "X-Auth-AccessToken", "Cookie", "Set-Cookie"}
+	// DefaultScrubFields are the JSON body fields scrubbed from recorded requests and responses.
+	DefaultScrubFields = []string{"access", "refresh", "password", "token", "api_token", "secret"}
+)
+
+// ErrUnmatchedRequest is returned in replay mode for requests that have no recorded interaction.
+var ErrUnmatchedRequest = errors.New("no recorded interaction matches the request")
+
+// Recorder is an http.RoundTripper recording or replaying HTTP interactions.
+type Recorder struct {
+	// Transport is used to send requests in record and passthrough modes.
+	Transport http.RoundTripper
+	// ScrubHeaders and ScrubFields list the headers and the JSON body fields whose values are replaced with Redacted.
+	ScrubHeaders []string
+	ScrubFields  []string
+
+	mode     Mode
+	path     string
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// New returns a recorder for the cassette file. In replay mode the cassette must exist.
+func New(mode Mode, path string, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	r := &Recorder{
+		Transport:    transport,
+		ScrubHeaders: DefaultScrubHeaders,
+		ScrubFields:  DefaultScrubFields,
+		mode:         mode,
+		path:         path,
+		cassette:     &Cassette{},
+	}
+	if mode == ModeReplay {
+		c, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = c
+		r.used = make([]bool, len(c.Interactions))
+	}
+	return r, nil
+}
+
+// Configure installs a recorder as the transport of the provider client, wrapping its current transport. The
+// transport is swapped in place: Configure must be called before the first request of the client and is not safe
+// to call while the client is in use by other goroutines.
+func Configure(client *gcorecloud.ProviderClient, mode Mode, path string) (*Recorder, error) {
+	r, err := New(mode, path, client.HTTPClient.Transport)
+	if err != nil {
+		return nil, err
+	}
+	client.HTTPClient.Transport = r
+	return r, nil
+}
+
+// Mode returns the recorder mode.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Interactions returns the interactions recorded or loaded so far.
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	interactions := make([]Interaction, len(r.cassette.Interactions))
+	copy(interactions, r.cassette.Interactions)
+	return interactions
+}
+
+// Unused returns the recorded interactions that were not replayed.
+func (r *Recorder) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var unused []Interaction
+	for i, used := range r.used {
+		if !used {
+			unused = append(unused, r.cassette.Interactions[i])
+		}
+	}
+	return unused
+}
+
+// Stop saves the cassette in record mode.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cassette.Save(r.path)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch r.mode {
+	case ModePassthrough:
+		return r.Transport.RoundTrip(req)
+	case ModeReplay:
+		return r.replay(req)
+	}
+
+	recorded, err := r.recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    r.scrubHeaders(resp.Header),
+			Body:       r.scrubBody(body),
+		},
+	})
+	r.mu.Unlock()
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	recorded, err := r.recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || !matches(interaction.Request, recorded) {
+			continue
+		}
+		r.used[i] = true
+		header := make(http.Header)
+		for k, v := range interaction.Response.Headers {
+			header[k] = append([]string(nil), v...)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrUnmatchedRequest, req.Method, req.URL.RequestURI())
+}
+
+// recordRequest captures a scrubbed copy of the request and restores its body.
+func (r *Recorder) recordRequest(req *http.Request) (Request, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return Request{}, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	var query map[string][]string
+	if q := req.URL.Query(); len(q) > 0 {
+		query = q
+	}
+	return Request{
+		Method:  req.Method,
+		Path:    req.URL.Path,
+		Query:   query,
+		Headers: r.scrubHeaders(req.Header),
+		Body:    r.scrubBody(body),
+	}, nil
+}
+
+func (r *Recorder) scrubHeaders(header http.Header) map[string][]string {
+	if len(header) == 0 {
+		return nil
+	}
+	result := make(map[string][]string, len(header))
+	for k, v := range header {
+		result[k] = append([]string(nil), v...)
+	}
+	for _, name := range r.ScrubHeaders {
+		key := http.CanonicalHeaderKey(name)
+		if _, ok := result[key]; ok {
+			result[key] = []string{Redacted}
+		}
+	}
+	return result
+}
+
+func (r *Recorder) scrubBody(body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return body
+	}
+	if !r.scrubValue(v) {
+		return body
+	}
+	scrubbed, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return scrubbed
+}
+
+// scrubValue redacts the scrubbed fields in place and reports whether anything was changed.
+func (r *Recorder) scrubValue(v interface{}) bool {
+	changed := false
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, item := range value {
+			if r.isScrubField(k) {
+				if _, ok := item.(string); ok && item != Redacted {
+					value[k] = Redacted
+					changed = true
+					continue
+				}
+			}
+			changed = r.scrubValue(item) || changed
+		}
+	case []interface{}:
+		for _, item := range value {
+			changed = r.scrubValue(item) || changed
+		}
+	}
+	return changed
+}
+
+func (r *Recorder) isScrubField(name string) bool {
+	for _, field := range r.ScrubFields {
+		if strings.EqualFold(field, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches compares requests on method, path, query and JSON-normalized body.
+func matches(recorded, actual Request) bool {
+	if recorded.Method != actual.Method || recorded.Path != actual.Path {
+		return false
+	}
+	if !equalQuery(recorded.Query, actual.Query) {
+		return false
+	}
+	return equalBody(recorded.Body, actual.Body)
+}
+
+func equalQuery(a, b map[string][]string) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(url.Values(a), url.Values(b))
+}
+
+func equalBody(a, b []byte) bool {
+	if bytes.Equal(bytes.TrimSpace(a), bytes.TrimSpace(b)) {
+		return true
+	}
+	var va, vb interface{}
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+var _ http.RoundTripper = (*Recorder)(nil)
@@ -0,0 +1,149 @@
+package testing
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/network/v1/networks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	th "github.com/G-Core/gcorelabscloud-go/testhelper"
+	"github.com/G-Core/gcorelabscloud-go/testhelper/cassette"
+	"github.com/G-Core/gcorelabscloud-go/testhelper/fakecloud"
+	"github.com/stretchr/testify/require"
+)
+
+func createNetwork(t *testing.T, client, taskClient *gcorecloud.ServiceClient) *networks.Network {
+	results, err := networks.Create(client, networks.CreateOpts{Name: "network", CreateRouter: true}).Extract()
+	require.NoError(t, err)
+	task, err := tasks.Get(taskClient, string(results.Tasks[0])).Extract()
+	require.NoError(t, err)
+	networkID, err := networks.ExtractNetworkIDFromTask(task)
+	require.NoError(t, err)
+	network, err := networks.Get(client, networkID).Extract()
+	require.NoError(t, err)
+	return network
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "networks.json")
+
+	server := fakecloud.New(fakecloud.Options{})
+	client := server.ServiceClient("networks", "v1")
+	taskClient := server.ServiceClient("tasks", "v1")
+	recorder, err := cassette.Configure(client.ProviderClient, cassette.ModeRecord, path)
+	require.NoError(t, err)
+	taskClient.ProviderClient.HTTPClient.Transport = recorder
+
+	recorded := createNetwork(t, client, taskClient)
+	require.Len(t, recorder.Interactions(), 3)
+	require.NoError(t, recorder.Stop())
+	server.Close()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), fakecloud.APIToken)
+	require.Contains(t, string(data), cassette.Redacted)
+
+	replayer, err := cassette.Configure(client.ProviderClient, cassette.ModeReplay, path)
+	require.NoError(t, err)
+	taskClient.ProviderClient.HTTPClient.Transport = replayer
+
+	replayed := createNetwork(t, client, taskClient)
+	require.Equal(t, recorded, replayed)
+	require.Len(t, replayer.Unused(), 0)
+
+	_, err = networks.Get(client, recorded.ID).Extract()
+	require.Error(t, err)
+	require.True(t, errors.Is(err, cassette.ErrUnmatchedRequest))
+}
+
+func TestReplayMatching(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "matching.json")
+	c := cassette.Cassette{Interactions: []cassette.Interaction{{
+		Request: cassette.Request{
+			Method: http.MethodPost,
+			Path:   "/v1/networks/1/1",
+			Query:  map[string][]string{"a": {"1"}, "b": {"2"}},
+			Body:   cassette.Body(`{"name": "network", "create_router": true}`),
+		},
+		Response: cassette.Response{
+			StatusCode: http.StatusCreated,
+			Headers:    map[string][]string{"Content-Type": {"application/json"}},
+			Body:       cassette.Body(`{"tasks": ["50f53a35-42ed-40c4-82b2-5a37fb3e00bc"]}`),
+		},
+	}}}
+	require.NoError(t, c.Save(path))
+
+	recorder, err := cassette.New(cassette.ModeReplay, path, nil)
+	require.NoError(t, err)
+
+	request := func(url, body string) (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+		require.NoError(t, err)
+		return recorder.RoundTrip(req)
+	}
+
+	_, err = request("http://example.com/v1/networks/1/1?b=2&a=1", `{"name":"other","create_router":true}`)
+	require.True(t, errors.Is(err, cassette.ErrUnmatchedRequest))
+	_, err = request("http://example.com/v1/networks/1/1?b=3&a=1", `{"create_router":true,"name":"network"}`)
+	require.True(t, errors.Is(err, cassette.ErrUnmatchedRequest))
+
+	resp, err := request("http://example.com/v1/networks/1/1?b=2&a=1", `{"create_router":true,"name":"network"}`)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	buf := new(bytes.Buffer)
+	_, err = buf.ReadFrom(resp.Body)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"tasks": ["50f53a35-42ed-40c4-82b2-5a37fb3e00bc"]}`, buf.String())
+
+	_, err = request("http://example.com/v1/networks/1/1?b=2&a=1", `{"create_router":true,"name":"network"}`)
+	require.True(t, errors.Is(err, cassette.ErrUnmatchedRequest))
+}
+
+func TestScrubAndPassthrough(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/auth/jwt/login", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"access": "secret-access", "refresh": "secret-refresh", "user": {"id": 1}}`))
+	})
+
+	path := filepath.Join(t.TempDir(), "auth.json")
+	recorder, err := cassette.New(cassette.ModeRecord, path, nil)
+	require.NoError(t, err)
+	httpClient := http.Client{Transport: recorder}
+
+	req, err := http.NewRequest(http.MethodPost, th.Endpoint()+"auth/jwt/login",
+		strings.NewReader(`{"username": "user", "password": "secret-password"}`))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer secret-header")
+	resp, err := httpClient.Do(req)
+	require.NoError(t, err)
+	buf := new(bytes.Buffer)
+	_, err = buf.ReadFrom(resp.Body)
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), "secret-access")
+	require.NoError(t, recorder.Stop())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "secret-")
+	require.Contains(t, string(data), `"user"`)
+
+	passthrough, err := cassette.New(cassette.ModePassthrough, path, nil)
+	require.NoError(t, err)
+	httpClient.Transport = passthrough
+	resp, err = httpClient.Post(th.Endpoint()+"auth/jwt/login", "application/json", strings.NewReader(`{}`))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Len(t, passthrough.Interactions(), 0)
+}
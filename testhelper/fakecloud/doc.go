@@ -0,0 +1,30 @@
+/*
+Package fakecloud provides a stateful in-memory fake of the GCore Cloud API.
+
+Unlike testhelper.SetupHTTP, which serves hand-written fixtures, the fake server
+keeps projects, networks, subnets, instances, volumes, images, snapshots,
+floating IPs, security groups, loadbalancers and pools in memory. Asynchronous
+operations return real-shaped task IDs and the tasks move from NEW to RUNNING to
+FINISHED after configurable delays, so full create/wait/get/delete flows can run
+offline.
+
+Instances of a bm flavor are baremetal servers: they are listed as bminstances,
+or as instances with include_baremetal, and only they can be rebuilt.
+
+Volumes with snapshots cannot be deleted. The ones deleted on the termination of
+their instance are detached and kept instead.
+
+Subnets get allocation pools of their host addresses but the gateway and, with
+DHCP enabled, a DHCP port on the first pool address.
+
+Example of creating a network against the fake server
+
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+
+	client := server.ServiceClient("networks", "v1")
+	results, err := networks.Create(client, networks.CreateOpts{Name: "test"}).Extract()
+	taskID := results.Tasks[0]
+	err = tasks.WaitForStatus(server.ServiceClient("tasks", "v1"), string(taskID), tasks.TaskStateFinished, 60, true)
+*/
+package fakecloud
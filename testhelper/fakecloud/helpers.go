@@ -0,0 +1,40 @@
+package fakecloud
+
+import (
+	"testing"
+	"time"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+)
+
+// taskWaitOpts are the waits of FinishedTask, short enough for the task delays of tests.
+var taskWaitOpts = gcorecloud.WaitOpts{Timeout: 10 * time.Second, Interval: 10 * time.Millisecond}
+
+// FinishedTask waits for the single task of the result to finish and returns it. The test fails otherwise.
+func (s *Server) FinishedTask(t testing.TB, result tasks.Result) *tasks.Task {
+	t.Helper()
+	results, err := result.Extract()
+	if err != nil {
+		t.Fatalf("starting task: %s", err)
+	}
+	if len(results.Tasks) != 1 {
+		t.Fatalf("expected 1 task, got %d", len(results.Tasks))
+	}
+	task, err := tasks.WaitForTask(s.ServiceClient("tasks", "v1"), string(results.Tasks[0]), taskWaitOpts)
+	if err != nil {
+		t.Fatalf("waiting for task %s: %s", results.Tasks[0], err)
+	}
+	return task
+}
+
+// CreatedID waits for the task of the result to finish and returns the ID of the resource it created.
+func (s *Server) CreatedID(t testing.TB, result tasks.Result, extract func(*tasks.Task) (string, error)) string {
+	t.Helper()
+	task := s.FinishedTask(t, result)
+	id, err := extract(task)
+	if err != nil {
+		t.Fatalf("extracting the created resource of task %s: %s", task.ID, err)
+	}
+	return id
+}
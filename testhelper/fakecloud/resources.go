@@ -0,0 +1,1732 @@
+package fakecloud
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	uuid "github.com/satori/go.uuid"
+)
+
+type scope struct {
+	projectID int
+	regionID  int
+}
+
+// record is a resource built by a create request which is inserted once its task finishes.
+type record struct {
+	collection *collection
+	object     map[string]interface{}
+}
+
+type actionFunc func(s *Server, c *collection, obj map[string]interface{}, body map[string]interface{}) (int, interface{})
+
+// detailActionFunc handles the requests to a sub resource of a resource, e.g. a member of a pool.
+type detailActionFunc func(s *Server, r *http.Request, obj map[string]interface{}, detailID string) (int, interface{})
+
+// methodActionFunc handles an action whose behavior depends on the request method.
+type methodActionFunc func(s *Server, r *http.Request, obj map[string]interface{}, body map[string]interface{}) (int, interface{})
+
+// collection keeps the resources of a kind in creation order.
+type collection struct {
+	// name is the singular resource name used in task types.
+	name string
+	// idField and nameField are the JSON fields holding the ID and the name of the resource.
+	idField   string
+	nameField string
+	// taskKey is the created_resources key. Resources without it are created and deleted synchronously.
+	taskKey       string
+	items         map[string]map[string]interface{}
+	order         []string
+	build         func(s *Server, sc scope, body map[string]interface{}) ([]record, error)
+	actions       map[string]actionFunc
+	detailActions map[string]detailActionFunc
+	methodActions map[string]methodActionFunc
+	// onInsert and onDelete maintain the relations between resources.
+	onInsert func(s *Server, obj map[string]interface{}) error
+	onDelete func(s *Server, obj map[string]interface{}, query url.Values)
+	// onUpdate applies a PATCH or PUT request. Only the name is updated without it.
+	onUpdate func(s *Server, obj map[string]interface{}, body map[string]interface{}) error
+	// deletable, if set, refuses the deletion of a resource with an error.
+	deletable func(s *Server, obj map[string]interface{}, query url.Values) error
+	// listed, if set, reports whether a resource is listed through the resource kind of the request.
+	listed func(kind string, query url.Values, obj map[string]interface{}) bool
+}
+
+func newCollections() map[string]*collection {
+	collections := map[string]*collection{
+		"networks": {
+			name: "network", idField: "id", nameField: "name", taskKey: "networks",
+			build: buildNetwork, onDelete: deleteNetwork,
+		},
+		"subnets": {
+			name: "subnet", idField: "id", nameField: "name", taskKey: "subnets",
+			build: buildSubnet, onInsert: insertSubnet, onDelete: deleteSubnet,
+		},
+		"volumes": {
+			name: "volume", idField: "id", nameField: "name", taskKey: "volumes",
+			build: buildVolume, onInsert: insertVolume, deletable: deletableVolume,
+			actions: map[string]actionFunc{
+				"attach": attachVolume,
+				"detach": detachVolume,
+				"revert": revertVolume,
+			},
+		},
+		"instances": {
+			name: "vm", idField: "instance_id", nameField: "instance_name", taskKey: "instances",
+			build: buildInstances, onDelete: deleteInstance, deletable: deletableInstance, listed: listedInstance,
+			actions: map[string]actionFunc{
+				"start":            instancePowerAction("ACTIVE", "active"),
+				"stop":             instancePowerAction("SHUTOFF", "stopped"),
+				"powercycle":       instanceRestartAction("HARD_REBOOT"),
+				"reboot":           instanceRestartAction("REBOOT"),
+				"suspend":          instancePowerAction("SUSPENDED", "suspended"),
+				"resume":           instancePowerAction("ACTIVE", "active"),
+				"interfaces":       listInstanceInterfaces,
+				"ports":            listInstancePorts,
+				"addsecuritygroup": instanceSecurityGroups(true),
+				"delsecuritygroup": instanceSecurityGroups(false),
+				"metadata":         instanceMetadata,
+				"changeflavor":     changeInstanceFlavor,
+				"rebuild":          rebuildInstance,
+			},
+		},
+		"images": {
+			name: "image", idField: "id", nameField: "name", taskKey: "images",
+			build: buildImage,
+		},
+		"snapshots": {
+			name: "snapshot", idField: "id", nameField: "name", taskKey: "snapshots",
+			build: buildSnapshot,
+		},
+		"floatingips": {
+			name: "floating_ip", idField: "id", nameField: "floating_ip_address", taskKey: "floatingips",
+			build: buildFloatingIP,
+			actions: map[string]actionFunc{
+				"assign":   assignFloatingIP,
+				"unassign": unassignFloatingIP,
+			},
+		},
+		"securitygroups": {
+			name: "security_group", idField: "id", nameField: "name",
+			build: buildSecurityGroup,
+			actions: map[string]actionFunc{
+				"rules": addSecurityGroupRule,
+			},
+			methodActions: map[string]methodActionFunc{
+				"metadata":  securityGroupMetadata,
+				"instances": securityGroupInstances,
+			},
+		},
+		"loadbalancers": {
+			name: "loadbalancer", idField: "id", nameField: "name", taskKey: "loadbalancers",
+			build: buildLoadBalancer,
+		},
+		"routers": {
+			name: "router", idField: "id", nameField: "name", taskKey: "routers",
+			build: buildRouter, onUpdate: updateRouter,
+			actions: map[string]actionFunc{
+				"attach": attachRouterSubnet,
+				"detach": detachRouterSubnet,
+			},
+		},
+		"reserved_fixed_ips": {
+			name: "reserved_fixed_ip", idField: "port_id", nameField: "name", taskKey: "ports",
+			build: buildReservedFixedIP, onUpdate: updateReservedFixedIP, onDelete: deleteReservedFixedIP,
+			methodActions: map[string]methodActionFunc{
+				"connected_devices": reservedFixedIPDevices,
+			},
+		},
+		"lbpools": {
+			name: "lbpool", idField: "id", nameField: "name", taskKey: "pools",
+			build: buildLBPool,
+			actions: map[string]actionFunc{
+				"member": addPoolMember,
+			},
+			detailActions: map[string]detailActionFunc{
+				"member": deletePoolMember,
+			},
+		},
+	}
+	for _, c := range collections {
+		c.items = make(map[string]map[string]interface{})
+	}
+	// Baremetal servers are instances served by another API, they are listed as instances on demand only.
+	collections["bminstances"] = collections["instances"]
+	return collections
+}
+
+func (c *collection) id(obj map[string]interface{}) string {
+	id, _ := obj[c.idField].(string)
+	return id
+}
+
+func inScope(obj map[string]interface{}, sc scope) bool {
+	return obj["project_id"] == sc.projectID && obj["region_id"] == sc.regionID
+}
+
+// list returns the resources of the scope. Query parameters matching a top level string field filter the results,
+// the name parameter filters on the resource name.
+func (c *collection) list(sc scope, query url.Values) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(c.order))
+	for _, id := range c.order {
+		obj := c.items[id]
+		if !inScope(obj, sc) || !c.matches(obj, query) {
+			continue
+		}
+		result = append(result, obj)
+	}
+	return result
+}
+
+func (c *collection) matches(obj map[string]interface{}, query url.Values) bool {
+	for key, values := range query {
+		if key == "limit" || key == "offset" {
+			continue
+		}
+		field := key
+		if key == "name" {
+			field = c.nameField
+		}
+		value, ok := obj[field].(string)
+		if ok && value != values[0] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *collection) get(sc scope, id string) (map[string]interface{}, bool) {
+	obj, ok := c.items[id]
+	if !ok || !inScope(obj, sc) {
+		return nil, false
+	}
+	return obj, true
+}
+
+func (c *collection) insert(s *Server, obj map[string]interface{}) error {
+	if c.onInsert != nil {
+		if err := c.onInsert(s, obj); err != nil {
+			return err
+		}
+	}
+	id := c.id(obj)
+	c.items[id] = obj
+	c.order = append(c.order, id)
+	return nil
+}
+
+func (c *collection) delete(s *Server, id string, query url.Values) {
+	obj, ok := c.items[id]
+	if !ok {
+		return
+	}
+	delete(c.items, id)
+	for i, itemID := range c.order {
+		if itemID == id {
+			c.order = append(c.order[:i:i], c.order[i+1:]...)
+			break
+		}
+	}
+	if c.onDelete != nil {
+		c.onDelete(s, obj, query)
+	}
+}
+
+func (s *Server) timestamp() string {
+	return s.now().UTC().Format(gcorecloud.RFC3339Z)
+}
+
+func (s *Server) baseObject(sc scope) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         uuid.NewV4().String(),
+		"project_id": sc.projectID,
+		"region_id":  sc.regionID,
+		"region":     RegionName,
+		"created_at": s.timestamp(),
+	}
+}
+
+func metadataList(raw interface{}) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	m, _ := raw.(map[string]interface{})
+	for k, v := range m {
+		result = append(result, map[string]interface{}{"key": k, "value": fmt.Sprint(v), "read_only": false})
+	}
+	return result
+}
+
+func stringField(body map[string]interface{}, key, defaultValue string) string {
+	if v, ok := body[key].(string); ok && v != "" {
+		return v
+	}
+	return defaultValue
+}
+
+func intField(body map[string]interface{}, key string, defaultValue int) int {
+	if v, ok := body[key].(float64); ok {
+		return int(v)
+	}
+	return defaultValue
+}
+
+func boolField(body map[string]interface{}, key string, defaultValue bool) bool {
+	if v, ok := body[key].(bool); ok {
+		return v
+	}
+	return defaultValue
+}
+
+func buildNetwork(s *Server, sc scope, body map[string]interface{}) ([]record, error) {
+	name := stringField(body, "name", "")
+	if name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	obj := s.baseObject(sc)
+	obj["name"] = name
+	obj["mtu"] = intField(body, "mtu", 1450)
+	obj["type"] = stringField(body, "type", "vxlan")
+	obj["subnets"] = []interface{}{}
+	obj["updated_at"] = nil
+	obj["external"] = false
+	obj["default"] = false
+	obj["shared"] = false
+	obj["task_id"] = nil
+	obj["metadata"] = metadataList(body["metadata"])
+	return []record{{collection: s.collections["networks"], object: obj}}, nil
+}
+
+func deleteNetwork(s *Server, obj map[string]interface{}, _ url.Values) {
+	subnets := s.collections["subnets"]
+	for _, id := range append([]string(nil), subnets.order...) {
+		if subnets.items[id]["network_id"] == obj["id"] {
+			subnets.delete(s, id, nil)
+		}
+	}
+}
+
+func buildSubnet(s *Server, sc scope, body map[string]interface{}) ([]record, error) {
+	name := stringField(body, "name", "")
+	networkID := stringField(body, "network_id", "")
+	if name == "" || networkID == "" {
+		return nil, fmt.Errorf("name and network_id are required")
+	}
+	_, ipNet, err := net.ParseCIDR(stringField(body, "cidr", ""))
+	if err != nil {
+		return nil, err
+	}
+	ones, bits := ipNet.Mask.Size()
+	total := 1<<uint(bits-ones) - 2
+	gateway := stringField(body, "gateway_ip", hostAddress(ipNet, 1).String())
+	obj := s.baseObject(sc)
+	obj["name"] = name
+	obj["ip_version"] = 4
+	obj["enable_dhcp"] = boolField(body, "enable_dhcp", true)
+	obj["cidr"] = ipNet.String()
+	obj["updated_at"] = obj["created_at"]
+	obj["network_id"] = networkID
+	obj["task_id"] = ""
+	obj["creator_task_id"] = ""
+	obj["available_ips"] = total - 1
+	obj["total_ips"] = total
+	obj["has_router"] = boolField(body, "connect_to_network_router", true)
+	obj["dns_nameservers"] = body["dns_nameservers"]
+	obj["host_routes"] = body["host_routes"]
+	obj["gateway_ip"] = gateway
+	obj["allocation_pools"] = allocationPools(ipNet, net.ParseIP(gateway))
+	obj["metadata"] = metadataList(body["metadata"])
+	return []record{{collection: s.collections["subnets"], object: obj}}, nil
+}
+
+// allocationPools returns the default allocation pools of a subnet: its host addresses but the gateway.
+func allocationPools(ipNet *net.IPNet, gateway net.IP) []interface{} {
+	ones, bits := ipNet.Mask.Size()
+	last := 1<<uint(bits-ones) - 2
+	bounds := [][2]int{{1, last}}
+	if gw := gateway.To4(); gw != nil && ipNet.Contains(gw) {
+		offset := int(binary.BigEndian.Uint32(gw) - binary.BigEndian.Uint32(ipNet.IP.To4()))
+		bounds = [][2]int{{1, offset - 1}, {offset + 1, last}}
+	}
+	pools := []interface{}{}
+	for _, b := range bounds {
+		if b[0] <= b[1] {
+			pools = append(pools, map[string]interface{}{
+				"start": hostAddress(ipNet, b[0]).String(),
+				"end":   hostAddress(ipNet, b[1]).String(),
+			})
+		}
+	}
+	return pools
+}
+
+func insertSubnet(s *Server, obj map[string]interface{}) error {
+	network, ok := s.collections["networks"].items[obj["network_id"].(string)]
+	if !ok {
+		return fmt.Errorf("network %s not found", obj["network_id"])
+	}
+	network["subnets"] = append(network["subnets"].([]interface{}), obj["id"])
+	pools := obj["allocation_pools"].([]interface{})
+	if obj["enable_dhcp"] == true && len(pools) > 0 {
+		s.ipCounter++
+		s.dhcpPorts[obj["id"].(string)] = map[string]interface{}{
+			"port_id":               uuid.NewV4().String(),
+			"network_id":            obj["network_id"],
+			"mac_address":           fmt.Sprintf("fa:16:3e:%02x:%02x:%02x", s.ipCounter>>16&0xff, s.ipCounter>>8&0xff, s.ipCounter&0xff),
+			"ip_assignments":        []interface{}{map[string]interface{}{"ip_address": pools[0].(map[string]interface{})["start"], "subnet_id": obj["id"]}},
+			"port_security_enabled": false,
+			"allowed_address_pairs": []interface{}{},
+			"security_groups":       []interface{}{},
+		}
+	}
+	return nil
+}
+
+func deleteSubnet(s *Server, obj map[string]interface{}, _ url.Values) {
+	delete(s.dhcpPorts, obj["id"].(string))
+	network, ok := s.collections["networks"].items[obj["network_id"].(string)]
+	if !ok {
+		return
+	}
+	var subnets []interface{}
+	for _, id := range network["subnets"].([]interface{}) {
+		if id != obj["id"] {
+			subnets = append(subnets, id)
+		}
+	}
+	if subnets == nil {
+		subnets = []interface{}{}
+	}
+	network["subnets"] = subnets
+}
+
+// hostAddress returns the n-th address of the network.
+func hostAddress(ipNet *net.IPNet, n int) net.IP {
+	ip := ipNet.IP.To4()
+	if ip == nil {
+		return ipNet.IP
+	}
+	result := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(result, binary.BigEndian.Uint32(ip)+uint32(n))
+	return result
+}
+
+func (s *Server) newVolume(sc scope, body map[string]interface{}) map[string]interface{} {
+	obj := s.baseObject(sc)
+	source := stringField(body, "source", "new-volume")
+	obj["name"] = stringField(body, "name", "volume")
+	obj["size"] = intField(body, "size", 1)
+	obj["volume_type"] = stringField(body, "type_name", "standard")
+	obj["status"] = "available"
+	obj["bootable"] = source == "image"
+	obj["availability_zone"] = "nova"
+	obj["updated_at"] = obj["created_at"]
+	obj["snapshot_id"] = stringField(body, "snapshot_id", "")
+	obj["source_volid"] = ""
+	obj["attachments"] = []interface{}{}
+	obj["metadata_detailed"] = metadataList(body["metadata"])
+	obj["creator_task_id"] = ""
+	obj["volume_image_metadata"] = map[string]interface{}{"image_id": stringField(body, "image_id", "")}
+	return obj
+}
+
+func buildVolume(s *Server, sc scope, body map[string]interface{}) ([]record, error) {
+	if stringField(body, "name", "") == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	obj := s.newVolume(sc, body)
+	if instanceID := stringField(body, "instance_id_to_attach_to", ""); instanceID != "" {
+		obj["instance_id_to_attach_to"] = instanceID
+	}
+	return []record{{collection: s.collections["volumes"], object: obj}}, nil
+}
+
+func insertVolume(s *Server, obj map[string]interface{}) error {
+	instanceID, ok := obj["instance_id_to_attach_to"].(string)
+	if !ok {
+		return nil
+	}
+	delete(obj, "instance_id_to_attach_to")
+	instance, ok := s.collections["instances"].items[instanceID]
+	if !ok {
+		return fmt.Errorf("instance %s not found", instanceID)
+	}
+	s.attach(obj, instance, false)
+	return nil
+}
+
+// attach links a volume with an instance.
+func (s *Server) attach(volume, instance map[string]interface{}, deleteOnTermination bool) {
+	volumes, _ := instance["volumes"].([]interface{})
+	device := fmt.Sprintf("/dev/vd%c", 'a'+len(volumes))
+	volume["status"] = "in-use"
+	volume["attachments"] = []interface{}{map[string]interface{}{
+		"server_id":     instance["instance_id"],
+		"attachment_id": uuid.NewV4().String(),
+		"instance_name": instance["instance_name"],
+		"attached_at":   s.timestamp(),
+		"volume_id":     volume["id"],
+		"device":        device,
+	}}
+	instance["volumes"] = append(volumes, map[string]interface{}{
+		"id":                    volume["id"],
+		"delete_on_termination": deleteOnTermination,
+	})
+}
+
+// detach unlinks a volume from an instance.
+func detach(volume, instance map[string]interface{}) {
+	volume["status"] = "available"
+	volume["attachments"] = []interface{}{}
+	if instance == nil {
+		return
+	}
+	volumes := []interface{}{}
+	for _, v := range instance["volumes"].([]interface{}) {
+		if v.(map[string]interface{})["id"] != volume["id"] {
+			volumes = append(volumes, v)
+		}
+	}
+	instance["volumes"] = volumes
+}
+
+func attachVolume(s *Server, _ *collection, obj map[string]interface{}, body map[string]interface{}) (int, interface{}) {
+	instance, ok := s.collections["instances"].items[stringField(body, "instance_id", "")]
+	if !ok {
+		return http.StatusNotFound, map[string]interface{}{"message": "instance not found"}
+	}
+	if obj["status"] == "in-use" {
+		return http.StatusConflict, map[string]interface{}{"message": fmt.Sprintf("volume %s is in use", obj["id"])}
+	}
+	s.attach(obj, instance, false)
+	return http.StatusOK, obj
+}
+
+func detachVolume(s *Server, _ *collection, obj map[string]interface{}, body map[string]interface{}) (int, interface{}) {
+	detach(obj, s.collections["instances"].items[stringField(body, "instance_id", "")])
+	return http.StatusOK, obj
+}
+
+// revertVolume reverts a volume to its latest snapshot. The volume should be detached or its instance stopped.
+func revertVolume(s *Server, _ *collection, obj map[string]interface{}, _ map[string]interface{}) (int, interface{}) {
+	var latest map[string]interface{}
+	snapshots := s.collections["snapshots"]
+	for _, id := range snapshots.order {
+		if snapshots.items[id]["volume_id"] == obj["id"] {
+			latest = snapshots.items[id]
+		}
+	}
+	if latest == nil {
+		return http.StatusBadRequest, map[string]interface{}{"message": fmt.Sprintf("volume %s has no snapshots", obj["id"])}
+	}
+	if attachments, _ := obj["attachments"].([]interface{}); len(attachments) > 0 {
+		serverID := attachments[0].(map[string]interface{})["server_id"]
+		if instance, ok := s.collections["instances"].items[fmt.Sprint(serverID)]; ok && instance["status"] != "SHUTOFF" {
+			return http.StatusConflict, map[string]interface{}{"message": fmt.Sprintf("volume %s is in use", obj["id"])}
+		}
+	}
+	return s.taskResponse("revert_volume", obj, func() (map[string][]string, error) {
+		obj["size"] = latest["size"]
+		obj["updated_at"] = s.timestamp()
+		return nil, nil
+	})
+}
+
+func buildInstances(s *Server, sc scope, body map[string]interface{}) ([]record, error) {
+	flavor := stringField(body, "flavor", "")
+	if flavor == "" {
+		return nil, fmt.Errorf("flavor is required")
+	}
+	var names []string
+	for _, key := range []string{"names", "name_templates"} {
+		raw, _ := body[key].([]interface{})
+		for _, n := range raw {
+			names = append(names, fmt.Sprint(n))
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("names or name_templates are required")
+	}
+	interfaces, _ := body["interfaces"].([]interface{})
+	if len(interfaces) == 0 {
+		return nil, fmt.Errorf("interfaces are required")
+	}
+
+	var securityGroups []interface{}
+	sgIDs, _ := body["security_groups"].([]interface{})
+	for _, raw := range sgIDs {
+		id, _ := raw.(map[string]interface{})["id"].(string)
+		if sg, ok := s.collections["securitygroups"].items[id]; ok {
+			securityGroups = append(securityGroups, map[string]interface{}{"name": sg["name"]})
+		}
+	}
+	if securityGroups == nil {
+		securityGroups = []interface{}{map[string]interface{}{"name": "default"}}
+	}
+	metadata, _ := body["metadata"].(map[string]interface{})
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+
+	var records []record
+	for _, name := range names {
+		id := uuid.NewV4().String()
+		instance := map[string]interface{}{
+			"instance_id":          id,
+			"instance_name":        name,
+			"instance_description": "",
+			"instance_created":     s.now().UTC().Format(gcorecloud.RFC3339ZZ),
+			"status":               "ACTIVE",
+			"vm_state":             "active",
+			"task_state":           nil,
+			"flavor": map[string]interface{}{
+				"flavor_id":   flavor,
+				"flavor_name": flavor,
+				"vcpus":       1,
+				"ram":         1024,
+			},
+			"metadata":          metadata,
+			"volumes":           []interface{}{},
+			"addresses":         map[string]interface{}{},
+			"security_groups":   securityGroups,
+			"creator_task_id":   nil,
+			"task_id":           nil,
+			"project_id":        sc.projectID,
+			"region_id":         sc.regionID,
+			"region":            RegionName,
+			"availability_zone": "nova",
+		}
+		s.ports[id] = s.buildInterfaces(interfaces)
+		for _, port := range s.ports[id] {
+			port.(map[string]interface{})["security_groups"] = append([]interface{}{}, securityGroups...)
+		}
+		records = append(records, record{collection: s.collections["instances"], object: instance})
+
+		volumes, _ := body["volumes"].([]interface{})
+		for _, raw := range volumes {
+			spec := raw.(map[string]interface{})
+			if volumeID := stringField(spec, "volume_id", ""); volumeID != "" {
+				if volume, ok := s.collections["volumes"].items[volumeID]; ok {
+					s.attach(volume, instance, boolField(spec, "delete_on_termination", false))
+				}
+				continue
+			}
+			if _, ok := spec["name"]; !ok {
+				spec["name"] = name
+			}
+			volume := s.newVolume(sc, spec)
+			s.attach(volume, instance, boolField(spec, "delete_on_termination", true))
+			records = append(records, record{collection: s.collections["volumes"], object: volume})
+		}
+	}
+	for _, rec := range records {
+		if rec.collection.name == "vm" {
+			rec.object["addresses"] = s.instanceAddresses(rec.object)
+		}
+	}
+	return records, nil
+}
+
+// buildInterfaces creates the ports of an instance.
+func (s *Server) buildInterfaces(specs []interface{}) []interface{} {
+	var result []interface{}
+	for _, raw := range specs {
+		spec := raw.(map[string]interface{})
+		s.ipCounter++
+		port := map[string]interface{}{
+			"port_id":               uuid.NewV4().String(),
+			"mac_address":           fmt.Sprintf("fa:16:3e:%02x:%02x:%02x", s.ipCounter>>16&0xff, s.ipCounter>>8&0xff, s.ipCounter&0xff),
+			"port_security_enabled": true,
+			"floatingip_details":    []interface{}{},
+			"sub_ports":             []interface{}{},
+			"allowed_address_pairs": []interface{}{},
+			"security_groups":       []interface{}{},
+		}
+		var subnet map[string]interface{}
+		if subnetID := stringField(spec, "subnet_id", ""); subnetID != "" {
+			subnet = s.collections["subnets"].items[subnetID]
+		} else if networkID := stringField(spec, "network_id", ""); networkID != "" {
+			for _, id := range s.collections["subnets"].order {
+				if s.collections["subnets"].items[id]["network_id"] == networkID {
+					subnet = s.collections["subnets"].items[id]
+					break
+				}
+			}
+		}
+		if subnet != nil {
+			_, ipNet, _ := net.ParseCIDR(subnet["cidr"].(string))
+			address := stringField(spec, "ip_address", hostAddress(ipNet, s.ipCounter%250+2).String())
+			port["network_id"] = subnet["network_id"]
+			port["ip_assignments"] = []interface{}{map[string]interface{}{"ip_address": address, "subnet_id": subnet["id"]}}
+		} else {
+			port["network_id"] = ""
+			port["ip_assignments"] = []interface{}{map[string]interface{}{"ip_address": s.nextIP("203.0"), "subnet_id": ""}}
+		}
+		port["network_details"] = s.networkDetails(port["network_id"].(string))
+		result = append(result, port)
+	}
+	return result
+}
+
+func (s *Server) networkDetails(networkID string) map[string]interface{} {
+	network, ok := s.collections["networks"].items[networkID]
+	if !ok {
+		return map[string]interface{}{"name": "public", "external": true, "subnets": []interface{}{}}
+	}
+	return map[string]interface{}{
+		"id":         network["id"],
+		"name":       network["name"],
+		"mtu":        network["mtu"],
+		"created_at": network["created_at"],
+		"project_id": network["project_id"],
+		"region_id":  network["region_id"],
+		"region":     network["region"],
+		"subnets":    []interface{}{},
+	}
+}
+
+// instanceAddresses builds the addresses field of an instance from its ports.
+func (s *Server) instanceAddresses(instance map[string]interface{}) map[string]interface{} {
+	addresses := make(map[string]interface{})
+	for _, raw := range s.ports[instance["instance_id"].(string)] {
+		port := raw.(map[string]interface{})
+		networkName := port["network_details"].(map[string]interface{})["name"].(string)
+		list, _ := addresses[networkName].([]interface{})
+		for _, a := range port["ip_assignments"].([]interface{}) {
+			assignment := a.(map[string]interface{})
+			address := map[string]interface{}{"addr": assignment["ip_address"], "type": "fixed"}
+			if subnet, ok := s.collections["subnets"].items[fmt.Sprint(assignment["subnet_id"])]; ok {
+				address["subnet_id"] = subnet["id"]
+				address["subnet_name"] = subnet["name"]
+			}
+			list = append(list, address)
+		}
+		for _, f := range port["floatingip_details"].([]interface{}) {
+			fip := f.(map[string]interface{})
+			list = append(list, map[string]interface{}{"addr": fip["floating_ip_address"], "type": "floating"})
+		}
+		addresses[networkName] = list
+	}
+	return addresses
+}
+
+func instancePowerAction(status, vmState string) actionFunc {
+	return func(s *Server, _ *collection, obj map[string]interface{}, _ map[string]interface{}) (int, interface{}) {
+		obj["status"] = status
+		obj["vm_state"] = vmState
+		return http.StatusOK, obj
+	}
+}
+
+// instanceRestartAction puts an instance in the status of a reboot, it is ACTIVE again from the next request on.
+func instanceRestartAction(status string) actionFunc {
+	return func(s *Server, _ *collection, obj map[string]interface{}, _ map[string]interface{}) (int, interface{}) {
+		obj["status"] = status
+		obj["vm_state"] = "active"
+		obj["task_state"] = "rebooting"
+		s.restarts = append(s.restarts, obj)
+		return http.StatusOK, obj
+	}
+}
+
+func listInstanceInterfaces(s *Server, _ *collection, obj map[string]interface{}, _ map[string]interface{}) (int, interface{}) {
+	interfaces := s.ports[obj["instance_id"].(string)]
+	return http.StatusOK, map[string]interface{}{"count": len(interfaces), "results": interfaces}
+}
+
+// listInstancePorts lists the ports of an instance with their security groups.
+func listInstancePorts(s *Server, _ *collection, obj map[string]interface{}, _ map[string]interface{}) (int, interface{}) {
+	result := []interface{}{}
+	for _, raw := range s.ports[obj["instance_id"].(string)] {
+		port := raw.(map[string]interface{})
+		result = append(result, map[string]interface{}{
+			"id":              port["port_id"],
+			"name":            port["port_id"],
+			"security_groups": port["security_groups"],
+		})
+	}
+	return http.StatusOK, map[string]interface{}{"count": len(result), "results": result}
+}
+
+// instanceSecurityGroups adds or removes security groups by name, on every port of an instance with name or on the
+// ports listed in ports_security_group_names. The security groups of the instance are the ones of its ports.
+func instanceSecurityGroups(add bool) actionFunc {
+	return func(s *Server, _ *collection, obj map[string]interface{}, body map[string]interface{}) (int, interface{}) {
+		ports := s.ports[obj["instance_id"].(string)]
+		changes := map[string][]string{}
+		if name := stringField(body, "name", ""); name != "" {
+			for _, raw := range ports {
+				portID := raw.(map[string]interface{})["port_id"].(string)
+				changes[portID] = append(changes[portID], name)
+			}
+		}
+		specs, _ := body["ports_security_group_names"].([]interface{})
+		for _, raw := range specs {
+			spec := raw.(map[string]interface{})
+			portID := stringField(spec, "port_id", "")
+			names, _ := spec["security_group_names"].([]interface{})
+			for _, name := range names {
+				changes[portID] = append(changes[portID], fmt.Sprint(name))
+			}
+		}
+		for portID, names := range changes {
+			var port map[string]interface{}
+			for _, raw := range ports {
+				if raw.(map[string]interface{})["port_id"] == portID {
+					port = raw.(map[string]interface{})
+				}
+			}
+			if port == nil {
+				return http.StatusNotFound, map[string]interface{}{"message": fmt.Sprintf("port %s not found", portID)}
+			}
+			for _, name := range names {
+				if add && !s.securityGroupExists(obj, name) {
+					return http.StatusNotFound, map[string]interface{}{"message": fmt.Sprintf("security group %s not found", name)}
+				}
+			}
+			groups := []interface{}{}
+			for _, group := range port["security_groups"].([]interface{}) {
+				if !containsString(names, group.(map[string]interface{})["name"].(string)) {
+					groups = append(groups, group)
+				}
+			}
+			if add {
+				for _, name := range names {
+					groups = append(groups, map[string]interface{}{"name": name})
+				}
+			}
+			port["security_groups"] = groups
+		}
+
+		groups := []interface{}{}
+		var seen []string
+		for _, raw := range ports {
+			for _, group := range raw.(map[string]interface{})["security_groups"].([]interface{}) {
+				if name := group.(map[string]interface{})["name"].(string); !containsString(seen, name) {
+					seen = append(seen, name)
+					groups = append(groups, group)
+				}
+			}
+		}
+		obj["security_groups"] = groups
+		return http.StatusNoContent, nil
+	}
+}
+
+// securityGroupExists reports whether a security group of the name exists in the scope of the instance.
+func (s *Server) securityGroupExists(instance map[string]interface{}, name string) bool {
+	if name == "default" {
+		return true
+	}
+	groups := s.collections["securitygroups"]
+	for _, id := range groups.order {
+		sg := groups.items[id]
+		if sg["name"] == name && sg["project_id"] == instance["project_id"] && sg["region_id"] == instance["region_id"] {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// instanceMetadata merges the request body into the metadata of an instance and lists it.
+func instanceMetadata(s *Server, _ *collection, obj map[string]interface{}, body map[string]interface{}) (int, interface{}) {
+	metadata := obj["metadata"].(map[string]interface{})
+	for k, v := range body {
+		metadata[k] = v
+	}
+	list := metadataList(metadata)
+	return http.StatusOK, map[string]interface{}{"count": len(list), "results": list}
+}
+
+// taskResponse registers a task applying the change and returns the response of the action.
+func (s *Server) taskResponse(taskType string, obj map[string]interface{}, apply applyFunc) (int, interface{}) {
+	projectID, _ := obj["project_id"].(int)
+	t := s.newTask(taskType, projectID, apply)
+	return http.StatusOK, map[string]interface{}{"tasks": []string{t.id()}}
+}
+
+func changeInstanceFlavor(s *Server, _ *collection, obj map[string]interface{}, body map[string]interface{}) (int, interface{}) {
+	flavorID := stringField(body, "flavor_id", "")
+	if flavorID == "" {
+		return http.StatusBadRequest, map[string]interface{}{"message": "flavor_id is required"}
+	}
+	return s.taskResponse("resize_vm", obj, func() (map[string][]string, error) {
+		flavor := obj["flavor"].(map[string]interface{})
+		flavor["flavor_id"] = flavorID
+		flavor["flavor_name"] = flavorID
+		obj["status"] = "ACTIVE"
+		obj["vm_state"] = "active"
+		return nil, nil
+	})
+}
+
+// baremetal reports whether the instance is a baremetal server, that is whether its flavor is a bm flavor.
+func baremetal(instance map[string]interface{}) bool {
+	flavor, _ := instance["flavor"].(map[string]interface{})
+	id, _ := flavor["flavor_id"].(string)
+	return strings.HasPrefix(id, "bm")
+}
+
+// listedInstance lists the baremetal servers only as bminstances, or as instances with include_baremetal.
+func listedInstance(kind string, query url.Values, obj map[string]interface{}) bool {
+	if kind == "bminstances" {
+		return baremetal(obj)
+	}
+	return !baremetal(obj) || query.Get("include_baremetal") == "true"
+}
+
+func rebuildInstance(s *Server, _ *collection, obj map[string]interface{}, body map[string]interface{}) (int, interface{}) {
+	if !baremetal(obj) {
+		return http.StatusBadRequest, map[string]interface{}{"message": fmt.Sprintf("instance %s is not a baremetal server", obj["instance_id"])}
+	}
+	imageID := stringField(body, "image_id", "")
+	if imageID == "" {
+		return http.StatusBadRequest, map[string]interface{}{"message": "image_id is required"}
+	}
+	return s.taskResponse("rebuild_bm", obj, func() (map[string][]string, error) {
+		obj["image_id"] = imageID
+		obj["status"] = "ACTIVE"
+		obj["vm_state"] = "active"
+		return nil, nil
+	})
+}
+
+// deletableVolume refuses the deletion of a volume which has snapshots.
+func deletableVolume(s *Server, obj map[string]interface{}, _ url.Values) error {
+	for _, id := range s.collections["snapshots"].order {
+		if s.collections["snapshots"].items[id]["volume_id"] == obj["id"] {
+			return fmt.Errorf("volume %s has snapshots", obj["id"])
+		}
+	}
+	return nil
+}
+
+// deletableInstance refuses the deletion of an instance along with a requested volume which has snapshots. The
+// volumes deleted on termination are kept instead, see deleteInstance.
+func deletableInstance(s *Server, _ map[string]interface{}, query url.Values) error {
+	for _, id := range strings.Split(query.Get("volumes"), ",") {
+		if volume, ok := s.collections["volumes"].items[id]; ok {
+			if err := deletableVolume(s, volume, nil); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func deleteInstance(s *Server, obj map[string]interface{}, query url.Values) {
+	deleteVolumes := make(map[string]bool)
+	for _, id := range strings.Split(query.Get("volumes"), ",") {
+		deleteVolumes[id] = true
+	}
+	volumes := s.collections["volumes"]
+	for _, raw := range obj["volumes"].([]interface{}) {
+		v := raw.(map[string]interface{})
+		id := v["id"].(string)
+		volume, ok := volumes.items[id]
+		if !ok {
+			continue
+		}
+		if deleteVolumes[id] || (v["delete_on_termination"] == true && deletableVolume(s, volume, nil) == nil) {
+			volumes.delete(s, id, nil)
+			continue
+		}
+		detach(volume, nil)
+	}
+
+	deleteFloatings := query.Get("delete_floatings") == "true"
+	deleteFloatingIDs := make(map[string]bool)
+	for _, id := range strings.Split(query.Get("floatings"), ",") {
+		deleteFloatingIDs[id] = true
+	}
+	fips := s.collections["floatingips"]
+	for _, id := range append([]string(nil), fips.order...) {
+		fip := fips.items[id]
+		instance, _ := fip["instance"].(map[string]interface{})
+		if instance == nil || instance["instance_id"] != obj["instance_id"] {
+			continue
+		}
+		if deleteFloatings || deleteFloatingIDs[id] {
+			fips.delete(s, id, nil)
+			continue
+		}
+		s.unassign(fip)
+	}
+	delete(s.ports, obj["instance_id"].(string))
+}
+
+func buildFloatingIP(s *Server, sc scope, body map[string]interface{}) ([]record, error) {
+	obj := s.baseObject(sc)
+	obj["floating_ip_address"] = s.nextIP("198.51")
+	obj["router_id"] = ""
+	obj["subnet_id"] = ""
+	obj["dns_domain"] = ""
+	obj["dns_name"] = ""
+	obj["updated_at"] = nil
+	obj["creator_task_id"] = nil
+	obj["metadata"] = metadataList(body["metadata"])
+	s.unassign(obj)
+	if portID := stringField(body, "port_id", ""); portID != "" {
+		if err := s.assign(obj, portID, stringField(body, "fixed_ip_address", "")); err != nil {
+			return nil, err
+		}
+	}
+	return []record{{collection: s.collections["floatingips"], object: obj}}, nil
+}
+
+// assign links a floating IP with an instance port or a reserved fixed IP port.
+func (s *Server) assign(fip map[string]interface{}, portID, fixedIP string) error {
+	instances := s.collections["instances"]
+	for _, id := range instances.order {
+		instance := instances.items[id]
+		for _, raw := range s.ports[id] {
+			port := raw.(map[string]interface{})
+			if port["port_id"] != portID {
+				continue
+			}
+			if fixedIP == "" {
+				fixedIP = port["ip_assignments"].([]interface{})[0].(map[string]interface{})["ip_address"].(string)
+			}
+			fip["port_id"] = portID
+			fip["fixed_ip_address"] = fixedIP
+			fip["status"] = "ACTIVE"
+			fip["instance"] = instance
+			port["floatingip_details"] = append(port["floatingip_details"].([]interface{}), map[string]interface{}{
+				"id":                  fip["id"],
+				"floating_ip_address": fip["floating_ip_address"],
+				"fixed_ip_address":    fixedIP,
+				"port_id":             portID,
+				"status":              "ACTIVE",
+			})
+			instance["addresses"] = s.instanceAddresses(instance)
+			return nil
+		}
+	}
+	if reserved, ok := s.collections["reserved_fixed_ips"].items[portID]; ok {
+		if fixedIP == "" {
+			fixedIP = reserved["fixed_ip_address"].(string)
+		}
+		fip["port_id"] = portID
+		fip["fixed_ip_address"] = fixedIP
+		fip["status"] = "ACTIVE"
+		return nil
+	}
+	return fmt.Errorf("port %s not found", portID)
+}
+
+// unassign unlinks a floating IP from its port.
+func (s *Server) unassign(fip map[string]interface{}) {
+	if instance, ok := fip["instance"].(map[string]interface{}); ok {
+		for _, raw := range s.ports[instance["instance_id"].(string)] {
+			port := raw.(map[string]interface{})
+			details := []interface{}{}
+			for _, d := range port["floatingip_details"].([]interface{}) {
+				if d.(map[string]interface{})["id"] != fip["id"] {
+					details = append(details, d)
+				}
+			}
+			port["floatingip_details"] = details
+		}
+	}
+	fip["port_id"] = nil
+	fip["fixed_ip_address"] = nil
+	fip["status"] = "DOWN"
+	delete(fip, "instance")
+}
+
+func assignFloatingIP(s *Server, _ *collection, obj map[string]interface{}, body map[string]interface{}) (int, interface{}) {
+	s.unassign(obj)
+	if err := s.assign(obj, stringField(body, "port_id", ""), stringField(body, "fixed_ip_address", "")); err != nil {
+		return http.StatusBadRequest, map[string]interface{}{"message": err.Error()}
+	}
+	return http.StatusOK, obj
+}
+
+func unassignFloatingIP(s *Server, _ *collection, obj map[string]interface{}, _ map[string]interface{}) (int, interface{}) {
+	instance, _ := obj["instance"].(map[string]interface{})
+	s.unassign(obj)
+	if instance != nil {
+		instance["addresses"] = s.instanceAddresses(instance)
+	}
+	return http.StatusOK, obj
+}
+
+func buildSecurityGroup(s *Server, sc scope, body map[string]interface{}) ([]record, error) {
+	spec, _ := body["security_group"].(map[string]interface{})
+	if stringField(spec, "name", "") == "" {
+		return nil, fmt.Errorf("security_group.name is required")
+	}
+	obj := s.baseObject(sc)
+	obj["name"] = spec["name"]
+	obj["description"] = stringField(spec, "description", "")
+	obj["updated_at"] = nil
+	obj["revision_number"] = 0
+	obj["tags"] = []interface{}{}
+	obj["metadata"] = metadataList(spec["metadata"])
+	rules := []interface{}{}
+	raw, _ := spec["security_group_rules"].([]interface{})
+	for _, r := range raw {
+		rules = append(rules, s.newSecurityGroupRule(obj["id"].(string), r.(map[string]interface{})))
+	}
+	obj["security_group_rules"] = rules
+	return []record{{collection: s.collections["securitygroups"], object: obj}}, nil
+}
+
+func (s *Server) newSecurityGroupRule(securityGroupID string, body map[string]interface{}) map[string]interface{} {
+	rule := map[string]interface{}{
+		"id":                uuid.NewV4().String(),
+		"security_group_id": securityGroupID,
+		"created_at":        s.timestamp(),
+		"updated_at":        nil,
+		"revision_number":   0,
+	}
+	for _, key := range []string{"direction", "ethertype", "protocol", "remote_group_id", "port_range_min",
+		"port_range_max", "description", "remote_ip_prefix"} {
+		rule[key] = body[key]
+	}
+	return rule
+}
+
+func addSecurityGroupRule(s *Server, _ *collection, obj map[string]interface{}, body map[string]interface{}) (int, interface{}) {
+	rule := s.newSecurityGroupRule(obj["id"].(string), body)
+	obj["security_group_rules"] = append(obj["security_group_rules"].([]interface{}), rule)
+	obj["revision_number"] = obj["revision_number"].(int) + 1
+	return http.StatusCreated, rule
+}
+
+// portView returns a port with its instance, a DHCP port has none.
+func portView(port, instance map[string]interface{}) map[string]interface{} {
+	view := copyObject(port)
+	view["instance_id"] = stringField(instance, "instance_id", "")
+	view["instance_name"] = stringField(instance, "instance_name", "")
+	return view
+}
+
+// portMatches reports whether an instance port matches the network_id, device_id, mac_address and ip_address
+// query parameters.
+func portMatches(port, instance map[string]interface{}, query url.Values) bool {
+	if v := query.Get("network_id"); v != "" && port["network_id"] != v {
+		return false
+	}
+	if v := query.Get("device_id"); v != "" && instance["instance_id"] != v {
+		return false
+	}
+	if v := query.Get("mac_address"); v != "" && !strings.EqualFold(fmt.Sprint(port["mac_address"]), v) {
+		return false
+	}
+	if v := query.Get("ip_address"); v != "" {
+		for _, a := range port["ip_assignments"].([]interface{}) {
+			if a.(map[string]interface{})["ip_address"] == v {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// servePorts lists and gets the instance and DHCP ports and runs their port security and allowed address pairs
+// actions.
+func (s *Server) servePorts(w http.ResponseWriter, r *http.Request, rest []string, body map[string]interface{}) {
+	if len(rest) < 2 || len(rest) > 4 {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	projectID, err1 := strconv.Atoi(rest[0])
+	regionID, err2 := strconv.Atoi(rest[1])
+	if err1 != nil || err2 != nil {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	sc := scope{projectID: projectID, regionID: regionID}
+
+	if len(rest) == 2 {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+			return
+		}
+		result := []map[string]interface{}{}
+		instances := s.collections["instances"]
+		for _, id := range instances.order {
+			instance := instances.items[id]
+			if !inScope(instance, sc) {
+				continue
+			}
+			for _, raw := range s.ports[id] {
+				if port := raw.(map[string]interface{}); portMatches(port, instance, r.URL.Query()) {
+					result = append(result, portView(port, instance))
+				}
+			}
+		}
+		subnets := s.collections["subnets"]
+		for _, id := range subnets.order {
+			port, ok := s.dhcpPorts[id]
+			if ok && inScope(subnets.items[id], sc) && portMatches(port, nil, r.URL.Query()) {
+				result = append(result, portView(port, nil))
+			}
+		}
+		writePage(w, r, result, s.opts.PageSize)
+		return
+	}
+
+	port, instance := s.instancePort(rest[2])
+	owner := instance
+	if port == nil {
+		port, owner = s.dhcpPort(rest[2])
+	}
+	if port == nil || !inScope(owner, sc) {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("port %s not found", rest[2]))
+		return
+	}
+	action := ""
+	if len(rest) == 4 {
+		action = rest[3]
+	}
+	switch {
+	case action == "" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, portView(port, instance))
+	case action == "enable_port_security" && r.Method == http.MethodPost:
+		port["port_security_enabled"] = true
+		writeJSON(w, http.StatusOK, port)
+	case action == "disable_port_security" && r.Method == http.MethodPost:
+		port["port_security_enabled"] = false
+		writeJSON(w, http.StatusOK, port)
+	case action == "allow_address_pairs" && r.Method == http.MethodPut:
+		pairs, _ := body["allowed_address_pairs"].([]interface{})
+		if pairs == nil {
+			pairs = []interface{}{}
+		}
+		port["allowed_address_pairs"] = pairs
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"network_id":            port["network_id"],
+			"allowed_address_pairs": pairs,
+			"instance_id":           instance["instance_id"],
+			"port_id":               port["port_id"],
+		})
+	case action == "" || action == "enable_port_security" || action == "disable_port_security" || action == "allow_address_pairs":
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("unknown port action %s", action))
+	}
+}
+
+// serveSecurityGroupRules replaces and deletes rules of the stored security groups.
+func (s *Server) serveSecurityGroupRules(w http.ResponseWriter, r *http.Request, rest []string, body map[string]interface{}) {
+	if len(rest) != 3 {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	groups := s.collections["securitygroups"]
+	for _, id := range groups.order {
+		sg := groups.items[id]
+		if itoa(sg["project_id"]) != rest[0] || itoa(sg["region_id"]) != rest[1] {
+			continue
+		}
+		rules := sg["security_group_rules"].([]interface{})
+		for i, raw := range rules {
+			if raw.(map[string]interface{})["id"] != rest[2] {
+				continue
+			}
+			rules = append(rules[:i:i], rules[i+1:]...)
+			sg["revision_number"] = sg["revision_number"].(int) + 1
+			switch r.Method {
+			case http.MethodDelete:
+				sg["security_group_rules"] = rules
+				w.WriteHeader(http.StatusNoContent)
+			case http.MethodPut:
+				securityGroupID := stringField(body, "security_group_id", id)
+				target, ok := groups.items[securityGroupID]
+				if !ok {
+					writeError(w, http.StatusNotFound, fmt.Sprintf("security group %s not found", securityGroupID))
+					return
+				}
+				sg["security_group_rules"] = rules
+				rule := s.newSecurityGroupRule(securityGroupID, body)
+				target["security_group_rules"] = append(target["security_group_rules"].([]interface{}), rule)
+				writeJSON(w, http.StatusOK, rule)
+			default:
+				writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+			}
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, fmt.Sprintf("security group rule %s not found", rest[2]))
+}
+
+func buildLoadBalancer(s *Server, sc scope, body map[string]interface{}) ([]record, error) {
+	name := stringField(body, "name", "")
+	if name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	flavor := stringField(body, "flavor", "lb1-1-2")
+	obj := s.baseObject(sc)
+	obj["name"] = name
+	obj["provisioning_status"] = "ACTIVE"
+	obj["operating_status"] = "ONLINE"
+	obj["vip_address"] = s.nextIP("10.0")
+	obj["vip_port_id"] = uuid.NewV4().String()
+	obj["listeners"] = []interface{}{}
+	obj["creator_task_id"] = nil
+	obj["task_id"] = nil
+	obj["updated_at"] = nil
+	obj["tags"] = []interface{}{}
+	obj["flavor"] = map[string]interface{}{"flavor_id": flavor, "flavor_name": flavor, "vcpus": 1, "ram": 2048}
+	obj["metadata"] = metadataList(body["metadata"])
+	return []record{{collection: s.collections["loadbalancers"], object: obj}}, nil
+}
+
+// sourceVolume returns the volume referenced by the volume_id field of a create request.
+func (s *Server) sourceVolume(body map[string]interface{}) (map[string]interface{}, error) {
+	volumeID := stringField(body, "volume_id", "")
+	volume, ok := s.collections["volumes"].items[volumeID]
+	if !ok {
+		return nil, fmt.Errorf("volume %s not found", volumeID)
+	}
+	return volume, nil
+}
+
+func buildImage(s *Server, sc scope, body map[string]interface{}) ([]record, error) {
+	name := stringField(body, "name", "")
+	if name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	volume, err := s.sourceVolume(body)
+	if err != nil {
+		return nil, err
+	}
+	obj := s.baseObject(sc)
+	obj["name"] = name
+	obj["description"] = ""
+	obj["status"] = "active"
+	obj["tags"] = []interface{}{}
+	obj["visibility"] = "private"
+	obj["min_disk"] = volume["size"]
+	obj["min_ram"] = 0
+	obj["size"] = volume["size"]
+	obj["disk_format"] = "raw"
+	obj["os_type"] = stringField(body, "os_type", "linux")
+	obj["updated_at"] = nil
+	obj["creator_task_id"] = nil
+	obj["task_id"] = nil
+	obj["metadata_detailed"] = metadataList(body["metadata"])
+	return []record{{collection: s.collections["images"], object: obj}}, nil
+}
+
+func buildSnapshot(s *Server, sc scope, body map[string]interface{}) ([]record, error) {
+	name := stringField(body, "name", "")
+	if name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	volume, err := s.sourceVolume(body)
+	if err != nil {
+		return nil, err
+	}
+	metadata, _ := body["metadata"].(map[string]interface{})
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+	obj := s.baseObject(sc)
+	obj["name"] = name
+	obj["description"] = stringField(body, "description", "")
+	obj["status"] = "available"
+	obj["size"] = volume["size"]
+	obj["volume_id"] = volume["id"]
+	obj["updated_at"] = nil
+	obj["metadata"] = metadata
+	obj["creator_task_id"] = nil
+	obj["task_id"] = nil
+	return []record{{collection: s.collections["snapshots"], object: obj}}, nil
+}
+
+func newPoolMember(body map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"id":               uuid.NewV4().String(),
+		"address":          stringField(body, "address", ""),
+		"protocol_port":    intField(body, "protocol_port", 80),
+		"weight":           intField(body, "weight", 1),
+		"subnet_id":        stringField(body, "subnet_id", ""),
+		"instance_id":      stringField(body, "instance_id", ""),
+		"operating_status": "ONLINE",
+	}
+}
+
+func buildLBPool(s *Server, sc scope, body map[string]interface{}) ([]record, error) {
+	name := stringField(body, "name", "")
+	if name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	obj := s.baseObject(sc)
+	obj["name"] = name
+	obj["protocol"] = stringField(body, "protocol", "HTTP")
+	obj["lb_algorithm"] = stringField(body, "lb_algorithm", "ROUND_ROBIN")
+	obj["loadbalancers"] = []interface{}{}
+	if lbID := stringField(body, "loadbalancer_id", ""); lbID != "" {
+		obj["loadbalancers"] = []interface{}{map[string]interface{}{"id": lbID}}
+	}
+	obj["listeners"] = []interface{}{}
+	members := []interface{}{}
+	specs, _ := body["members"].([]interface{})
+	for _, raw := range specs {
+		members = append(members, newPoolMember(raw.(map[string]interface{})))
+	}
+	obj["members"] = members
+	obj["healthmonitor"] = nil
+	obj["session_persistence"] = nil
+	obj["provisioning_status"] = "ACTIVE"
+	obj["operating_status"] = "ONLINE"
+	obj["creator_task_id"] = ""
+	obj["task_id"] = ""
+	return []record{{collection: s.collections["lbpools"], object: obj}}, nil
+}
+
+func addPoolMember(s *Server, _ *collection, obj map[string]interface{}, body map[string]interface{}) (int, interface{}) {
+	member := newPoolMember(body)
+	return s.taskResponse("create_lbmember", obj, func() (map[string][]string, error) {
+		obj["members"] = append(obj["members"].([]interface{}), member)
+		return map[string][]string{"members": {member["id"].(string)}}, nil
+	})
+}
+
+func deletePoolMember(s *Server, r *http.Request, obj map[string]interface{}, memberID string) (int, interface{}) {
+	if r.Method != http.MethodDelete {
+		return http.StatusMethodNotAllowed, map[string]interface{}{"message": "Method Not Allowed"}
+	}
+	return s.taskResponse("delete_lbmember", obj, func() (map[string][]string, error) {
+		members := []interface{}{}
+		for _, raw := range obj["members"].([]interface{}) {
+			if raw.(map[string]interface{})["id"] != memberID {
+				members = append(members, raw)
+			}
+		}
+		obj["members"] = members
+		return nil, nil
+	})
+}
+
+// externalNetworkID is the network of the router gateways and the external reserved fixed IPs.
+const externalNetworkID = "e0ddc8a5-6f59-4a1b-9a7f-3c1b6a1c2d3e"
+
+// routerInterface builds the interface of a router in a subnet, holding the gateway IP of the subnet.
+func (s *Server) routerInterface(subnet map[string]interface{}) map[string]interface{} {
+	s.ipCounter++
+	networkID := subnet["network_id"].(string)
+	return map[string]interface{}{
+		"port_id":               uuid.NewV4().String(),
+		"mac_address":           fmt.Sprintf("fa:16:3e:%02x:%02x:%02x", s.ipCounter>>16&0xff, s.ipCounter>>8&0xff, s.ipCounter&0xff),
+		"network_id":            networkID,
+		"port_security_enabled": false,
+		"ip_assignments":        []interface{}{map[string]interface{}{"ip_address": subnet["gateway_ip"], "subnet_id": subnet["id"]}},
+		"network_details":       s.networkDetails(networkID),
+		"floatingip_details":    []interface{}{},
+		"sub_ports":             []interface{}{},
+	}
+}
+
+// routerSubnet returns the subnet of a router interface.
+func routerSubnet(iface map[string]interface{}) string {
+	assignments, _ := iface["ip_assignments"].([]interface{})
+	if len(assignments) == 0 {
+		return ""
+	}
+	return fmt.Sprint(assignments[0].(map[string]interface{})["subnet_id"])
+}
+
+func buildRouter(s *Server, sc scope, body map[string]interface{}) ([]record, error) {
+	name := stringField(body, "name", "")
+	if name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	obj := s.baseObject(sc)
+	obj["name"] = name
+	obj["status"] = "ACTIVE"
+	obj["updated_at"] = obj["created_at"]
+	obj["task_id"] = ""
+	obj["creator_task_id"] = ""
+	obj["routes"] = []interface{}{}
+	if routes, ok := body["routes"].([]interface{}); ok {
+		obj["routes"] = routes
+	}
+	gateway, _ := body["external_gateway_info"].(map[string]interface{})
+	obj["external_gateway_info"] = map[string]interface{}{
+		"enable_snat":        boolField(gateway, "enable_snat", true),
+		"network_id":         stringField(gateway, "network_id", externalNetworkID),
+		"external_fixed_ips": []interface{}{map[string]interface{}{"ip_address": s.nextIP("203.0"), "subnet_id": ""}},
+	}
+	interfaces := []interface{}{}
+	specs, _ := body["interfaces"].([]interface{})
+	for _, raw := range specs {
+		subnet, ok := s.collections["subnets"].items[stringField(raw.(map[string]interface{}), "subnet_id", "")]
+		if !ok {
+			return nil, fmt.Errorf("subnet %s not found", raw.(map[string]interface{})["subnet_id"])
+		}
+		interfaces = append(interfaces, s.routerInterface(subnet))
+	}
+	obj["interfaces"] = interfaces
+	return []record{{collection: s.collections["routers"], object: obj}}, nil
+}
+
+func updateRouter(s *Server, obj map[string]interface{}, body map[string]interface{}) error {
+	if name := stringField(body, "name", ""); name != "" {
+		obj["name"] = name
+	}
+	if routes, ok := body["routes"].([]interface{}); ok {
+		obj["routes"] = routes
+	}
+	if gateway, ok := body["external_gateway_info"].(map[string]interface{}); ok {
+		info := obj["external_gateway_info"].(map[string]interface{})
+		info["enable_snat"] = boolField(gateway, "enable_snat", true)
+		info["network_id"] = stringField(gateway, "network_id", externalNetworkID)
+	}
+	obj["updated_at"] = s.timestamp()
+	return nil
+}
+
+func attachRouterSubnet(s *Server, _ *collection, obj map[string]interface{}, body map[string]interface{}) (int, interface{}) {
+	subnetID := stringField(body, "subnet_id", "")
+	subnet, ok := s.collections["subnets"].items[subnetID]
+	if !ok {
+		return http.StatusNotFound, map[string]interface{}{"message": fmt.Sprintf("subnet %s not found", subnetID)}
+	}
+	for _, raw := range obj["interfaces"].([]interface{}) {
+		if routerSubnet(raw.(map[string]interface{})) == subnetID {
+			return http.StatusConflict, map[string]interface{}{"message": fmt.Sprintf("subnet %s is already attached", subnetID)}
+		}
+	}
+	obj["interfaces"] = append(obj["interfaces"].([]interface{}), s.routerInterface(subnet))
+	return http.StatusOK, obj
+}
+
+func detachRouterSubnet(s *Server, _ *collection, obj map[string]interface{}, body map[string]interface{}) (int, interface{}) {
+	subnetID := stringField(body, "subnet_id", "")
+	interfaces := []interface{}{}
+	for _, raw := range obj["interfaces"].([]interface{}) {
+		if routerSubnet(raw.(map[string]interface{})) != subnetID {
+			interfaces = append(interfaces, raw)
+		}
+	}
+	if len(interfaces) == len(obj["interfaces"].([]interface{})) {
+		return http.StatusNotFound, map[string]interface{}{"message": fmt.Sprintf("subnet %s is not attached", subnetID)}
+	}
+	obj["interfaces"] = interfaces
+	return http.StatusOK, obj
+}
+
+// subnetOf returns the subnet of the network containing the address, or the first subnet of the network.
+func (s *Server) subnetOf(networkID string, address net.IP) map[string]interface{} {
+	subnets := s.collections["subnets"]
+	for _, id := range subnets.order {
+		subnet := subnets.items[id]
+		if subnet["network_id"] != networkID {
+			continue
+		}
+		_, ipNet, _ := net.ParseCIDR(subnet["cidr"].(string))
+		if address == nil || ipNet.Contains(address) {
+			return subnet
+		}
+	}
+	return nil
+}
+
+func buildReservedFixedIP(s *Server, sc scope, body map[string]interface{}) ([]record, error) {
+	var subnet map[string]interface{}
+	address := net.ParseIP(stringField(body, "ip_address", ""))
+	switch stringField(body, "type", "") {
+	case "external":
+	case "subnet":
+		subnet = s.collections["subnets"].items[stringField(body, "subnet_id", "")]
+	case "any_subnet":
+		subnet = s.subnetOf(stringField(body, "network_id", ""), nil)
+	case "ip_address":
+		subnet = s.subnetOf(stringField(body, "network_id", ""), address)
+	default:
+		return nil, fmt.Errorf("unknown reserved fixed IP type %q", body["type"])
+	}
+
+	obj := s.baseObject(sc)
+	delete(obj, "id")
+	obj["port_id"] = uuid.NewV4().String()
+	obj["updated_at"] = obj["created_at"]
+	obj["status"] = "DOWN"
+	obj["creator_task_id"] = ""
+	obj["task_id"] = nil
+	obj["is_vip"] = boolField(body, "is_vip", false)
+	obj["reservation"] = map[string]interface{}{"status": "available", "resource_type": nil, "resource_id": nil}
+	obj["allowed_address_pairs"] = []interface{}{}
+	if subnet == nil {
+		if stringField(body, "type", "") != "external" {
+			return nil, fmt.Errorf("subnet not found")
+		}
+		obj["is_external"] = true
+		obj["fixed_ip_address"] = s.nextIP("203.0")
+		obj["subnet_id"] = ""
+		obj["network_id"] = externalNetworkID
+	} else {
+		if address == nil {
+			s.ipCounter++
+			_, ipNet, _ := net.ParseCIDR(subnet["cidr"].(string))
+			address = hostAddress(ipNet, s.ipCounter%250+2)
+		}
+		obj["is_external"] = false
+		obj["fixed_ip_address"] = address.String()
+		obj["subnet_id"] = subnet["id"]
+		obj["network_id"] = subnet["network_id"]
+	}
+	obj["name"] = fmt.Sprintf("port_%s", obj["fixed_ip_address"])
+	obj["network"] = s.networkDetails(obj["network_id"].(string))
+	return []record{{collection: s.collections["reserved_fixed_ips"], object: obj}}, nil
+}
+
+func updateReservedFixedIP(s *Server, obj map[string]interface{}, body map[string]interface{}) error {
+	if isVIP, ok := body["is_vip"].(bool); ok {
+		if !isVIP && len(s.vipPorts[obj["port_id"].(string)]) > 0 {
+			return fmt.Errorf("reserved fixed IP %s has connected devices", obj["port_id"])
+		}
+		obj["is_vip"] = isVIP
+	}
+	obj["updated_at"] = s.timestamp()
+	return nil
+}
+
+func deleteReservedFixedIP(s *Server, obj map[string]interface{}, _ url.Values) {
+	delete(s.vipPorts, obj["port_id"].(string))
+}
+
+// instancePort returns an instance interface and its instance.
+func (s *Server) instancePort(portID string) (map[string]interface{}, map[string]interface{}) {
+	instances := s.collections["instances"]
+	for _, id := range instances.order {
+		for _, raw := range s.ports[id] {
+			if port := raw.(map[string]interface{}); port["port_id"] == portID {
+				return port, instances.items[id]
+			}
+		}
+	}
+	return nil, nil
+}
+
+// dhcpPort returns a DHCP port and its subnet.
+func (s *Server) dhcpPort(portID string) (map[string]interface{}, map[string]interface{}) {
+	for subnetID, port := range s.dhcpPorts {
+		if port["port_id"] == portID {
+			return port, s.collections["subnets"].items[subnetID]
+		}
+	}
+	return nil, nil
+}
+
+// reservedFixedIPDevices lists, replaces (PUT) or extends (PATCH) the instance ports sharing a VIP.
+func reservedFixedIPDevices(s *Server, r *http.Request, obj map[string]interface{}, body map[string]interface{}) (int, interface{}) {
+	vipID := obj["port_id"].(string)
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut, http.MethodPatch:
+		if obj["is_vip"] != true {
+			return http.StatusBadRequest, map[string]interface{}{"message": fmt.Sprintf("reserved fixed IP %s is not a VIP", vipID)}
+		}
+		var portIDs []string
+		if r.Method == http.MethodPatch {
+			portIDs = append(portIDs, s.vipPorts[vipID]...)
+		}
+		raw, _ := body["port_ids"].([]interface{})
+		for _, id := range raw {
+			if port, _ := s.instancePort(fmt.Sprint(id)); port == nil {
+				return http.StatusNotFound, map[string]interface{}{"message": fmt.Sprintf("port %s not found", id)}
+			}
+			portIDs = append(portIDs, fmt.Sprint(id))
+		}
+		s.vipPorts[vipID] = portIDs
+	default:
+		return http.StatusMethodNotAllowed, map[string]interface{}{"message": "Method Not Allowed"}
+	}
+
+	devices := []interface{}{}
+	for _, portID := range s.vipPorts[vipID] {
+		port, instance := s.instancePort(portID)
+		if port == nil {
+			continue
+		}
+		assignments := []interface{}{}
+		for _, a := range port["ip_assignments"].([]interface{}) {
+			assignment := copyObject(a.(map[string]interface{}))
+			if subnet, ok := s.collections["subnets"].items[fmt.Sprint(assignment["subnet_id"])]; ok {
+				assignment["subnet"] = subnet
+			}
+			assignments = append(assignments, assignment)
+		}
+		devices = append(devices, map[string]interface{}{
+			"port_id":        portID,
+			"ip_assignments": assignments,
+			"instance_id":    instance["instance_id"],
+			"instance_name":  instance["instance_name"],
+			"network":        port["network_details"],
+		})
+	}
+	return http.StatusOK, map[string]interface{}{"count": len(devices), "results": devices}
+}
+
+// securityGroupMetadata lists the metadata of a security group, merges the request body into it on POST and
+// replaces the keys which are not read-only on PUT. A missing or null body is refused.
+func securityGroupMetadata(_ *Server, r *http.Request, obj map[string]interface{}, body map[string]interface{}) (int, interface{}) {
+	current, _ := obj["metadata"].([]map[string]interface{})
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost, http.MethodPut:
+		if body == nil {
+			return http.StatusBadRequest, map[string]interface{}{"message": "metadata must be an object"}
+		}
+		merged := map[string]interface{}{}
+		var readOnly []map[string]interface{}
+		for _, item := range current {
+			switch {
+			case item["read_only"] == true:
+				readOnly = append(readOnly, item)
+			case r.Method == http.MethodPost:
+				merged[item["key"].(string)] = item["value"]
+			}
+		}
+		for k, v := range body {
+			merged[k] = v
+		}
+		current = append(readOnly, metadataList(merged)...)
+		obj["metadata"] = current
+	default:
+		return http.StatusMethodNotAllowed, map[string]interface{}{"message": "Method Not Allowed"}
+	}
+	return http.StatusOK, map[string]interface{}{"count": len(current), "results": current}
+}
+
+// securityGroupInstances lists the instances of the project using a security group, matched by name.
+func securityGroupInstances(s *Server, r *http.Request, obj map[string]interface{}, _ map[string]interface{}) (int, interface{}) {
+	if r.Method != http.MethodGet {
+		return http.StatusMethodNotAllowed, map[string]interface{}{"message": "Method Not Allowed"}
+	}
+	result := []interface{}{}
+	instances := s.collections["instances"]
+	for _, id := range instances.order {
+		instance := instances.items[id]
+		if instance["project_id"] != obj["project_id"] || instance["region_id"] != obj["region_id"] {
+			continue
+		}
+		groups, _ := instance["security_groups"].([]interface{})
+		for _, group := range groups {
+			if group.(map[string]interface{})["name"] == obj["name"] {
+				result = append(result, instance)
+				break
+			}
+		}
+	}
+	return http.StatusOK, map[string]interface{}{"count": len(result), "results": result}
+}
@@ -0,0 +1,494 @@
+package fakecloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore"
+)
+
+const (
+	// DefaultProjectID is the ID of the project every server starts with.
+	DefaultProjectID = 1
+	// DefaultRegionID is the region used by ServiceClient.
+	DefaultRegionID = 1
+	// DefaultClientID is the client ID reported on projects and tasks.
+	DefaultClientID = 1
+	// APIToken is the token accepted by the server.
+	APIToken = "fakecloud-api-token" // nolint
+	// RegionName is the region name reported on resources.
+	RegionName = "Luxembourg"
+)
+
+// Options configures a fake server.
+type Options struct {
+	// TaskNewDuration is how long a task stays in the NEW state.
+	TaskNewDuration time.Duration
+	// TaskRunningDuration is how long a task stays in the RUNNING state before it is FINISHED
+	// and its changes are applied.
+	TaskRunningDuration time.Duration
+	// PageSize is the number of items returned per page when the request has no limit.
+	// Zero means all items are returned in a single page.
+	PageSize int
+}
+
+// Server is a stateful fake of the GCore Cloud API.
+type Server struct {
+	*httptest.Server
+
+	opts Options
+
+	mu            sync.Mutex
+	now           func() time.Time
+	projects      []map[string]interface{}
+	nextProjectID int
+	collections   map[string]*collection
+	tasks         map[string]*task
+	taskOrder     []string
+	ipCounter     int
+	// ports keeps the interfaces of instances by instance ID.
+	ports map[string][]interface{}
+	// vipPorts keeps the instance ports sharing a VIP by reserved fixed IP port ID.
+	vipPorts map[string][]string
+	// dhcpPorts keeps the DHCP ports of the subnets by subnet ID.
+	dhcpPorts map[string]map[string]interface{}
+	// restarts are the instances rebooted by the previous request.
+	restarts []map[string]interface{}
+}
+
+// New starts a fake server with a default project.
+func New(opts Options) *Server {
+	s := &Server{
+		opts:          opts,
+		now:           time.Now,
+		nextProjectID: DefaultProjectID,
+		tasks:         make(map[string]*task),
+		ports:         make(map[string][]interface{}),
+		vipPorts:      make(map[string][]string),
+		dhcpPorts:     make(map[string]map[string]interface{}),
+	}
+	s.collections = newCollections()
+	s.AddProject("default")
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Endpoint returns the API URL of the server.
+func (s *Server) Endpoint() string {
+	return s.URL + "/"
+}
+
+// ServiceClient returns a service client of the default project and region.
+func (s *Server) ServiceClient(name, version string) *gcorecloud.ServiceClient {
+	return s.ProjectServiceClient(name, version, DefaultProjectID, DefaultRegionID)
+}
+
+// ProjectServiceClient returns a service client of the given project and region.
+func (s *Server) ProjectServiceClient(name, version string, projectID, regionID int) *gcorecloud.ServiceClient {
+	provider, err := gcore.APITokenClient(gcorecloud.APITokenOptions{
+		APIURL:   s.Endpoint(),
+		APIToken: APIToken,
+	})
+	if err != nil {
+		panic(err)
+	}
+	client, err := gcore.ClientServiceFromProvider(provider, gcorecloud.EndpointOpts{
+		Name:    name,
+		Region:  regionID,
+		Project: projectID,
+		Version: version,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// AddProject adds a project and returns its ID.
+func (s *Server) AddProject(name string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addProject(name, "")
+}
+
+// Resources returns a copy of the resources of a kind (e.g. "instances") in creation order.
+func (s *Server) Resources(kind string) []map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.advanceTasks()
+	c, ok := s.collections[kind]
+	if !ok {
+		return nil
+	}
+	var result []map[string]interface{}
+	for _, id := range c.order {
+		result = append(result, copyObject(c.items[id]))
+	}
+	return result
+}
+
+func (s *Server) addProject(name, description string) int {
+	id := s.nextProjectID
+	s.nextProjectID++
+	s.projects = append(s.projects, map[string]interface{}{
+		"id":          id,
+		"client_id":   DefaultClientID,
+		"name":        name,
+		"description": description,
+		"state":       "ACTIVE",
+		"task_id":     nil,
+		"created_at":  s.now().UTC().Format(gcorecloud.RFC3339NoZ),
+	})
+	return id
+}
+
+func (s *Server) findProject(id int) (int, map[string]interface{}) {
+	for i, p := range s.projects {
+		if p["id"] == id {
+			return i, p
+		}
+	}
+	return -1, nil
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != fmt.Sprintf("APIKey %s", APIToken) {
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var body map[string]interface{}
+	if r.Body != nil {
+		raw, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if len(raw) > 0 {
+			if err := json.Unmarshal(raw, &body); err != nil {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("malformed JSON body: %s", err))
+				return
+			}
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.advanceTasks()
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) < 2 || (parts[0] != "v1" && parts[0] != "v2") {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	kind, rest := parts[1], parts[2:]
+
+	switch kind {
+	case "projects":
+		s.serveProjects(w, r, rest, body)
+	case "tasks":
+		s.serveTasks(w, r, rest)
+	case "securitygrouprules":
+		s.serveSecurityGroupRules(w, r, rest, body)
+	case "ports":
+		s.servePorts(w, r, rest, body)
+	default:
+		c, ok := s.collections[kind]
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("unknown resource %s", kind))
+			return
+		}
+		s.serveCollection(w, r, kind, c, rest, body)
+	}
+}
+
+func (s *Server) serveProjects(w http.ResponseWriter, r *http.Request, rest []string, body map[string]interface{}) {
+	if len(rest) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writePage(w, r, s.projects, s.opts.PageSize)
+		case http.MethodPost:
+			name, _ := body["name"].(string)
+			description, _ := body["description"].(string)
+			id := s.addProject(name, description)
+			_, p := s.findProject(id)
+			writeJSON(w, http.StatusCreated, p)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+		}
+		return
+	}
+
+	id, err := strconv.Atoi(rest[0])
+	if err != nil {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	_, p := s.findProject(id)
+	if p == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("project %d not found", id))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, p)
+	case http.MethodPut, http.MethodPatch:
+		for _, k := range []string{"name", "description"} {
+			if v, ok := body[k]; ok {
+				p[k] = v
+			}
+		}
+		writeJSON(w, http.StatusOK, p)
+	case http.MethodDelete:
+		p["state"] = "DELETING"
+		t := s.newTask("delete_project", 0, func() (map[string][]string, error) {
+			if idx, _ := s.findProject(id); idx >= 0 {
+				s.projects = append(s.projects[:idx:idx], s.projects[idx+1:]...)
+			}
+			for _, c := range s.collections {
+				for _, itemID := range append([]string(nil), c.order...) {
+					if c.items[itemID]["project_id"] == id {
+						c.delete(s, itemID, nil)
+					}
+				}
+			}
+			return nil, nil
+		})
+		writeTasks(w, t)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+	}
+}
+
+func (s *Server) serveCollection(w http.ResponseWriter, r *http.Request, kind string, c *collection, rest []string, body map[string]interface{}) {
+	if len(rest) < 2 {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	projectID, err1 := strconv.Atoi(rest[0])
+	regionID, err2 := strconv.Atoi(rest[1])
+	if err1 != nil || err2 != nil {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	if _, p := s.findProject(projectID); p == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("project %d not found", projectID))
+		return
+	}
+	sc := scope{projectID: projectID, regionID: regionID}
+
+	if len(rest) == 2 {
+		switch r.Method {
+		case http.MethodGet:
+			items := c.list(sc, r.URL.Query())
+			if c.listed != nil {
+				listed := items[:0]
+				for _, obj := range items {
+					if c.listed(kind, r.URL.Query(), obj) {
+						listed = append(listed, obj)
+					}
+				}
+				items = listed
+			}
+			writePage(w, r, items, s.opts.PageSize)
+		case http.MethodPost:
+			s.create(w, c, sc, body)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+		}
+		return
+	}
+
+	id := rest[2]
+	obj, ok := c.get(sc, id)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", c.name, id))
+		return
+	}
+
+	if len(rest) == 5 {
+		action, ok := c.detailActions[rest[3]]
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("unknown %s action %s", c.name, rest[3]))
+			return
+		}
+		status, response := action(s, r, obj, rest[4])
+		writeJSON(w, status, response)
+		return
+	}
+	if len(rest) == 4 {
+		if action, ok := c.methodActions[rest[3]]; ok {
+			status, response := action(s, r, obj, body)
+			writeJSON(w, status, response)
+			return
+		}
+		action, ok := c.actions[rest[3]]
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("unknown %s action %s", c.name, rest[3]))
+			return
+		}
+		status, response := action(s, c, obj, body)
+		writeJSON(w, status, response)
+		return
+	}
+	if len(rest) != 3 {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, obj)
+	case http.MethodPatch, http.MethodPut:
+		if c.onUpdate != nil {
+			if err := c.onUpdate(s, obj, body); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		} else if name, ok := body["name"]; ok {
+			obj[c.nameField] = name
+		}
+		writeJSON(w, http.StatusOK, obj)
+	case http.MethodDelete:
+		query := r.URL.Query()
+		if c.deletable != nil {
+			if err := c.deletable(s, obj, query); err != nil {
+				writeError(w, http.StatusConflict, err.Error())
+				return
+			}
+		}
+		if c.taskKey == "" {
+			c.delete(s, id, query)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		t := s.newTask("delete_"+c.name, projectID, func() (map[string][]string, error) {
+			if _, ok := c.items[id]; !ok {
+				return nil, fmt.Errorf("%s %s not found", c.name, id)
+			}
+			c.delete(s, id, query)
+			return nil, nil
+		})
+		writeTasks(w, t)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+	}
+}
+
+func (s *Server) create(w http.ResponseWriter, c *collection, sc scope, body map[string]interface{}) {
+	records, err := c.build(s, sc, body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if c.taskKey == "" {
+		for _, rec := range records {
+			if err := rec.collection.insert(s, rec.object); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		writeJSON(w, http.StatusCreated, records[0].object)
+		return
+	}
+	t := s.newTask("create_"+c.name, sc.projectID, func() (map[string][]string, error) {
+		created := make(map[string][]string)
+		for _, rec := range records {
+			if err := rec.collection.insert(s, rec.object); err != nil {
+				return nil, err
+			}
+			if key := rec.collection.taskKey; key != "" {
+				created[key] = append(created[key], rec.collection.id(rec.object))
+			}
+		}
+		return created, nil
+	})
+	writeTasks(w, t)
+}
+
+func (s *Server) nextIP(prefix string) string {
+	s.ipCounter++
+	return fmt.Sprintf("%s.%d.%d", prefix, s.ipCounter/250, s.ipCounter%250+2)
+}
+
+// writePage writes items honoring the limit and offset query parameters and adds a next link.
+func writePage(w http.ResponseWriter, r *http.Request, items []map[string]interface{}, pageSize int) {
+	query := r.URL.Query()
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	if limit == 0 {
+		limit = pageSize
+	}
+	count := len(items)
+	if offset > count {
+		offset = count
+	}
+	end := count
+	if limit > 0 && offset+limit < count {
+		end = offset + limit
+	}
+	results := items[offset:end]
+	if results == nil {
+		results = []map[string]interface{}{}
+	}
+	var links []gcorecloud.Link
+	if end < count {
+		next := url.URL{Scheme: "http", Host: r.Host, Path: r.URL.Path}
+		q := r.URL.Query()
+		q.Set("offset", strconv.Itoa(end))
+		q.Set("limit", strconv.Itoa(limit))
+		next.RawQuery = q.Encode()
+		links = append(links, gcorecloud.Link{Href: next.String(), Rel: "next"})
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"count":   count,
+		"results": results,
+		"links":   links,
+	})
+}
+
+func writeTasks(w http.ResponseWriter, t *task) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{"tasks": []string{t.id()}})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if v == nil {
+		return
+	}
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"exception_class": http.StatusText(status),
+		"message":         message,
+	})
+}
+
+func itoa(v interface{}) string {
+	switch i := v.(type) {
+	case int:
+		return strconv.Itoa(i)
+	case float64:
+		return strconv.Itoa(int(i))
+	}
+	return ""
+}
+
+func copyObject(obj map[string]interface{}) map[string]interface{} {
+	raw, _ := json.Marshal(obj)
+	var result map[string]interface{}
+	_ = json.Unmarshal(raw, &result)
+	return result
+}
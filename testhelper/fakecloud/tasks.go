@@ -0,0 +1,140 @@
+package fakecloud
+
+import (
+	"net/http"
+	"time"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	taskStateNew      = "NEW"
+	taskStateRunning  = "RUNNING"
+	taskStateFinished = "FINISHED"
+	taskStateError    = "ERROR"
+)
+
+// applyFunc applies the changes of a task when it finishes and returns its created resources.
+type applyFunc func() (map[string][]string, error)
+
+type task struct {
+	object    map[string]interface{}
+	createdAt time.Time
+	apply     applyFunc
+	done      bool
+}
+
+func (t *task) id() string {
+	return t.object["id"].(string)
+}
+
+// newTask registers a task. The changes are applied once the task reaches the FINISHED state.
+func (s *Server) newTask(taskType string, projectID int, apply applyFunc) *task {
+	id := uuid.NewV4().String()
+	now := s.now().UTC()
+	object := map[string]interface{}{
+		"id":                id,
+		"task_type":         taskType,
+		"client_id":         DefaultClientID,
+		"user_id":           DefaultClientID,
+		"user_client_id":    DefaultClientID,
+		"state":             taskStateNew,
+		"created_on":        now.Format(gcorecloud.RFC3339NoZ),
+		"updated_on":        nil,
+		"finished_on":       nil,
+		"acknowledged_at":   nil,
+		"acknowledged_by":   nil,
+		"created_resources": nil,
+		"request_id":        uuid.NewV4().String(),
+		"error":             nil,
+		"data":              nil,
+	}
+	if projectID != 0 {
+		object["project_id"] = projectID
+		object["region_id"] = DefaultRegionID
+	}
+	t := &task{object: object, createdAt: now, apply: apply}
+	s.tasks[id] = t
+	s.taskOrder = append(s.taskOrder, id)
+	s.advanceTasks()
+	return t
+}
+
+// advanceTasks moves tasks through their states according to the elapsed time. Tasks are finished
+// in creation order, so a task never observes a state later than the one its predecessors produced. The instances
+// rebooted by a previous request are ACTIVE again.
+func (s *Server) advanceTasks() {
+	for _, instance := range s.restarts {
+		instance["status"] = "ACTIVE"
+		instance["task_state"] = nil
+	}
+	s.restarts = nil
+
+	now := s.now().UTC()
+	for _, id := range s.taskOrder {
+		t := s.tasks[id]
+		if t.done {
+			continue
+		}
+		elapsed := now.Sub(t.createdAt)
+		switch {
+		case elapsed < s.opts.TaskNewDuration:
+			continue
+		case elapsed < s.opts.TaskNewDuration+s.opts.TaskRunningDuration:
+			if t.object["state"] != taskStateRunning {
+				t.object["state"] = taskStateRunning
+				t.object["updated_on"] = now.Format(gcorecloud.RFC3339NoZ)
+			}
+			continue
+		}
+		t.done = true
+		t.object["updated_on"] = now.Format(gcorecloud.RFC3339NoZ)
+		t.object["finished_on"] = now.Format(gcorecloud.RFC3339NoZ)
+		created, err := t.apply()
+		if err != nil {
+			t.object["state"] = taskStateError
+			t.object["error"] = err.Error()
+			continue
+		}
+		t.object["state"] = taskStateFinished
+		if created != nil {
+			resources := make(map[string]interface{}, len(created))
+			for k, v := range created {
+				resources[k] = v
+			}
+			t.object["created_resources"] = resources
+		}
+	}
+}
+
+func (s *Server) serveTasks(w http.ResponseWriter, r *http.Request, rest []string) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+		return
+	}
+	switch len(rest) {
+	case 1:
+		t, ok := s.tasks[rest[0]]
+		if !ok {
+			writeError(w, http.StatusNotFound, "task "+rest[0]+" not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, t.object)
+	case 3:
+		if rest[2] != "active" {
+			writeError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		var active []map[string]interface{}
+		for _, id := range s.taskOrder {
+			t := s.tasks[id]
+			if !t.done && rest[0] == itoa(t.object["project_id"]) {
+				active = append(active, t.object)
+			}
+		}
+		writePage(w, r, active, s.opts.PageSize)
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
@@ -0,0 +1,2 @@
+// fakecloud unit tests
+package testing
@@ -0,0 +1,198 @@
+package testing
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/gcore/floatingip/v1/floatingips"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/instances"
+	"github.com/G-Core/gcorelabscloud-go/gcore/instance/v1/types"
+	"github.com/G-Core/gcorelabscloud-go/gcore/network/v1/networks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/securitygroup/v1/securitygroups"
+	"github.com/G-Core/gcorelabscloud-go/gcore/subnet/v1/subnets"
+	"github.com/G-Core/gcorelabscloud-go/gcore/task/v1/tasks"
+	"github.com/G-Core/gcorelabscloud-go/gcore/volume/v1/volumes"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+	"github.com/G-Core/gcorelabscloud-go/testhelper/fakecloud"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskLifecycle(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{
+		TaskNewDuration:     300 * time.Millisecond,
+		TaskRunningDuration: 300 * time.Millisecond,
+	})
+	defer server.Close()
+
+	client := server.ServiceClient("networks", "v1")
+	taskClient := server.ServiceClient("tasks", "v1")
+
+	results, err := networks.Create(client, networks.CreateOpts{Name: "network"}).Extract()
+	require.NoError(t, err)
+	taskID := string(results.Tasks[0])
+
+	task, err := tasks.Get(taskClient, taskID).Extract()
+	require.NoError(t, err)
+	require.Equal(t, tasks.TaskStateNew, task.State)
+	require.Nil(t, task.CreatedResources)
+
+	active, err := tasks.List(taskClient).AllPages()
+	require.NoError(t, err)
+	activeTasks, err := tasks.ExtractTasks(active)
+	require.NoError(t, err)
+	require.Len(t, activeTasks, 1)
+
+	time.Sleep(400 * time.Millisecond)
+	task, err = tasks.Get(taskClient, taskID).Extract()
+	require.NoError(t, err)
+	require.Equal(t, tasks.TaskStateRunning, task.State)
+	require.Len(t, server.Resources("networks"), 0)
+
+	err = tasks.WaitForStatus(taskClient, taskID, tasks.TaskStateFinished, 10, true)
+	require.NoError(t, err)
+	task, err = tasks.Get(taskClient, taskID).Extract()
+	require.NoError(t, err)
+	networkID, err := networks.ExtractNetworkIDFromTask(task)
+	require.NoError(t, err)
+
+	network, err := networks.Get(client, networkID).Extract()
+	require.NoError(t, err)
+	require.Equal(t, "network", network.Name)
+	require.Equal(t, fakecloud.DefaultProjectID, network.ProjectID)
+}
+
+func TestInstanceFlow(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+
+	networkClient := server.ServiceClient("networks", "v1")
+	subnetClient := server.ServiceClient("subnets", "v1")
+	instanceClient := server.ServiceClient("instances", "v1")
+	volumeClient := server.ServiceClient("volumes", "v1")
+	fipClient := server.ServiceClient("floatingips", "v1")
+
+	task := server.FinishedTask(t, networks.Create(networkClient, networks.CreateOpts{Name: "network"}))
+	networkID, err := networks.ExtractNetworkIDFromTask(task)
+	require.NoError(t, err)
+
+	cidr, err := gcorecloud.ParseCIDRString("192.168.10.0/24")
+	require.NoError(t, err)
+	task = server.FinishedTask(t, subnets.Create(subnetClient, subnets.CreateOpts{
+		Name:      "subnet",
+		CIDR:      *cidr,
+		NetworkID: networkID,
+	}))
+	subnetID, err := subnets.ExtractSubnetIDFromTask(task)
+	require.NoError(t, err)
+
+	network, err := networks.Get(networkClient, networkID).Extract()
+	require.NoError(t, err)
+	require.Equal(t, []string{subnetID}, network.Subnets)
+
+	task = server.FinishedTask(t, instances.Create(instanceClient, instances.CreateOpts{
+		Flavor: "g1-standard-1-2",
+		Names:  []string{"instance"},
+		Volumes: []instances.CreateVolumeOpts{{
+			Source:    types.NewVolume,
+			BootIndex: 0,
+			Size:      10,
+			TypeName:  volumes.Standard,
+		}},
+		Interfaces: []instances.InterfaceInstanceCreateOpts{{
+			InterfaceOpts: instances.InterfaceOpts{Type: types.SubnetInterfaceType, SubnetID: subnetID},
+		}},
+	}))
+	instanceID, err := instances.ExtractInstanceIDFromTask(task)
+	require.NoError(t, err)
+
+	instance, err := instances.Get(instanceClient, instanceID).Extract()
+	require.NoError(t, err)
+	require.Equal(t, "instance", instance.Name)
+	require.Equal(t, "ACTIVE", instance.Status)
+	require.Len(t, instance.Volumes, 1)
+	require.Len(t, instance.Addresses["network"], 1)
+	_, ipNet, _ := net.ParseCIDR("192.168.10.0/24")
+	require.True(t, ipNet.Contains(instance.Addresses["network"][0].Address))
+
+	volume, err := volumes.Get(volumeClient, instance.Volumes[0].ID).Extract()
+	require.NoError(t, err)
+	require.Equal(t, volumes.InUse, volume.Status)
+	require.Equal(t, instanceID, volume.Attachments[0].ServerID)
+
+	instance, err = instances.Stop(instanceClient, instanceID).Extract()
+	require.NoError(t, err)
+	require.Equal(t, "SHUTOFF", instance.Status)
+
+	interfaces, err := instances.ListInterfacesAll(instanceClient, instanceID)
+	require.NoError(t, err)
+	require.Len(t, interfaces, 1)
+
+	task = server.FinishedTask(t, floatingips.Create(fipClient, floatingips.CreateOpts{PortID: interfaces[0].PortID}))
+	fipID, err := floatingips.ExtractFloatingIPIDFromTask(task)
+	require.NoError(t, err)
+	fips, err := floatingips.ListAll(fipClient, nil)
+	require.NoError(t, err)
+	require.Len(t, fips, 1)
+	require.Equal(t, fipID, fips[0].ID)
+	require.Equal(t, instanceID, fips[0].Instance.ID)
+	require.Equal(t, "ACTIVE", fips[0].Status)
+
+	server.FinishedTask(t, instances.Delete(instanceClient, instanceID, instances.DeleteOpts{DeleteFloatings: true}))
+	_, err = instances.Get(instanceClient, instanceID).Extract()
+	require.Error(t, err)
+	require.Len(t, server.Resources("volumes"), 0)
+	require.Len(t, server.Resources("floatingips"), 0)
+
+	server.FinishedTask(t, networks.Delete(networkClient, networkID))
+	require.Len(t, server.Resources("subnets"), 0)
+}
+
+func TestPagination(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{PageSize: 2})
+	defer server.Close()
+
+	client := server.ServiceClient("securitygroups", "v1")
+	for _, name := range []string{"sg1", "sg2", "sg3", "sg4", "sg5"} {
+		_, err := securitygroups.Create(client, securitygroups.CreateOpts{
+			SecurityGroup: securitygroups.CreateSecurityGroupOpts{Name: name},
+		}).Extract()
+		require.NoError(t, err)
+	}
+
+	pages := 0
+	var names []string
+	err := securitygroups.List(client, nil).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+		groups, err := securitygroups.ExtractSecurityGroups(page)
+		require.NoError(t, err)
+		for _, sg := range groups {
+			names = append(names, sg.Name)
+		}
+		return true, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, pages)
+	require.Equal(t, []string{"sg1", "sg2", "sg3", "sg4", "sg5"}, names)
+}
+
+func TestProjectScope(t *testing.T) {
+	server := fakecloud.New(fakecloud.Options{})
+	defer server.Close()
+
+	projectID := server.AddProject("other")
+	_, err := securitygroups.Create(server.ServiceClient("securitygroups", "v1"), securitygroups.CreateOpts{
+		SecurityGroup: securitygroups.CreateSecurityGroupOpts{Name: "sg"},
+	}).Extract()
+	require.NoError(t, err)
+
+	other := server.ProjectServiceClient("securitygroups", "v1", projectID, fakecloud.DefaultRegionID)
+	groups, err := securitygroups.ListAll(other, nil)
+	require.NoError(t, err)
+	require.Len(t, groups, 0)
+
+	missing := server.ProjectServiceClient("securitygroups", "v1", 100, fakecloud.DefaultRegionID)
+	_, err = securitygroups.ListAll(missing, nil)
+	require.Error(t, err)
+}
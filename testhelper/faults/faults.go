@@ -0,0 +1,262 @@
+/*
+Package faults wraps an http.Handler to inject faults into the responses of a test server.
+
+Rules select requests by method and path pattern and apply a fault to some of the matching calls, which makes it
+possible to test token refresh, retries and error handling with testhelper.SetupHTTP.
+
+Example of forcing a token refresh on the first call and a rate limit on the second one
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	injector := faults.Install()
+
+	injector.On(http.MethodGet, "/v1/networks/1/1").OnCall(1).Inject(faults.Unauthorized())
+	injector.On(http.MethodGet, "/v1/networks/1/1").OnCall(2).Inject(faults.TooManyRequests(time.Second))
+*/
+package faults
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	th "github.com/G-Core/gcorelabscloud-go/testhelper"
+)
+
+// Fault handles a request instead of, or around, the wrapped handler.
+type Fault func(w http.ResponseWriter, r *http.Request, next http.Handler)
+
+// Rule applies a fault to the requests matching a method and a path pattern.
+type Rule struct {
+	method  string
+	pattern string
+	calls   map[int]bool
+	from    int
+	to      int
+	fault   Fault
+
+	mu      sync.Mutex
+	matched int
+	applied int
+}
+
+// OnCall restricts the rule to the given calls, counted from 1 among the requests matching the rule.
+func (r *Rule) OnCall(calls ...int) *Rule {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.calls == nil {
+		r.calls = make(map[int]bool)
+	}
+	for _, c := range calls {
+		r.calls[c] = true
+	}
+	return r
+}
+
+// Times restricts the rule to the first n matching calls.
+func (r *Rule) Times(n int) *Rule {
+	return r.Between(1, n)
+}
+
+// Between restricts the rule to the matching calls from..to inclusive. A zero to means no upper bound.
+func (r *Rule) Between(from, to int) *Rule {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.from, r.to = from, to
+	return r
+}
+
+// Inject sets the fault of the rule.
+func (r *Rule) Inject(fault Fault) *Rule {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fault = fault
+	return r
+}
+
+// Matched returns the number of requests matching the rule.
+func (r *Rule) Matched() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.matched
+}
+
+// Applied returns the number of requests the fault was applied to.
+func (r *Rule) Applied() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.applied
+}
+
+// match counts the request and reports whether the fault applies to it.
+func (r *Rule) match(req *http.Request) (Fault, bool) {
+	if r.method != "" && r.method != req.Method {
+		return nil, false
+	}
+	if ok, err := path.Match(r.pattern, req.URL.Path); err != nil || !ok {
+		return nil, false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.matched++
+	call := r.matched
+	if r.calls != nil && !r.calls[call] {
+		return nil, false
+	}
+	if (r.from > 0 && call < r.from) || (r.to > 0 && call > r.to) {
+		return nil, false
+	}
+	if r.fault == nil {
+		return nil, false
+	}
+	r.applied++
+	return r.fault, true
+}
+
+// Injector is an http.Handler applying the rules to the requests before passing them to the wrapped handler.
+// Every matching rule counts the request, the first one whose call selection applies handles it.
+type Injector struct {
+	next  http.Handler
+	mu    sync.Mutex
+	rules []*Rule
+}
+
+// New returns an injector wrapping the handler.
+func New(next http.Handler) *Injector {
+	return &Injector{next: next}
+}
+
+// Install wraps the handler of testhelper.Server. It must be called after testhelper.SetupHTTP.
+func Install() *Injector {
+	injector := New(th.Server.Config.Handler)
+	th.Server.Config.Handler = injector
+	return injector
+}
+
+// On adds a rule for the method and the path pattern. An empty method matches any method. The pattern uses
+// path.Match syntax, e.g. "/v1/networks/*/*".
+func (i *Injector) On(method, pattern string) *Rule {
+	rule := &Rule{method: method, pattern: pattern}
+	i.mu.Lock()
+	i.rules = append(i.rules, rule)
+	i.mu.Unlock()
+	return rule
+}
+
+// Reset removes all rules.
+func (i *Injector) Reset() {
+	i.mu.Lock()
+	i.rules = nil
+	i.mu.Unlock()
+}
+
+// ServeHTTP implements http.Handler.
+func (i *Injector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	i.mu.Lock()
+	rules := make([]*Rule, len(i.rules))
+	copy(rules, i.rules)
+	i.mu.Unlock()
+
+	var selected Fault
+	for _, rule := range rules {
+		if fault, ok := rule.match(r); ok && selected == nil {
+			selected = fault
+		}
+	}
+	if selected == nil {
+		i.next.ServeHTTP(w, r)
+		return
+	}
+	selected(w, r, i.next)
+}
+
+func writeJSONError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = fmt.Fprintf(w, `{"exception_class": %q, "message": %q}`, http.StatusText(status), message)
+}
+
+// Latency delays the request before passing it to the wrapped handler.
+func Latency(d time.Duration) Fault {
+	return func(w http.ResponseWriter, r *http.Request, next http.Handler) {
+		select {
+		case <-time.After(d):
+		case <-r.Context().Done():
+			return
+		}
+		next.ServeHTTP(w, r)
+	}
+}
+
+// Status responds with the status code and a JSON error body.
+func Status(code int) Fault {
+	return func(w http.ResponseWriter, _ *http.Request, _ http.Handler) {
+		writeJSONError(w, code, http.StatusText(code))
+	}
+}
+
+// Unauthorized responds with 401, which makes a client with a ReauthFunc refresh its token.
+func Unauthorized() Fault {
+	return Status(http.StatusUnauthorized)
+}
+
+// TooManyRequests responds with 429 and a Retry-After header.
+func TooManyRequests(retryAfter time.Duration) Fault {
+	return func(w http.ResponseWriter, _ *http.Request, _ http.Handler) {
+		w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Round(time.Second)/time.Second)))
+		writeJSONError(w, http.StatusTooManyRequests, "Too many requests")
+	}
+}
+
+// DropConnection sends the headers and the first half of the wrapped handler response, then closes the connection.
+func DropConnection() Fault {
+	return func(w http.ResponseWriter, r *http.Request, next http.Handler) {
+		recorder := httptest.NewRecorder()
+		next.ServeHTTP(recorder, r)
+		body := recorder.Body.Bytes()
+
+		hijacker, ok := w.(http.Hijacker)
+		if !ok {
+			panic("faults: DropConnection requires a hijackable http.ResponseWriter")
+		}
+		conn, buf, err := hijacker.Hijack()
+		if err != nil {
+			panic(err)
+		}
+		defer conn.Close()
+
+		header := recorder.Header().Clone()
+		header.Set("Content-Length", strconv.Itoa(len(body)))
+		_, _ = fmt.Fprintf(buf, "HTTP/1.1 %d %s\r\n", recorder.Code, http.StatusText(recorder.Code))
+		_ = header.Write(buf)
+		_, _ = buf.WriteString("\r\n")
+		_, _ = buf.Write(body[:len(body)/2])
+		_ = buf.Flush()
+	}
+}
+
+// MalformedJSON passes the request to the wrapped handler and truncates its JSON response, keeping the status code.
+func MalformedJSON() Fault {
+	return func(w http.ResponseWriter, r *http.Request, next http.Handler) {
+		recorder := httptest.NewRecorder()
+		next.ServeHTTP(recorder, r)
+		body := bytes.TrimSpace(recorder.Body.Bytes())
+		if len(body) < 2 {
+			body = []byte(`{"`)
+		} else {
+			body = body[:len(body)-1]
+		}
+		for k, v := range recorder.Header() {
+			w.Header()[k] = v
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Del("Content-Length")
+		w.WriteHeader(recorder.Code)
+		_, _ = w.Write(body)
+	}
+}
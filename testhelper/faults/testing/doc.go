@@ -0,0 +1,2 @@
+// faults unit tests
+package testing
@@ -0,0 +1,136 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	th "github.com/G-Core/gcorelabscloud-go/testhelper"
+	fake "github.com/G-Core/gcorelabscloud-go/testhelper/client"
+	"github.com/G-Core/gcorelabscloud-go/testhelper/faults"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPath     = "/v1/networks/1/1/e00ecfb3-1e10-4c5d-9c65-0c4a0a6cf8a2"
+	testResponse = `{"id": "e00ecfb3-1e10-4c5d-9c65-0c4a0a6cf8a2", "name": "network"}`
+)
+
+func setupNetworkHandler(t *testing.T) {
+	th.Mux.HandleFunc(testPath, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, testResponse)
+	})
+}
+
+func get(client *gcorecloud.ServiceClient) (map[string]interface{}, error) {
+	var body map[string]interface{}
+	_, err := client.Get(client.ServiceURL("e00ecfb3-1e10-4c5d-9c65-0c4a0a6cf8a2"), &body, nil)
+	return body, err
+}
+
+func TestUnauthorizedForcesTokenRefresh(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	setupNetworkHandler(t)
+	injector := faults.Install()
+
+	updatedAccessToken := fake.AccessToken + "X"
+	refreshCount := 0
+	th.Mux.HandleFunc("/v1/token/refresh", func(w http.ResponseWriter, r *http.Request) {
+		refreshCount++
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"access": "%s", "refresh": "%s"}`, updatedAccessToken, fake.RefreshToken)
+	})
+	rule := injector.On(http.MethodGet, "/v1/networks/*/*/*").OnCall(1).Inject(faults.Unauthorized())
+
+	client := fake.ServiceTokenClient("networks", "v1")
+	body, err := get(client)
+	require.NoError(t, err)
+	require.Equal(t, "network", body["name"])
+	require.Equal(t, 1, refreshCount)
+	require.Equal(t, updatedAccessToken, client.AccessToken())
+	require.Equal(t, 2, rule.Matched())
+	require.Equal(t, 1, rule.Applied())
+}
+
+func TestTooManyRequests(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	setupNetworkHandler(t)
+	injector := faults.Install()
+	injector.On("", "/v1/networks/*/*/*").Times(2).Inject(faults.TooManyRequests(3 * time.Second))
+
+	client := fake.ServiceTokenClient("networks", "v1")
+	for i := 0; i < 2; i++ {
+		_, err := get(client)
+		require.Error(t, err)
+		_, ok := err.(gcorecloud.ErrDefault429)
+		require.True(t, ok, "unexpected error %T", err)
+	}
+	_, err := get(client)
+	require.NoError(t, err)
+
+	resp, err := http.Get(th.Endpoint() + strings.TrimPrefix(testPath, "/"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	injector.Reset()
+	injector.On(http.MethodGet, testPath).Inject(faults.TooManyRequests(3 * time.Second))
+	resp, err = http.Get(th.Endpoint() + strings.TrimPrefix(testPath, "/"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	require.Equal(t, "3", resp.Header.Get("Retry-After"))
+}
+
+func TestDropConnection(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	setupNetworkHandler(t)
+	injector := faults.Install()
+	injector.On(http.MethodGet, testPath).OnCall(2).Inject(faults.DropConnection())
+
+	client := fake.ServiceTokenClient("networks", "v1")
+	_, err := get(client)
+	require.NoError(t, err)
+	_, err = get(client)
+	require.Error(t, err)
+	_, err = get(client)
+	require.NoError(t, err)
+}
+
+func TestMalformedJSON(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	setupNetworkHandler(t)
+	injector := faults.Install()
+	injector.On(http.MethodGet, testPath).Inject(faults.MalformedJSON())
+
+	client := fake.ServiceTokenClient("networks", "v1")
+	_, err := get(client)
+	require.Error(t, err)
+}
+
+func TestLatency(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	setupNetworkHandler(t)
+	injector := faults.Install()
+	injector.On(http.MethodGet, testPath).Between(2, 0).Inject(faults.Latency(200 * time.Millisecond))
+
+	client := fake.ServiceTokenClient("networks", "v1")
+	start := time.Now()
+	_, err := get(client)
+	require.NoError(t, err)
+	require.Less(t, int64(time.Since(start)), int64(200*time.Millisecond))
+
+	start = time.Now()
+	_, err = get(client)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, int64(time.Since(start)), int64(200*time.Millisecond))
+}
@@ -0,0 +1,95 @@
+package testhelper
+
+import (
+	"context"
+	"crypto/rand"
+	"sync"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+)
+
+// RecordedSpan is a span recorded by InMemoryTracer.
+type RecordedSpan struct {
+	Name        string
+	Context     gcorecloud.SpanContext
+	Parent      gcorecloud.SpanContext
+	Attributes  map[string]string
+	Errors      []error
+	Ended       bool
+	attributeMu sync.Mutex
+}
+
+// SpanContext implements gcorecloud.Span.
+func (s *RecordedSpan) SpanContext() gcorecloud.SpanContext {
+	return s.Context
+}
+
+// SetAttribute implements gcorecloud.Span.
+func (s *RecordedSpan) SetAttribute(key, value string) {
+	s.attributeMu.Lock()
+	defer s.attributeMu.Unlock()
+	s.Attributes[key] = value
+}
+
+// RecordError implements gcorecloud.Span.
+func (s *RecordedSpan) RecordError(err error) {
+	s.attributeMu.Lock()
+	defer s.attributeMu.Unlock()
+	s.Errors = append(s.Errors, err)
+}
+
+// End implements gcorecloud.Span.
+func (s *RecordedSpan) End() {
+	s.attributeMu.Lock()
+	defer s.attributeMu.Unlock()
+	s.Ended = true
+}
+
+// InMemoryTracer is a gcorecloud.Tracer recording all spans it starts.
+type InMemoryTracer struct {
+	mu    sync.Mutex
+	spans []*RecordedSpan
+}
+
+// Start implements gcorecloud.Tracer.
+func (t *InMemoryTracer) Start(ctx context.Context, name string) (context.Context, gcorecloud.Span) {
+	span := &RecordedSpan{
+		Name:       name,
+		Attributes: make(map[string]string),
+	}
+	if parent, ok := gcorecloud.SpanContextFromContext(ctx); ok {
+		span.Parent = parent
+		span.Context.TraceID = parent.TraceID
+		span.Context.Sampled = parent.Sampled
+	} else {
+		_, _ = rand.Read(span.Context.TraceID[:])
+		span.Context.Sampled = true
+	}
+	_, _ = rand.Read(span.Context.SpanID[:])
+
+	t.mu.Lock()
+	t.spans = append(t.spans, span)
+	t.mu.Unlock()
+
+	return gcorecloud.ContextWithSpanContext(ctx, span.Context), span
+}
+
+// Spans returns the recorded spans in start order.
+func (t *InMemoryTracer) Spans() []*RecordedSpan {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	spans := make([]*RecordedSpan, len(t.spans))
+	copy(spans, t.spans)
+	return spans
+}
+
+// SpansByName returns the recorded spans with the given name.
+func (t *InMemoryTracer) SpansByName(name string) []*RecordedSpan {
+	var spans []*RecordedSpan
+	for _, s := range t.Spans() {
+		if s.Name == name {
+			spans = append(spans, s)
+		}
+	}
+	return spans
+}
@@ -18,6 +18,43 @@ func TestServiceURL(t *testing.T) {
 	th.CheckEquals(t, expected, actual)
 }
 
+func TestForService(t *testing.T) {
+	c := &gcorecloud.ServiceClient{
+		Endpoint:     "http://123.45.67.8/",
+		ResourceBase: "http://123.45.67.8/v1/instances/1/2/",
+		Name:         "instances",
+	}
+	volumes := c.ForService("volumes")
+	th.CheckEquals(t, "http://123.45.67.8/v1/volumes/1/2/", volumes.ResourceBaseURL())
+	th.CheckEquals(t, "volumes", volumes.Name)
+	th.CheckEquals(t, "http://123.45.67.8/v1/instances/1/2/", c.ResourceBaseURL())
+}
+
+func TestForServiceWithoutSegment(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	requests := 0
+	th.Mux.HandleFunc("/v1/instances/1/2/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+	})
+
+	for _, name := range []string{"", "volumes"} {
+		c := &gcorecloud.ServiceClient{
+			ProviderClient: new(gcorecloud.ProviderClient),
+			Endpoint:       th.Endpoint(),
+			ResourceBase:   th.Endpoint() + "v1/instances/1/2/",
+			Name:           name,
+		}
+		// The client keeps failing when derived again.
+		tasks := c.ForService("subnets").ForService("tasks")
+		th.CheckEquals(t, "tasks", tasks.Name)
+		_, err := tasks.Get(tasks.ServiceURL("id"), nil, nil)
+		th.AssertEquals(t, true, err != nil)
+	}
+	th.AssertEquals(t, 0, requests)
+}
+
 func TestMoreHeaders(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -0,0 +1,197 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+	"github.com/G-Core/gcorelabscloud-go/pagination"
+	th "github.com/G-Core/gcorelabscloud-go/testhelper"
+)
+
+func TestTraceParent(t *testing.T) {
+	value := "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+	sc, err := gcorecloud.ParseTraceParent(value)
+	require.NoError(t, err)
+	require.True(t, sc.Sampled)
+	require.Equal(t, value, sc.TraceParent())
+
+	for _, invalid := range []string{
+		"",
+		"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331",
+		"00-00000000000000000000000000000000-b7ad6b7169203331-01",
+		"ff-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+		"00-0af7651916cd43dd8448eb211c80319c-b7ad6b71692033-01",
+	} {
+		_, err := gcorecloud.ParseTraceParent(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestTraceParentWithoutTracer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	parent, err := gcorecloud.ParseTraceParent("00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+	require.NoError(t, err)
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, gcorecloud.TraceParentHeader, parent.TraceParent())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c := &gcorecloud.ServiceClient{ProviderClient: new(gcorecloud.ProviderClient)}
+	c = c.WithContext(gcorecloud.ContextWithSpanContext(context.Background(), parent))
+	resp, err := c.Get(th.Endpoint()+"route", nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+}
+
+// nilTracer returns neither a context nor a span.
+type nilTracer struct{}
+
+func (nilTracer) Start(_ context.Context, _ string) (context.Context, gcorecloud.Span) {
+	return nil, nil // nolint
+}
+
+func TestNilTracer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	parent, err := gcorecloud.ParseTraceParent("00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+	require.NoError(t, err)
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, gcorecloud.TraceParentHeader, parent.TraceParent())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c := &gcorecloud.ServiceClient{ProviderClient: &gcorecloud.ProviderClient{Tracer: nilTracer{}}}
+	c = c.WithContext(gcorecloud.ContextWithSpanContext(context.Background(), parent))
+	resp, err := c.Get(th.Endpoint()+"route", nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+}
+
+func TestRequestSpan(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	parent, err := gcorecloud.ParseTraceParent("00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+	require.NoError(t, err)
+
+	var traceParent string
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		traceParent = r.Header.Get(gcorecloud.TraceParentHeader)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprint(w, `{"tasks": ["50f53a35-42ed-40c4-82b2-5a37fb3e00bc"]}`)
+		if err != nil {
+			log.Error(err)
+		}
+	})
+
+	tracer := new(th.InMemoryTracer)
+	c := &gcorecloud.ServiceClient{
+		ProviderClient: &gcorecloud.ProviderClient{
+			Tracer:  tracer,
+			Context: gcorecloud.ContextWithSpanContext(context.Background(), parent),
+		},
+		Name:      "instances",
+		RegionID:  1,
+		ProjectID: 2,
+	}
+
+	var body interface{}
+	_, err = c.Post(th.Endpoint()+"route", map[string]string{}, &body, nil)
+	require.NoError(t, err)
+
+	spans := tracer.SpansByName(gcorecloud.SpanNameRequest)
+	require.Len(t, spans, 1)
+	span := spans[0]
+	require.True(t, span.Ended)
+	require.Equal(t, parent, span.Parent)
+	require.Equal(t, span.Context.TraceParent(), traceParent)
+	require.Equal(t, "instances", span.Attributes[gcorecloud.SpanAttributeResourceType])
+	require.Equal(t, "1", span.Attributes[gcorecloud.SpanAttributeRegionID])
+	require.Equal(t, "2", span.Attributes[gcorecloud.SpanAttributeProjectID])
+	require.Equal(t, "POST", span.Attributes[gcorecloud.SpanAttributeHTTPMethod])
+	require.Equal(t, "200", span.Attributes[gcorecloud.SpanAttributeHTTPStatus])
+	require.Equal(t, "50f53a35-42ed-40c4-82b2-5a37fb3e00bc", span.Attributes[gcorecloud.SpanAttributeTaskIDs])
+}
+
+func TestRequestSpanError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	tracer := new(th.InMemoryTracer)
+	c := &gcorecloud.ServiceClient{ProviderClient: &gcorecloud.ProviderClient{Tracer: tracer}}
+	_, err := c.Get(th.Endpoint()+"route", nil, nil)
+	require.Error(t, err)
+
+	spans := tracer.SpansByName(gcorecloud.SpanNameRequest)
+	require.Len(t, spans, 1)
+	require.Len(t, spans[0].Errors, 1)
+	require.Equal(t, "404", spans[0].Attributes[gcorecloud.SpanAttributeHTTPStatus])
+}
+
+type tracedPage struct {
+	pagination.LinkedPageBase
+}
+
+func (r tracedPage) IsEmpty() (bool, error) {
+	var s []int
+	err := r.Result.ExtractIntoSlicePtr(&s, "results")
+	return len(s) == 0, err
+}
+
+func TestPagerSpan(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/page1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		_, err := fmt.Fprintf(w, `{"results": [1], "links": {"next": "%s"}}`, th.Endpoint()+"page2")
+		if err != nil {
+			log.Error(err)
+		}
+	})
+	th.Mux.HandleFunc("/page2", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		_, err := fmt.Fprint(w, `{"results": [2]}`)
+		if err != nil {
+			log.Error(err)
+		}
+	})
+
+	tracer := new(th.InMemoryTracer)
+	c := &gcorecloud.ServiceClient{ProviderClient: &gcorecloud.ProviderClient{Tracer: tracer}, Name: "networks"}
+	pager := pagination.NewPager(c, th.Endpoint()+"page1", func(r pagination.PageResult) pagination.Page {
+		return tracedPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+
+	_, err := pager.AllPages()
+	require.NoError(t, err)
+
+	pagerSpans := tracer.SpansByName(gcorecloud.SpanNamePager)
+	require.Len(t, pagerSpans, 1)
+	require.True(t, pagerSpans[0].Ended)
+	require.Equal(t, "networks", pagerSpans[0].Attributes[gcorecloud.SpanAttributeResourceType])
+
+	requestSpans := tracer.SpansByName(gcorecloud.SpanNameRequest)
+	require.Len(t, requestSpans, 2)
+	for _, span := range requestSpans {
+		require.Equal(t, pagerSpans[0].Context, span.Parent)
+		require.True(t, strings.HasPrefix(span.Attributes[gcorecloud.SpanAttributeHTTPURL], th.Endpoint()))
+	}
+}
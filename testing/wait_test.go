@@ -0,0 +1,59 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	gcorecloud "github.com/G-Core/gcorelabscloud-go"
+)
+
+func TestWaitUntil(t *testing.T) {
+	var calls []time.Time
+	opts := gcorecloud.WaitOpts{Interval: 10 * time.Millisecond, MaxInterval: 40 * time.Millisecond, Multiplier: 2}
+	err := gcorecloud.WaitUntil(context.Background(), opts, func(ctx context.Context) (bool, error) {
+		calls = append(calls, time.Now())
+		return len(calls) == 5, nil
+	})
+	require.NoError(t, err)
+	require.Len(t, calls, 5)
+	// 10ms, 20ms, 40ms, 40ms
+	require.GreaterOrEqual(t, int64(calls[4].Sub(calls[0])), int64(110*time.Millisecond))
+	require.GreaterOrEqual(t, int64(calls[4].Sub(calls[3])), int64(40*time.Millisecond))
+}
+
+func TestWaitUntilTimeout(t *testing.T) {
+	opts := gcorecloud.WaitOpts{Timeout: 50 * time.Millisecond, Interval: 10 * time.Millisecond}
+	err := gcorecloud.WaitUntil(context.Background(), opts, func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, gcorecloud.ErrWaitTimeout))
+}
+
+func TestWaitUntilCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	err := gcorecloud.WaitUntil(ctx, gcorecloud.WaitOpts{Interval: 10 * time.Millisecond}, func(ctx context.Context) (bool, error) {
+		calls++
+		if calls == 2 {
+			cancel()
+		}
+		return false, nil
+	})
+	require.True(t, errors.Is(err, context.Canceled))
+	require.Equal(t, 2, calls)
+}
+
+func TestWaitUntilError(t *testing.T) {
+	calls := 0
+	err := gcorecloud.WaitUntil(context.Background(), gcorecloud.WaitOpts{Interval: time.Millisecond}, func(ctx context.Context) (bool, error) {
+		calls++
+		return false, gcorecloud.ErrResourceErrorState
+	})
+	require.True(t, errors.Is(err, gcorecloud.ErrResourceErrorState))
+	require.Equal(t, 1, calls)
+}
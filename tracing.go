@@ -0,0 +1,207 @@
+package gcorecloud
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Span attribute keys set by the library on the spans it starts.
+const (
+	SpanAttributeResourceType = "gcore.resource_type"
+	SpanAttributeRegionID     = "gcore.region_id"
+	SpanAttributeProjectID    = "gcore.project_id"
+	SpanAttributeTaskID       = "gcore.task_id"
+	SpanAttributeTaskIDs      = "gcore.task_ids"
+	SpanAttributeResourceID   = "gcore.resource_id"
+	SpanAttributeHTTPMethod   = "http.method"
+	SpanAttributeHTTPURL      = "http.url"
+	SpanAttributeHTTPStatus   = "http.status_code"
+)
+
+// Span names used by the library.
+const (
+	SpanNameRequest      = "gcore.request"
+	SpanNamePager        = "gcore.pager"
+	SpanNameTaskWait     = "gcore.task.wait"
+	SpanNameResourceWait = "gcore.resource.wait"
+)
+
+// TraceParentHeader is the W3C trace context header populated on every request.
+const TraceParentHeader = "traceparent"
+
+// SpanContext identifies a span within a trace, as defined by the W3C trace context specification.
+type SpanContext struct {
+	TraceID [16]byte
+	SpanID  [8]byte
+	Sampled bool
+}
+
+// IsValid reports whether both the trace and the span IDs are set.
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID != [16]byte{} && sc.SpanID != [8]byte{}
+}
+
+// TraceParent formats the span context as a W3C traceparent header value.
+func (sc SpanContext) TraceParent() string {
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+	return fmt.Sprintf("00-%s-%s-%s", hex.EncodeToString(sc.TraceID[:]), hex.EncodeToString(sc.SpanID[:]), flags)
+}
+
+// ParseTraceParent parses a W3C traceparent header value.
+func ParseTraceParent(value string) (SpanContext, error) {
+	var sc SpanContext
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" {
+		return sc, fmt.Errorf("invalid traceparent: %q", value)
+	}
+	if parts[0] == "00" && len(parts) != 4 {
+		return sc, fmt.Errorf("invalid traceparent: %q", value)
+	}
+	traceID, err := hex.DecodeString(parts[1])
+	if err != nil || len(traceID) != len(sc.TraceID) {
+		return sc, fmt.Errorf("invalid traceparent trace id: %q", parts[1])
+	}
+	spanID, err := hex.DecodeString(parts[2])
+	if err != nil || len(spanID) != len(sc.SpanID) {
+		return sc, fmt.Errorf("invalid traceparent parent id: %q", parts[2])
+	}
+	flags, err := strconv.ParseUint(parts[3], 16, 8)
+	if err != nil || len(parts[3]) != 2 {
+		return sc, fmt.Errorf("invalid traceparent flags: %q", parts[3])
+	}
+	copy(sc.TraceID[:], traceID)
+	copy(sc.SpanID[:], spanID)
+	sc.Sampled = flags&0x01 == 0x01
+	if !sc.IsValid() {
+		return sc, fmt.Errorf("invalid traceparent: %q", value)
+	}
+	return sc, nil
+}
+
+type spanContextKey struct{}
+
+// ContextWithSpanContext returns a copy of ctx carrying the span context. Tracers should use it to expose
+// the span they start so that the library can propagate it to the API.
+func ContextWithSpanContext(ctx context.Context, sc SpanContext) context.Context {
+	return context.WithValue(ctx, spanContextKey{}, sc)
+}
+
+// SpanContextFromContext returns the span context carried by ctx, if any.
+func SpanContextFromContext(ctx context.Context) (SpanContext, bool) {
+	if ctx == nil {
+		return SpanContext{}, false
+	}
+	sc, ok := ctx.Value(spanContextKey{}).(SpanContext)
+	return sc, ok && sc.IsValid()
+}
+
+// Span is a single traced operation.
+type Span interface {
+	// SpanContext returns the identity of the span. It is used to populate the traceparent header.
+	SpanContext() SpanContext
+	// SetAttribute tags the span.
+	SetAttribute(key, value string)
+	// RecordError marks the span as failed.
+	RecordError(err error)
+	// End finishes the span.
+	End()
+}
+
+// Tracer starts spans. Implementations are expected to parent the new span on the span carried by ctx, if any,
+// and to return a context carrying the new span (see ContextWithSpanContext).
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// noopSpan is used when no tracer is configured. It keeps the span context of the caller, so the
+// traceparent header is still propagated.
+type noopSpan struct {
+	sc SpanContext
+}
+
+func (s noopSpan) SpanContext() SpanContext { return s.sc }
+func (s noopSpan) SetAttribute(_, _ string) {}
+func (s noopSpan) RecordError(_ error)      {}
+func (s noopSpan) End()                     {}
+
+// StartSpan starts a span using the client Tracer. When no tracer is set, a no-op span is returned. A tracer
+// returning a nil context or span falls back to ctx and a no-op span.
+func (client *ProviderClient) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if client.Tracer == nil {
+		sc, _ := SpanContextFromContext(ctx)
+		return ctx, noopSpan{sc: sc}
+	}
+	spanCtx, span := client.Tracer.Start(ctx, name)
+	if spanCtx == nil {
+		spanCtx = ctx
+	}
+	if span == nil {
+		sc, _ := SpanContextFromContext(spanCtx)
+		span = noopSpan{sc: sc}
+	}
+	return spanCtx, span
+}
+
+// StartSpan starts a span tagged with the service resource type, region and project.
+func (client *ServiceClient) StartSpan(name string) (context.Context, Span) {
+	ctx, span := client.ProviderClient.StartSpan(client.RequestContext(), name)
+	for k, v := range client.spanAttributes() {
+		span.SetAttribute(k, v)
+	}
+	return ctx, span
+}
+
+func (client *ServiceClient) spanAttributes() map[string]string {
+	attributes := make(map[string]string)
+	if client.Name != "" {
+		attributes[SpanAttributeResourceType] = client.Name
+	} else if client.Type != "" {
+		attributes[SpanAttributeResourceType] = client.Type
+	}
+	if client.RegionID != 0 {
+		attributes[SpanAttributeRegionID] = strconv.Itoa(client.RegionID)
+	}
+	if client.ProjectID != 0 {
+		attributes[SpanAttributeProjectID] = strconv.Itoa(client.ProjectID)
+	}
+	return attributes
+}
+
+// extractTaskIDs returns the task IDs of a decoded task response.
+func extractTaskIDs(response interface{}) []string {
+	var body interface{}
+	switch v := response.(type) {
+	case *interface{}:
+		body = *v
+	case *map[string]interface{}:
+		body = *v
+	case map[string]interface{}:
+		body = v
+	default:
+		return nil
+	}
+	m, ok := body.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	raw, ok := m["tasks"].([]interface{})
+	if !ok {
+		return nil
+	}
+	var ids []string
+	for _, t := range raw {
+		if id, ok := t.(string); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
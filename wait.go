@@ -0,0 +1,113 @@
+package gcorecloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Defaults of WaitOpts.
+const (
+	DefaultWaitTimeout     = 10 * time.Minute
+	DefaultWaitInterval    = time.Second
+	DefaultWaitMaxInterval = 15 * time.Second
+	DefaultWaitMultiplier  = 1.5
+)
+
+var (
+	// ErrWaitTimeout is returned by the waiters when the resource does not reach the expected state in time.
+	ErrWaitTimeout = errors.New("timeout waiting for the resource")
+	// ErrResourceErrorState is returned by the waiters when the resource enters an error state.
+	ErrResourceErrorState = errors.New("resource is in an error state")
+)
+
+// WaitOpts configures the polling of the resource waiters. Zero fields are set to their defaults.
+type WaitOpts struct {
+	// Timeout is the maximum time to wait.
+	Timeout time.Duration
+	// Interval is the time between the first polls. It grows by Multiplier after each poll, up to MaxInterval.
+	Interval    time.Duration
+	MaxInterval time.Duration
+	Multiplier  float64
+}
+
+func (opts WaitOpts) withDefaults() WaitOpts {
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultWaitTimeout
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultWaitInterval
+	}
+	if opts.MaxInterval <= 0 {
+		opts.MaxInterval = DefaultWaitMaxInterval
+	}
+	if opts.MaxInterval < opts.Interval {
+		opts.MaxInterval = opts.Interval
+	}
+	if opts.Multiplier < 1 {
+		opts.Multiplier = DefaultWaitMultiplier
+	}
+	return opts
+}
+
+// WaitUntil polls the predicate with exponential backoff until it is satisfied, it returns an error, the timeout is
+// exceeded or the context is cancelled. The predicate is called immediately and receives a context bounded by the
+// timeout. ErrWaitTimeout is returned when the timeout is exceeded.
+func WaitUntil(ctx context.Context, opts WaitOpts, predicate func(ctx context.Context) (bool, error)) error {
+	opts = opts.withDefaults()
+	parent := ctx
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	stopped := func() error {
+		if parent.Err() != nil {
+			return parent.Err()
+		}
+		return fmt.Errorf("%w after %s", ErrWaitTimeout, opts.Timeout)
+	}
+
+	interval := opts.Interval
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	<-timer.C
+	for {
+		done, err := predicate(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return stopped()
+			}
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		timer.Reset(interval)
+		select {
+		case <-ctx.Done():
+			return stopped()
+		case <-timer.C:
+		}
+		interval = time.Duration(float64(interval) * opts.Multiplier)
+		if interval > opts.MaxInterval {
+			interval = opts.MaxInterval
+		}
+	}
+}
+
+// WaitForResource polls a resource of the service with WaitUntil. The wait is cancelled with the context of the
+// client, use WithContext to set it. The predicate receives a copy of the client bound to the wait.
+func (client *ServiceClient) WaitForResource(id string, opts WaitOpts, predicate func(client *ServiceClient) (bool, error)) error {
+	ctx, span := client.StartSpan(SpanNameResourceWait)
+	defer span.End()
+	span.SetAttribute(SpanAttributeResourceID, id)
+
+	err := WaitUntil(ctx, opts, func(ctx context.Context) (bool, error) {
+		return predicate(client.WithContext(ctx))
+	})
+	if err != nil {
+		span.RecordError(err)
+	}
+	return err
+}